@@ -0,0 +1,118 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type uintSlice []uint
+
+func (s uintSlice) Len() int           { return len(s) }
+func (s uintSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s uintSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// bitmapLimit bounds the bits a bitmap may set, far above the CPU
+// count of any host, so that a bogus range cannot take forever
+const bitmapLimit = 65536
+
+// parseBitmap parses a libvirt bitmap string such as "0-3,^2,8" into
+// a sorted list of the bits it sets.
+func parseBitmap(str string) ([]uint, error) {
+	set := make(map[uint]bool)
+	for _, part := range strings.Split(str, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		negate := false
+		if strings.HasPrefix(part, "^") {
+			negate = true
+			part = part[1:]
+		}
+		start, end := part, part
+		if idx := strings.Index(part, "-"); idx != -1 {
+			if negate {
+				return nil, fmt.Errorf("Invalid bitmap '%s', ranges cannot be negated", str)
+			}
+			start, end = part[:idx], part[idx+1:]
+		}
+		first, err := strconv.ParseUint(strings.TrimSpace(start), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid bitmap '%s': %s", str, err)
+		}
+		last, err := strconv.ParseUint(strings.TrimSpace(end), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid bitmap '%s': %s", str, err)
+		}
+		if last < first {
+			return nil, fmt.Errorf("Invalid bitmap '%s', range end is before its start", str)
+		}
+		if last >= bitmapLimit {
+			return nil, fmt.Errorf("Invalid bitmap '%s', bit %d is above the limit of %d", str, last, bitmapLimit-1)
+		}
+		for bit := first; bit <= last; bit++ {
+			if negate {
+				delete(set, uint(bit))
+			} else {
+				set[uint(bit)] = true
+			}
+		}
+	}
+
+	bits := make([]uint, 0, len(set))
+	for bit := range set {
+		bits = append(bits, bit)
+	}
+	sort.Sort(uintSlice(bits))
+	return bits, nil
+}
+
+// formatBitmap formats bits in the compact syntax libvirt uses,
+// collapsing consecutive bits into ranges.
+func formatBitmap(bits []uint) string {
+	sorted := make([]uint, len(bits))
+	copy(sorted, bits)
+	sort.Sort(uintSlice(sorted))
+
+	var parts []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[i] == sorted[j] {
+			parts = append(parts, fmt.Sprintf("%d", sorted[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,468 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// CapsFitHugepages is the hugepage demand of a domain on a set of
+// host NUMA nodes which the pages may be allocated from.
+type CapsFitHugepages struct {
+	Nodes []uint
+	// Page size in KiB
+	Size uint64
+	// Number of pages the domain needs and the total number of pages
+	// of that size the host reports on Nodes. The capabilities carry
+	// no free page counts, so pages already used by other domains
+	// are still counted as available.
+	Required  uint64
+	Available uint64
+}
+
+// CapsFit is the result of checking a domain against the host
+// capabilities. Each field answers one question separately so the
+// caller can weigh them when comparing hosts.
+type CapsFit struct {
+	// Whether the host can run guests of the domain's type and
+	// architecture, and whether it offers the domain's machine type
+	Arch    bool
+	Machine bool
+
+	// Host NUMA nodes referenced by the domain's NUMA tuning and
+	// host CPUs referenced by its CPU pinning, which the host lacks
+	MissingNUMANodes []uint
+	MissingCPUs      []uint
+
+	// Security models of the domain's labels unknown to the host
+	MissingSecModels []string
+
+	Hugepages []CapsFitHugepages
+}
+
+// Feasible reports whether the domain can be started on the host
+func (f *CapsFit) Feasible() bool {
+	if !f.Arch || !f.Machine ||
+		len(f.MissingNUMANodes) != 0 || len(f.MissingCPUs) != 0 ||
+		len(f.MissingSecModels) != 0 {
+		return false
+	}
+	for _, pages := range f.Hugepages {
+		if pages.Required > pages.Available {
+			return false
+		}
+	}
+	return true
+}
+
+func capsGuestMachine(machines []CapsGuestMachine, name string) bool {
+	for _, machine := range machines {
+		if machine.Name == name || machine.Canonical == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *CapsFit) checkGuest(dom *Domain, caps *Caps) {
+	ostype := "hvm"
+	arch := ""
+	machine := ""
+	if dom.OS != nil && dom.OS.Type != nil {
+		if dom.OS.Type.Type != "" {
+			ostype = dom.OS.Type.Type
+		}
+		arch = dom.OS.Type.Arch
+		machine = dom.OS.Type.Machine
+	}
+	if arch == "" && caps.Host.CPU != nil {
+		arch = caps.Host.CPU.Arch
+	}
+
+	for _, guest := range caps.Guests {
+		if guest.OSType != ostype || guest.Arch.Name != arch {
+			continue
+		}
+		var domain *CapsGuestDomain
+		for i := range guest.Arch.Domains {
+			if guest.Arch.Domains[i].Type == dom.Type {
+				domain = &guest.Arch.Domains[i]
+				break
+			}
+		}
+		if dom.Type != "" && domain == nil {
+			continue
+		}
+		f.Arch = true
+		if machine == "" || capsGuestMachine(guest.Arch.Machines, machine) ||
+			(domain != nil && capsGuestMachine(domain.Machines, machine)) {
+			f.Machine = true
+			return
+		}
+	}
+}
+
+func capsHostCells(caps *Caps) []CapsHostNUMACell {
+	if caps.Host.NUMA == nil || caps.Host.NUMA.Cells == nil {
+		return nil
+	}
+	return caps.Host.NUMA.Cells.Cells
+}
+
+func capsMissingBits(str string, present map[uint]bool, missing map[uint]bool) error {
+	if str == "" {
+		return nil
+	}
+	bits, err := parseBitmap(str)
+	if err != nil {
+		return err
+	}
+	for _, bit := range bits {
+		if !present[bit] {
+			missing[bit] = true
+		}
+	}
+	return nil
+}
+
+func capsSortedBits(bits map[uint]bool) []uint {
+	if len(bits) == 0 {
+		return nil
+	}
+	list := make([]uint, 0, len(bits))
+	for bit := range bits {
+		list = append(list, bit)
+	}
+	sort.Sort(uintSlice(list))
+	return list
+}
+
+func (f *CapsFit) checkTopology(dom *Domain, caps *Caps) error {
+	nodes := make(map[uint]bool)
+	cpus := make(map[uint]bool)
+	for _, cell := range capsHostCells(caps) {
+		nodes[uint(cell.ID)] = true
+		if cell.CPUS == nil {
+			continue
+		}
+		for _, cpu := range cell.CPUS.CPUs {
+			cpus[uint(cpu.ID)] = true
+		}
+	}
+
+	// Without a NUMA topology the host nodes are unknown
+	missingNodes := make(map[uint]bool)
+	if len(nodes) != 0 && dom.NUMATune != nil {
+		if dom.NUMATune.Memory != nil {
+			err := capsMissingBits(dom.NUMATune.Memory.Nodeset, nodes, missingNodes)
+			if err != nil {
+				return err
+			}
+		}
+		for _, memnode := range dom.NUMATune.MemNodes {
+			err := capsMissingBits(memnode.Nodeset, nodes, missingNodes)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	f.MissingNUMANodes = capsSortedBits(missingNodes)
+
+	// Without a NUMA topology the host CPUs are unknown
+	if len(cpus) == 0 {
+		return nil
+	}
+
+	missingCPUs := make(map[uint]bool)
+	if dom.VCPU != nil {
+		err := capsMissingBits(dom.VCPU.CPUSet, cpus, missingCPUs)
+		if err != nil {
+			return err
+		}
+	}
+	if dom.CPUTune != nil {
+		for _, pin := range dom.CPUTune.VCPUPin {
+			err := capsMissingBits(pin.CPUSet, cpus, missingCPUs)
+			if err != nil {
+				return err
+			}
+		}
+		if dom.CPUTune.EmulatorPin != nil {
+			err := capsMissingBits(dom.CPUTune.EmulatorPin.CPUSet, cpus, missingCPUs)
+			if err != nil {
+				return err
+			}
+		}
+		for _, pin := range dom.CPUTune.IOThreadPin {
+			err := capsMissingBits(pin.CPUSet, cpus, missingCPUs)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	f.MissingCPUs = capsSortedBits(missingCPUs)
+	return nil
+}
+
+func (f *CapsFit) checkSecModels(dom *Domain, caps *Caps) {
+	for _, label := range dom.SecLabel {
+		if label.Model == "" || label.Type == "none" {
+			continue
+		}
+		found := false
+		for _, model := range caps.Host.SecModel {
+			if model.Name == label.Model {
+				found = true
+				break
+			}
+		}
+		if !found {
+			f.MissingSecModels = append(f.MissingSecModels, label.Model)
+		}
+	}
+}
+
+// capsDefaultHugepageSize picks the smallest page size larger than
+// the base page size which the host reports
+func capsDefaultHugepageSize(caps *Caps) (uint64, error) {
+	var best uint64
+	consider := func(size int, unit string) error {
+		kib, err := scaleToKiB(uint64(size), unit)
+		if err != nil {
+			return err
+		}
+		if kib > 4 && (best == 0 || kib < best) {
+			best = kib
+		}
+		return nil
+	}
+	if caps.Host.CPU != nil {
+		for _, page := range caps.Host.CPU.PageSizes {
+			if err := consider(page.Size, page.Unit); err != nil {
+				return 0, err
+			}
+		}
+	}
+	for _, cell := range capsHostCells(caps) {
+		for _, page := range cell.PageInfo {
+			if err := consider(page.Size, page.Unit); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if best == 0 {
+		return 0, fmt.Errorf("Host does not report any huge page sizes")
+	}
+	return best, nil
+}
+
+// domainCellMemory returns the memory of each guest NUMA cell in KiB,
+// or a single pseudo cell holding all memory if there is no guest NUMA
+// topology. The pseudo cell has no ID.
+func domainCellMemory(dom *Domain) ([]*uint, []uint64, error) {
+	if dom.CPU != nil && dom.CPU.Numa != nil && len(dom.CPU.Numa.Cell) != 0 {
+		ids := make([]*uint, len(dom.CPU.Numa.Cell))
+		mem := make([]uint64, len(dom.CPU.Numa.Cell))
+		for i, cell := range dom.CPU.Numa.Cell {
+			id := uint(i)
+			if cell.ID != nil {
+				id = *cell.ID
+			}
+			ids[i] = &id
+			val, err := strconv.ParseUint(cell.Memory, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Invalid memory '%s' of NUMA cell %d", cell.Memory, id)
+			}
+			mem[i], err = scaleToKiB(val, cell.Unit)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return ids, mem, nil
+	}
+
+	var total uint64
+	var err error
+	if dom.Memory != nil {
+		total, err = scaleToKiB(uint64(dom.Memory.Value), dom.Memory.Unit)
+	} else if dom.MaximumMemory != nil {
+		total, err = scaleToKiB(uint64(dom.MaximumMemory.Value), dom.MaximumMemory.Unit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return []*uint{nil}, []uint64{total}, nil
+}
+
+// domainCellHugepage returns the huge page entry which applies to a
+// guest NUMA cell: the one listing the cell in its nodeset, otherwise
+// the one without a nodeset.
+func domainCellHugepage(pages []DomainMemoryHugepage, id *uint) (*DomainMemoryHugepage, error) {
+	var fallback *DomainMemoryHugepage
+	for i := range pages {
+		if pages[i].Nodeset == "" {
+			if fallback == nil {
+				fallback = &pages[i]
+			}
+			continue
+		}
+		if id == nil {
+			continue
+		}
+		cells, err := parseBitmap(pages[i].Nodeset)
+		if err != nil {
+			return nil, err
+		}
+		for _, cell := range cells {
+			if cell == *id {
+				return &pages[i], nil
+			}
+		}
+	}
+	return fallback, nil
+}
+
+func (f *CapsFit) checkHugepages(dom *Domain, caps *Caps) error {
+	if dom.MemoryBacking == nil || dom.MemoryBacking.MemoryHugePages == nil {
+		return nil
+	}
+
+	ids, mem, err := domainCellMemory(dom)
+	if err != nil {
+		return err
+	}
+
+	allNodes := []uint{}
+	for _, cell := range capsHostCells(caps) {
+		allNodes = append(allNodes, uint(cell.ID))
+	}
+
+	pages := dom.MemoryBacking.MemoryHugePages.Hugepages
+	demand := make(map[string]*CapsFitHugepages)
+	var order []string
+	for i, id := range ids {
+		var size uint64
+		page, err := domainCellHugepage(pages, id)
+		if err != nil {
+			return err
+		}
+		if page != nil {
+			size, err = scaleToKiB(uint64(page.Size), page.Unit)
+		} else if len(pages) == 0 {
+			size, err = capsDefaultHugepageSize(caps)
+		} else {
+			// Cell is not backed by huge pages
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if size == 0 {
+			return fmt.Errorf("Huge page size of less than 1 KiB is not supported")
+		}
+
+		nodeset := ""
+		if dom.NUMATune != nil {
+			if dom.NUMATune.Memory != nil {
+				nodeset = dom.NUMATune.Memory.Nodeset
+			}
+			for _, memnode := range dom.NUMATune.MemNodes {
+				if id != nil && memnode.CellID == *id {
+					nodeset = memnode.Nodeset
+				}
+			}
+		}
+		nodes := allNodes
+		if nodeset != "" {
+			nodes, err = parseBitmap(nodeset)
+			if err != nil {
+				return err
+			}
+		}
+
+		key := fmt.Sprintf("%s/%d", formatBitmap(nodes), size)
+		entry, ok := demand[key]
+		if !ok {
+			entry = &CapsFitHugepages{
+				Nodes: nodes,
+				Size:  size,
+			}
+			demand[key] = entry
+			order = append(order, key)
+		}
+		entry.Required += (mem[i] + size - 1) / size
+	}
+
+	for _, key := range order {
+		entry := demand[key]
+		for _, cell := range capsHostCells(caps) {
+			inset := false
+			for _, node := range entry.Nodes {
+				if node == uint(cell.ID) {
+					inset = true
+				}
+			}
+			if !inset {
+				continue
+			}
+			for _, page := range cell.PageInfo {
+				size, err := scaleToKiB(uint64(page.Size), page.Unit)
+				if err != nil {
+					return err
+				}
+				if size == entry.Size {
+					entry.Available += page.Count
+				}
+			}
+		}
+		f.Hugepages = append(f.Hugepages, *entry)
+	}
+	return nil
+}
+
+// CheckCaps checks whether dom could run on the host described by
+// caps. An error is only returned for malformed input, an infeasible
+// domain is reported through the result.
+func CheckCaps(dom *Domain, caps *Caps) (*CapsFit, error) {
+	fit := &CapsFit{}
+
+	fit.checkGuest(dom, caps)
+	fit.checkSecModels(dom, caps)
+
+	err := fit.checkTopology(dom, caps)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fit.checkHugepages(dom, caps)
+	if err != nil {
+		return nil, err
+	}
+
+	return fit, nil
+}
@@ -0,0 +1,358 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var capsCheckCaps = []string{
+	`<capabilities>`,
+	`  <host>`,
+	`    <cpu>`,
+	`      <arch>x86_64</arch>`,
+	`      <pages unit="KiB" size="4"/>`,
+	`      <pages unit="KiB" size="2048"/>`,
+	`      <pages unit="KiB" size="1048576"/>`,
+	`    </cpu>`,
+	`    <topology>`,
+	`      <cells num="2">`,
+	`        <cell id="0">`,
+	`          <memory unit="KiB">8388608</memory>`,
+	`          <pages unit="KiB" size="4">1048576</pages>`,
+	`          <pages unit="KiB" size="2048">1024</pages>`,
+	`          <pages unit="KiB" size="1048576">0</pages>`,
+	`          <cpus num="4">`,
+	`            <cpu id="0" socket_id="0" core_id="0" siblings="0,2"/>`,
+	`            <cpu id="1" socket_id="0" core_id="1" siblings="1,3"/>`,
+	`            <cpu id="2" socket_id="0" core_id="0" siblings="0,2"/>`,
+	`            <cpu id="3" socket_id="0" core_id="1" siblings="1,3"/>`,
+	`          </cpus>`,
+	`        </cell>`,
+	`        <cell id="1">`,
+	`          <memory unit="KiB">8388608</memory>`,
+	`          <pages unit="KiB" size="4">1048576</pages>`,
+	`          <pages unit="KiB" size="2048">256</pages>`,
+	`          <pages unit="KiB" size="1048576">2</pages>`,
+	`          <cpus num="4">`,
+	`            <cpu id="4" socket_id="1" core_id="0" siblings="4,6"/>`,
+	`            <cpu id="5" socket_id="1" core_id="1" siblings="5,7"/>`,
+	`            <cpu id="6" socket_id="1" core_id="0" siblings="4,6"/>`,
+	`            <cpu id="7" socket_id="1" core_id="1" siblings="5,7"/>`,
+	`          </cpus>`,
+	`        </cell>`,
+	`      </cells>`,
+	`    </topology>`,
+	`    <secmodel>`,
+	`      <model>selinux</model>`,
+	`      <doi>0</doi>`,
+	`    </secmodel>`,
+	`  </host>`,
+	`  <guest>`,
+	`    <os_type>hvm</os_type>`,
+	`    <arch name="x86_64">`,
+	`      <wordsize>64</wordsize>`,
+	`      <emulator>/usr/bin/qemu-system-x86_64</emulator>`,
+	`      <machine maxCpus="255">pc-i440fx-2.11</machine>`,
+	`      <machine canonical="pc-i440fx-2.11" maxCpus="255">pc</machine>`,
+	`      <domain type="qemu"/>`,
+	`      <domain type="kvm">`,
+	`        <machine maxCpus="288">pc-q35-2.11</machine>`,
+	`      </domain>`,
+	`    </arch>`,
+	`  </guest>`,
+	`</capabilities>`,
+}
+
+var capsCheckCellID0 uint = 0
+var capsCheckCellID1 uint = 1
+
+var capsCheckTestData = []struct {
+	Domain   *Domain
+	Expected *CapsFit
+	Feasible bool
+}{
+	{
+		Domain: &Domain{
+			Type: "kvm",
+			Memory: &DomainMemory{
+				Value: 2,
+				Unit:  "GiB",
+			},
+			MemoryBacking: &DomainMemoryBacking{
+				MemoryHugePages: &DomainMemoryHugepages{},
+			},
+			OS: &DomainOS{
+				Type: &DomainOSType{
+					Arch:    "x86_64",
+					Machine: "pc-q35-2.11",
+					Type:    "hvm",
+				},
+			},
+			NUMATune: &DomainNUMATune{
+				Memory: &DomainNUMATuneMemory{
+					Mode:    "strict",
+					Nodeset: "0",
+				},
+			},
+			CPUTune: &DomainCPUTune{
+				VCPUPin: []DomainCPUTuneVCPUPin{
+					DomainCPUTuneVCPUPin{
+						VCPU:   0,
+						CPUSet: "0,2",
+					},
+				},
+			},
+			SecLabel: []DomainSecLabel{
+				DomainSecLabel{
+					Type:  "dynamic",
+					Model: "selinux",
+				},
+			},
+		},
+		Expected: &CapsFit{
+			Arch:    true,
+			Machine: true,
+			Hugepages: []CapsFitHugepages{
+				CapsFitHugepages{
+					Nodes:     []uint{0},
+					Size:      2048,
+					Required:  1024,
+					Available: 1024,
+				},
+			},
+		},
+		Feasible: true,
+	},
+	{
+		Domain: &Domain{
+			Type: "qemu",
+			Memory: &DomainMemory{
+				Value: 4194304,
+			},
+			MemoryBacking: &DomainMemoryBacking{
+				MemoryHugePages: &DomainMemoryHugepages{
+					Hugepages: []DomainMemoryHugepage{
+						DomainMemoryHugepage{
+							Size:    1,
+							Unit:    "G",
+							Nodeset: "1",
+						},
+						DomainMemoryHugepage{
+							Size: 2,
+							Unit: "M",
+						},
+					},
+				},
+			},
+			OS: &DomainOS{
+				Type: &DomainOSType{
+					Arch:    "x86_64",
+					Machine: "pc-q35-2.11",
+				},
+			},
+			CPU: &DomainCPU{
+				Numa: &DomainNuma{
+					Cell: []DomainCell{
+						DomainCell{
+							ID:     &capsCheckCellID0,
+							CPUs:   "0-1",
+							Memory: "1048576",
+						},
+						DomainCell{
+							ID:     &capsCheckCellID1,
+							CPUs:   "2-3",
+							Memory: "3",
+							Unit:   "GiB",
+						},
+					},
+				},
+			},
+			NUMATune: &DomainNUMATune{
+				MemNodes: []DomainNUMATuneMemNode{
+					DomainNUMATuneMemNode{
+						CellID:  0,
+						Mode:    "strict",
+						Nodeset: "0",
+					},
+					DomainNUMATuneMemNode{
+						CellID:  1,
+						Mode:    "strict",
+						Nodeset: "1,3",
+					},
+				},
+			},
+			CPUTune: &DomainCPUTune{
+				EmulatorPin: &DomainCPUTuneEmulatorPin{
+					CPUSet: "6-9,^8",
+				},
+			},
+			SecLabel: []DomainSecLabel{
+				DomainSecLabel{
+					Type:  "dynamic",
+					Model: "apparmor",
+				},
+			},
+		},
+		Expected: &CapsFit{
+			Arch:             true,
+			Machine:          false,
+			MissingNUMANodes: []uint{3},
+			MissingCPUs:      []uint{9},
+			MissingSecModels: []string{"apparmor"},
+			Hugepages: []CapsFitHugepages{
+				CapsFitHugepages{
+					Nodes:     []uint{0},
+					Size:      2048,
+					Required:  512,
+					Available: 1024,
+				},
+				CapsFitHugepages{
+					Nodes:     []uint{1, 3},
+					Size:      1048576,
+					Required:  3,
+					Available: 2,
+				},
+			},
+		},
+		Feasible: false,
+	},
+}
+
+func TestCheckCaps(t *testing.T) {
+	caps := &Caps{}
+	err := caps.Unmarshal(strings.Join(capsCheckCaps, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range capsCheckTestData {
+		fit, err := CheckCaps(test.Domain, caps)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(fit, test.Expected) {
+			t.Fatalf("Bad result:\n%+v\n does not match\n%+v", fit, test.Expected)
+		}
+
+		if fit.Feasible() != test.Feasible {
+			t.Fatalf("Expected feasible %v for %+v", test.Feasible, fit)
+		}
+	}
+}
+
+func TestCheckCapsNoNUMA(t *testing.T) {
+	caps := &Caps{}
+	err := caps.Unmarshal(`<capabilities><host><cpu><arch>x86_64</arch></cpu></host></capabilities>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dom := &Domain{
+		NUMATune: &DomainNUMATune{
+			Memory: &DomainNUMATuneMemory{
+				Nodeset: "0-1",
+			},
+		},
+		VCPU: &DomainVCPU{
+			CPUSet: "0-3",
+		},
+	}
+	fit, err := CheckCaps(dom, caps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fit.MissingNUMANodes != nil || fit.MissingCPUs != nil {
+		t.Fatalf("Unexpected missing nodes %v or CPUs %v", fit.MissingNUMANodes, fit.MissingCPUs)
+	}
+}
+
+func TestCheckCapsHugepageSize(t *testing.T) {
+	caps := &Caps{}
+	err := caps.Unmarshal(strings.Join(capsCheckCaps, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, page := range []DomainMemoryHugepage{
+		DomainMemoryHugepage{Size: 0},
+		DomainMemoryHugepage{Size: 512, Unit: "b"},
+		DomainMemoryHugepage{Size: 1000, Unit: "bytes"},
+		DomainMemoryHugepage{Size: 1 << 30, Unit: "EiB"},
+	} {
+		dom := &Domain{
+			Memory: &DomainMemory{
+				Value: 1048576,
+			},
+			MemoryBacking: &DomainMemoryBacking{
+				MemoryHugePages: &DomainMemoryHugepages{
+					Hugepages: []DomainMemoryHugepage{page},
+				},
+			},
+		}
+		_, err := CheckCaps(dom, caps)
+		if err == nil {
+			t.Fatalf("Expected error for page size %d %s", page.Size, page.Unit)
+		}
+	}
+}
+
+func TestScaleToKiB(t *testing.T) {
+	val, err := scaleToKiB(2, "MiB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val != 2048 {
+		t.Fatalf("Expected 2048 KiB, got %d", val)
+	}
+
+	_, err = scaleToKiB(1<<60, "KiB")
+	if err == nil {
+		t.Fatal("Expected overflow error")
+	}
+}
+
+func TestBitmap(t *testing.T) {
+	bits, err := parseBitmap("0-3,^2, 8,10-11")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(bits, []uint{0, 1, 3, 8, 10, 11}) {
+		t.Fatal("Bad bitmap", bits)
+	}
+	if str := formatBitmap(bits); str != "0-1,3,8,10-11" {
+		t.Fatal("Bad bitmap format", str)
+	}
+
+	for _, str := range []string{"a", "3-1", "^1-2", "0-4000000000", "70000"} {
+		if _, err := parseBitmap(str); err == nil {
+			t.Fatal("Expected error parsing", str)
+		}
+	}
+}
@@ -0,0 +1,679 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CapsHostReader builds the host part of the capabilities from procfs
+// and sysfs, in the same way libvirtd does
+type CapsHostReader struct {
+	// Roots of the procfs and sysfs trees, "/proc" and "/sys" when
+	// empty
+	ProcRoot string
+	SysRoot  string
+	// Architecture of the host, derived from the running program when
+	// empty
+	Arch string
+	// Size of the system memory pages in KiB, the page size of the
+	// running system when zero
+	PageSize uint
+}
+
+var capsArchNames = map[string]string{
+	"386":     "i686",
+	"amd64":   "x86_64",
+	"arm":     "armv7l",
+	"arm64":   "aarch64",
+	"ppc64":   "ppc64",
+	"ppc64le": "ppc64le",
+	"s390x":   "s390x",
+}
+
+// Linux names of CPU flags which libvirt calls differently
+var capsCPUFlagNames = map[string]string{
+	"dts":                "ds",
+	"pclmulqdq":          "pclmuldq",
+	"sse4_1":             "sse4.1",
+	"sse4_2":             "sse4.2",
+	"tsc_deadline_timer": "tsc-deadline",
+	"cr8_legacy":         "cr8legacy",
+	"sha_ni":             "sha-ni",
+	"intel_pt":           "intel-pt",
+	"cqm":                "cmt",
+	"cqm_mbm_total":      "mbm_total",
+	"cqm_mbm_local":      "mbm_local",
+	"avx512_vbmi2":       "avx512vbmi2",
+	"avx512_vnni":        "avx512vnni",
+	"avx512_bitalg":      "avx512bitalg",
+	"avx512_vpopcntdq":   "avx512-vpopcntdq",
+	"avx512_4vnniw":      "avx512-4vnniw",
+	"avx512_4fmaps":      "avx512-4fmaps",
+	"md_clear":           "md-clear",
+	"spec_ctrl":          "spec-ctrl",
+	"arch_capabilities":  "arch-capabilities",
+	"amd_ssbd":           "amd-ssbd",
+	"virt_ssbd":          "virt-ssbd",
+}
+
+var capsCPUVendors = map[string]string{
+	"GenuineIntel": "Intel",
+	"AuthenticAMD": "AMD",
+}
+
+var capsCacheTypes = map[string]string{
+	"Unified":     "both",
+	"Data":        "data",
+	"Instruction": "code",
+}
+
+var capsNodeRegexp = regexp.MustCompile(`^node(\d+)$`)
+var capsHugepagesRegexp = regexp.MustCompile(`^hugepages-(\d+)kB$`)
+var capsCacheIndexRegexp = regexp.MustCompile(`^index\d+$`)
+
+type capsCPU struct {
+	id       uint
+	socket   *int
+	core     *int
+	siblings string
+}
+
+type capsHostReader struct {
+	proc     *sysfsReader
+	sys      *sysfsReader
+	pagesize uint
+}
+
+func capsReaderRoot(root, def string) (*sysfsReader, error) {
+	if root == "" {
+		root = def
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+	return &sysfsReader{
+		root: root,
+	}, nil
+}
+
+// capsCacheSize scales a size in KiB to the largest unit which
+// represents it exactly
+func capsCacheSize(kib uint) (uint, string) {
+	units := []string{"KiB", "MiB", "GiB"}
+	unit := 0
+	for kib != 0 && kib%1024 == 0 && unit < len(units)-1 {
+		kib /= 1024
+		unit++
+	}
+	return kib, units[unit]
+}
+
+// cpuinfo returns the fields describing the first processor
+func (r *capsHostReader) cpuinfo() (map[string]string, error) {
+	data, err := ioutil.ReadFile(r.proc.path("cpuinfo"))
+	if err != nil {
+		return nil, err
+	}
+
+	info := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(info) != 0 {
+				break
+			}
+			continue
+		}
+		sep := strings.Index(line, ":")
+		if sep == -1 {
+			continue
+		}
+		info[strings.TrimSpace(line[:sep])] = strings.TrimSpace(line[sep+1:])
+	}
+	return info, nil
+}
+
+func (r *capsHostReader) readCPUs(list string) ([]capsCPU, error) {
+	ids, err := parseBitmap(list)
+	if err != nil {
+		return nil, err
+	}
+	var cpus []capsCPU
+	for _, id := range ids {
+		dir := fmt.Sprintf("cpu%d", id)
+		cpu := capsCPU{
+			id: id,
+		}
+		siblings := r.sys.read("devices", "system", "cpu", dir, "topology", "thread_siblings_list")
+		if siblings != "" {
+			socket, err := strconv.Atoi(r.sys.read("devices", "system", "cpu", dir, "topology", "physical_package_id"))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid socket of CPU %d", id)
+			}
+			core, err := strconv.Atoi(r.sys.read("devices", "system", "cpu", dir, "topology", "core_id"))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid core of CPU %d", id)
+			}
+			bits, err := parseBitmap(siblings)
+			if err != nil {
+				return nil, err
+			}
+			cpu.socket = &socket
+			cpu.core = &core
+			cpu.siblings = formatBitmap(bits)
+		}
+		cpus = append(cpus, cpu)
+	}
+	return cpus, nil
+}
+
+func (r *capsHostReader) hugepages(elem ...string) (map[uint]uint64, error) {
+	names, err := r.sys.list(elem...)
+	if err != nil {
+		return nil, err
+	}
+	pages := make(map[uint]uint64)
+	for _, name := range names {
+		match := capsHugepagesRegexp.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+		size, err := strconv.ParseUint(match[1], 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		count, _ := r.sys.readUint(10, append(elem, name, "nr_hugepages")...)
+		pages[uint(size)] = uint64(count)
+	}
+	return pages, nil
+}
+
+func capsPageSizes(pages map[uint]uint64) []uint {
+	var sizes []uint
+	for size := range pages {
+		sizes = append(sizes, size)
+	}
+	sort.Sort(uintSlice(sizes))
+	return sizes
+}
+
+// memTotal returns MemTotal in KiB from a meminfo file
+func (r *capsHostReader) memTotal(reader *sysfsReader, elem ...string) (uint64, error) {
+	for _, line := range strings.Split(reader.read(elem...), "\n") {
+		fields := strings.Fields(line)
+		for i := 0; i+1 < len(fields); i++ {
+			if fields[i] == "MemTotal:" {
+				return strconv.ParseUint(fields[i+1], 10, 64)
+			}
+		}
+	}
+	return 0, fmt.Errorf("No MemTotal in %s", filepath.Join(elem...))
+}
+
+func (r *capsHostReader) cell(id int, cpulist string, memory uint64, pages map[uint]uint64) (*CapsHostNUMACell, error) {
+	cpus, err := r.readCPUs(cpulist)
+	if err != nil {
+		return nil, err
+	}
+	cell := &CapsHostNUMACell{
+		ID: id,
+		Memory: &CapsHostNUMAMemory{
+			Size: memory,
+			Unit: "KiB",
+		},
+		CPUS: &CapsHostNUMACPUs{
+			Num: uint(len(cpus)),
+		},
+	}
+
+	huge := uint64(0)
+	for size, count := range pages {
+		huge += uint64(size) * count
+	}
+	// Do not underflow when sysfs reports more huge pages than memory
+	if huge > memory {
+		huge = memory
+	}
+	cell.PageInfo = append(cell.PageInfo, CapsHostNUMAPageInfo{
+		Size:  int(r.pagesize),
+		Unit:  "KiB",
+		Count: (memory - huge) / uint64(r.pagesize),
+	})
+	for _, size := range capsPageSizes(pages) {
+		cell.PageInfo = append(cell.PageInfo, CapsHostNUMAPageInfo{
+			Size:  int(size),
+			Unit:  "KiB",
+			Count: pages[size],
+		})
+	}
+
+	for _, cpu := range cpus {
+		cell.CPUS.CPUs = append(cell.CPUS.CPUs, CapsHostNUMACPU{
+			ID:       int(cpu.id),
+			SocketID: cpu.socket,
+			CoreID:   cpu.core,
+			Siblings: cpu.siblings,
+		})
+	}
+	return cell, nil
+}
+
+func (r *capsHostReader) readNUMA() (*CapsHostNUMATopology, error) {
+	names, err := r.sys.list("devices", "system", "node")
+	if err != nil {
+		return nil, err
+	}
+	var nodes []uint
+	for _, name := range names {
+		match := capsNodeRegexp.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+		id, err := strconv.ParseUint(match[1], 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, uint(id))
+	}
+	sort.Sort(uintSlice(nodes))
+
+	topology := &CapsHostNUMATopology{
+		Cells: &CapsHostNUMACells{},
+	}
+
+	if len(nodes) == 0 {
+		// Hosts without NUMA are described as a single cell
+		memory, err := r.memTotal(r.proc, "meminfo")
+		if err != nil {
+			return nil, err
+		}
+		pages, err := r.hugepages("kernel", "mm", "hugepages")
+		if err != nil {
+			return nil, err
+		}
+		cell, err := r.cell(0, r.sys.read("devices", "system", "cpu", "online"), memory, pages)
+		if err != nil {
+			return nil, err
+		}
+		topology.Cells.Cells = append(topology.Cells.Cells, *cell)
+		topology.Cells.Num = 1
+		return topology, nil
+	}
+
+	for _, id := range nodes {
+		dir := fmt.Sprintf("node%d", id)
+		memory, err := r.memTotal(r.sys, "devices", "system", "node", dir, "meminfo")
+		if err != nil {
+			return nil, err
+		}
+		pages, err := r.hugepages("devices", "system", "node", dir, "hugepages")
+		if err != nil {
+			return nil, err
+		}
+		cell, err := r.cell(int(id), r.sys.read("devices", "system", "node", dir, "cpulist"), memory, pages)
+		if err != nil {
+			return nil, err
+		}
+
+		distances := strings.Fields(r.sys.read("devices", "system", "node", dir, "distance"))
+		if len(distances) == len(nodes) {
+			cell.Distances = &CapsHostNUMADistances{}
+			for i, distance := range distances {
+				value, err := strconv.Atoi(distance)
+				if err != nil {
+					return nil, fmt.Errorf("Invalid distance '%s' of NUMA node %d", distance, id)
+				}
+				cell.Distances.Siblings = append(cell.Distances.Siblings, CapsHostNUMASibling{
+					ID:    int(nodes[i]),
+					Value: value,
+				})
+			}
+		}
+		topology.Cells.Cells = append(topology.Cells.Cells, *cell)
+	}
+	topology.Cells.Num = uint(len(topology.Cells.Cells))
+	return topology, nil
+}
+
+func (r *capsHostReader) readCPU(arch string, numa *CapsHostNUMATopology) (*CapsHostCPU, error) {
+	cpu := &CapsHostCPU{
+		Arch: arch,
+	}
+
+	info, err := r.cpuinfo()
+	if err != nil {
+		return nil, err
+	}
+	if vendor, ok := capsCPUVendors[info["vendor_id"]]; ok {
+		cpu.Vendor = vendor
+	} else {
+		cpu.Vendor = info["vendor_id"]
+	}
+	if microcode, ok := info["microcode"]; ok {
+		version, err := strconv.ParseUint(strings.TrimPrefix(microcode, "0x"), 16, 0)
+		if err == nil {
+			cpu.Microcode = &CapsHostCPUMicrocode{
+				Version: int(version),
+			}
+		}
+	}
+
+	if cpumap, err := cpuMapForArch(arch); err == nil && info["flags"] != "" {
+		features := make(map[string]bool)
+		for _, flag := range strings.Fields(info["flags"]) {
+			if name, ok := capsCPUFlagNames[flag]; ok {
+				flag = name
+			}
+			if cpumap.KnownFeature(flag) {
+				features[flag] = true
+			}
+		}
+		// The host provides every feature of its model, so only the
+		// features it has on top are listed. Hosts which no model
+		// fits are reported without one.
+		if model, err := cpumap.decode(features, cpu.Vendor, false); err == nil {
+			cpu.Model = model.Model.Value
+			for _, feature := range model.Features {
+				cpu.FeatureFlags = append(cpu.FeatureFlags, CapsHostCPUFeatureFlag{
+					Name: feature.Name,
+				})
+			}
+		}
+	}
+
+	// Sockets are counted per NUMA cell
+	packages := make(map[int]bool)
+	var first *CapsHostNUMACPU
+	for _, cell := range numa.Cells.Cells {
+		for i := range cell.CPUS.CPUs {
+			host := &cell.CPUS.CPUs[i]
+			if host.SocketID == nil {
+				continue
+			}
+			if first == nil {
+				first = host
+			}
+			packages[*host.SocketID] = true
+		}
+	}
+	if first != nil {
+		siblings, err := parseBitmap(first.Siblings)
+		if err != nil {
+			return nil, err
+		}
+		threads := len(siblings)
+		cpus := 0
+		for _, cell := range numa.Cells.Cells {
+			for _, host := range cell.CPUS.CPUs {
+				if host.SocketID != nil && *host.SocketID == *first.SocketID {
+					cpus++
+				}
+			}
+		}
+		sockets := len(packages) / len(numa.Cells.Cells)
+		if sockets == 0 {
+			sockets = 1
+		}
+		cpu.Topology = &CapsHostCPUTopology{
+			Sockets: sockets,
+			Cores:   cpus / threads,
+			Threads: threads,
+		}
+	}
+
+	cpu.PageSizes = append(cpu.PageSizes, CapsHostCPUPageSize{
+		Size: int(r.pagesize),
+		Unit: "KiB",
+	})
+	pages, err := r.hugepages("kernel", "mm", "hugepages")
+	if err != nil {
+		return nil, err
+	}
+	for _, size := range capsPageSizes(pages) {
+		cpu.PageSizes = append(cpu.PageSizes, CapsHostCPUPageSize{
+			Size: int(size),
+			Unit: "KiB",
+		})
+	}
+	return cpu, nil
+}
+
+func (r *capsHostReader) cacheControl(level uint, typ string, size uint) []CapsHostCacheControl {
+	var infos []string
+	var types []string
+	if r.sys.exists("fs", "resctrl", "info", fmt.Sprintf("L%dCODE", level)) {
+		infos = []string{fmt.Sprintf("L%dCODE", level), fmt.Sprintf("L%dDATA", level)}
+		types = []string{"code", "data"}
+	} else if r.sys.exists("fs", "resctrl", "info", fmt.Sprintf("L%d", level)) {
+		infos = []string{fmt.Sprintf("L%d", level)}
+		types = []string{"both"}
+	}
+
+	var controls []CapsHostCacheControl
+	for i, info := range infos {
+		if typ != "both" && typ != types[i] {
+			continue
+		}
+		mask, ok := r.sys.readUint(16, "fs", "resctrl", "info", info, "cbm_mask")
+		if !ok || mask == 0 {
+			continue
+		}
+		bits := uint(0)
+		for ; mask != 0; mask >>= 1 {
+			bits += uint(mask & 1)
+		}
+		closids, _ := r.sys.readUint(10, "fs", "resctrl", "info", info, "num_closids")
+		minbits, _ := r.sys.readUint(10, "fs", "resctrl", "info", info, "min_cbm_bits")
+
+		granularity := size / bits
+		control := CapsHostCacheControl{
+			Type:      types[i],
+			MaxAllows: closids,
+		}
+		control.Granularity, control.Unit = capsCacheSize(granularity)
+		if minbits > 1 {
+			control.Min = control.Granularity * minbits
+		}
+		controls = append(controls, control)
+	}
+	return controls
+}
+
+func (r *capsHostReader) readCache() (*CapsHostCache, error) {
+	online := r.sys.read("devices", "system", "cpu", "online")
+	if online == "" {
+		return nil, nil
+	}
+	cpus, err := parseBitmap(online)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &CapsHostCache{}
+	seen := make(map[string]bool)
+	for _, cpu := range cpus {
+		dir := filepath.Join("devices", "system", "cpu", fmt.Sprintf("cpu%d", cpu), "cache")
+		names, err := r.sys.list(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if !capsCacheIndexRegexp.MatchString(name) {
+				continue
+			}
+			index := filepath.Join(dir, name)
+			level, _ := r.sys.readUint(10, index, "level")
+			// Like libvirt, only the caches which resctrl can
+			// partition are reported
+			if level < 3 {
+				continue
+			}
+			typ, ok := capsCacheTypes[r.sys.read(index, "type")]
+			if !ok {
+				continue
+			}
+			id, _ := r.sys.readUint(10, index, "id")
+			key := fmt.Sprintf("%d/%s/%d", level, typ, id)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			size, err := parseUnitSize(r.sys.read(index, "size"))
+			if err != nil {
+				return nil, err
+			}
+			shared, err := parseBitmap(r.sys.read(index, "shared_cpu_list"))
+			if err != nil {
+				return nil, err
+			}
+			bank := CapsHostCacheBank{
+				ID:      id,
+				Level:   level,
+				Type:    typ,
+				CPUs:    formatBitmap(shared),
+				Control: r.cacheControl(level, typ, size),
+			}
+			bank.Size, bank.Unit = capsCacheSize(size)
+			cache.Banks = append(cache.Banks, bank)
+		}
+	}
+	if len(cache.Banks) == 0 {
+		return nil, nil
+	}
+	sort.Sort(capsCacheBankList(cache.Banks))
+	return cache, nil
+}
+
+type capsCacheBankList []CapsHostCacheBank
+
+func (l capsCacheBankList) Len() int      { return len(l) }
+func (l capsCacheBankList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l capsCacheBankList) Less(i, j int) bool {
+	if l[i].Level != l[j].Level {
+		return l[i].Level < l[j].Level
+	}
+	if l[i].ID != l[j].ID {
+		return l[i].ID < l[j].ID
+	}
+	return l[i].Type < l[j].Type
+}
+
+// parseUnitSize parses cache sizes such as "30720K" into KiB
+func parseUnitSize(str string) (uint, error) {
+	scale := uint64(1)
+	num := str
+	if strings.HasSuffix(str, "K") {
+		num = str[:len(str)-1]
+	} else if strings.HasSuffix(str, "M") {
+		num = str[:len(str)-1]
+		scale = 1024
+	}
+	value, err := strconv.ParseUint(num, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid cache size '%s'", str)
+	}
+	return uint(value * scale), nil
+}
+
+// Caps reads the host capabilities. Only the host part is filled in,
+// as guest capabilities depend on the installed hypervisors.
+func (c *CapsHostReader) Caps() (*Caps, error) {
+	proc, err := capsReaderRoot(c.ProcRoot, "/proc")
+	if err != nil {
+		return nil, err
+	}
+	sys, err := capsReaderRoot(c.SysRoot, "/sys")
+	if err != nil {
+		return nil, err
+	}
+	r := &capsHostReader{
+		proc:     proc,
+		sys:      sys,
+		pagesize: c.PageSize,
+	}
+	if r.pagesize == 0 {
+		r.pagesize = uint(os.Getpagesize() / 1024)
+	}
+	arch := c.Arch
+	if arch == "" {
+		arch = capsArchNames[runtime.GOARCH]
+	}
+
+	caps := &Caps{}
+	caps.Host.UUID = sys.read("class", "dmi", "id", "product_uuid")
+
+	caps.Host.NUMA, err = r.readNUMA()
+	if err != nil {
+		return nil, err
+	}
+	caps.Host.CPU, err = r.readCPU(arch, caps.Host.NUMA)
+	if err != nil {
+		return nil, err
+	}
+
+	states := strings.Fields(sys.read("power", "state"))
+	for _, state := range states {
+		if caps.Host.PowerManagement == nil {
+			caps.Host.PowerManagement = &CapsHostPowerManagement{}
+		}
+		if state == "mem" {
+			caps.Host.PowerManagement.SuspendMem = &CapsHostPowerManagementMode{}
+		} else if state == "disk" {
+			caps.Host.PowerManagement.SuspendDisk = &CapsHostPowerManagementMode{}
+		}
+	}
+
+	groups, err := sys.list("kernel", "iommu_groups")
+	if err != nil {
+		return nil, err
+	}
+	caps.Host.IOMMU = &CapsHostIOMMU{
+		Support: "no",
+	}
+	if len(groups) != 0 {
+		caps.Host.IOMMU.Support = "yes"
+	}
+
+	caps.Host.Cache, err = r.readCache()
+	if err != nil {
+		return nil, err
+	}
+	return caps, nil
+}
@@ -0,0 +1,153 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var capsHostTestXML = []string{
+	`<capabilities>`,
+	`  <host>`,
+	`    <uuid>c7a5fdbd-edaf-9455-926a-d65c16db1809</uuid>`,
+	`    <cpu>`,
+	`      <arch>x86_64</arch>`,
+	`      <model>Haswell-noTSX</model>`,
+	`      <vendor>Intel</vendor>`,
+	`      <topology sockets="1" cores="2" threads="2"></topology>`,
+	`      <feature name="acpi"></feature>`,
+	`      <feature name="arat"></feature>`,
+	`      <feature name="cmt"></feature>`,
+	`      <feature name="dca"></feature>`,
+	`      <feature name="ds"></feature>`,
+	`      <feature name="ds_cpl"></feature>`,
+	`      <feature name="dtes64"></feature>`,
+	`      <feature name="est"></feature>`,
+	`      <feature name="ht"></feature>`,
+	`      <feature name="monitor"></feature>`,
+	`      <feature name="pbe"></feature>`,
+	`      <feature name="pdcm"></feature>`,
+	`      <feature name="pdpe1gb"></feature>`,
+	`      <feature name="smx"></feature>`,
+	`      <feature name="ss"></feature>`,
+	`      <feature name="tm"></feature>`,
+	`      <feature name="tm2"></feature>`,
+	`      <feature name="tsc_adjust"></feature>`,
+	`      <feature name="vme"></feature>`,
+	`      <feature name="vmx"></feature>`,
+	`      <feature name="xtpr"></feature>`,
+	`      <pages size="4" unit="KiB"></pages>`,
+	`      <pages size="2048" unit="KiB"></pages>`,
+	`      <pages size="1048576" unit="KiB"></pages>`,
+	`      <microcode version="60"></microcode>`,
+	`    </cpu>`,
+	`    <power_management>`,
+	`      <suspend_mem></suspend_mem>`,
+	`      <suspend_disk></suspend_disk>`,
+	`    </power_management>`,
+	`    <iommu support="yes"></iommu>`,
+	`    <topology>`,
+	`      <cells num="2">`,
+	`        <cell id="0">`,
+	`          <memory unit="KiB">8388608</memory>`,
+	`          <pages size="4" unit="KiB">1835008</pages>`,
+	`          <pages size="2048" unit="KiB">512</pages>`,
+	`          <pages size="1048576" unit="KiB">0</pages>`,
+	`          <distances>`,
+	`            <sibling id="0" value="10"></sibling>`,
+	`            <sibling id="1" value="21"></sibling>`,
+	`          </distances>`,
+	`          <cpus num="4">`,
+	`            <cpu id="0" socket_id="0" core_id="0" siblings="0-1"></cpu>`,
+	`            <cpu id="1" socket_id="0" core_id="0" siblings="0-1"></cpu>`,
+	`            <cpu id="2" socket_id="0" core_id="1" siblings="2-3"></cpu>`,
+	`            <cpu id="3" socket_id="0" core_id="1" siblings="2-3"></cpu>`,
+	`          </cpus>`,
+	`        </cell>`,
+	`        <cell id="1">`,
+	`          <memory unit="KiB">8388608</memory>`,
+	`          <pages size="4" unit="KiB">1572864</pages>`,
+	`          <pages size="2048" unit="KiB">0</pages>`,
+	`          <pages size="1048576" unit="KiB">2</pages>`,
+	`          <distances>`,
+	`            <sibling id="0" value="21"></sibling>`,
+	`            <sibling id="1" value="10"></sibling>`,
+	`          </distances>`,
+	`          <cpus num="4">`,
+	`            <cpu id="4" socket_id="1" core_id="0" siblings="4-5"></cpu>`,
+	`            <cpu id="5" socket_id="1" core_id="0" siblings="4-5"></cpu>`,
+	`            <cpu id="6" socket_id="1" core_id="1" siblings="6-7"></cpu>`,
+	`            <cpu id="7" socket_id="1" core_id="1" siblings="6-7"></cpu>`,
+	`          </cpus>`,
+	`        </cell>`,
+	`      </cells>`,
+	`    </topology>`,
+	`    <cache>`,
+	`      <bank id="0" level="3" type="both" size="30" unit="MiB" cpus="0-3">`,
+	`        <control granularity="1536" min="3072" unit="KiB" type="both" maxAllocs="16"></control>`,
+	`      </bank>`,
+	`      <bank id="1" level="3" type="both" size="30" unit="MiB" cpus="4-7">`,
+	`        <control granularity="1536" min="3072" unit="KiB" type="both" maxAllocs="16"></control>`,
+	`      </bank>`,
+	`    </cache>`,
+	`  </host>`,
+	`</capabilities>`,
+}
+
+func TestCapsHostReader(t *testing.T) {
+	reader := &CapsHostReader{
+		ProcRoot: "testdata/proc",
+		SysRoot:  "testdata/sysfs",
+		Arch:     "x86_64",
+		PageSize: 4,
+	}
+	caps, err := reader.Caps()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := caps.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := strings.Join(capsHostTestXML, "\n")
+	if doc != expect {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestCapsHostReaderMissingRoot(t *testing.T) {
+	reader := &CapsHostReader{
+		ProcRoot: "testdata/nonexistent",
+		SysRoot:  "testdata/sysfs",
+	}
+	_, err := reader.Caps()
+	if err == nil {
+		t.Fatal("Expected error for missing procfs root")
+	}
+}
@@ -0,0 +1,291 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	CPUCompareIncompatible = "incompatible"
+	CPUCompareIdentical    = "identical"
+	CPUCompareSuperset     = "superset"
+)
+
+// CPUComparison is the result of CompareCPU
+type CPUComparison struct {
+	// One of CPUCompareIncompatible, CPUCompareIdentical or
+	// CPUCompareSuperset, the latter meaning the host provides more
+	// features than the guest CPU needs
+	Result string
+	// Features the guest requires but the host lacks
+	Missing []string
+	// Features the guest forbids but the host has
+	Forbidden []string
+}
+
+// hostFeatures returns the features of the host model and the
+// additional feature flags. A host model missing from the map, such
+// as one newer than it, leaves only the feature flags to go by.
+func (m *CPUMap) hostFeatures(host *CapsHostCPU) (map[string]bool, error) {
+	features := make(map[string]bool)
+	if host.Model != "" && m.Model(host.Model) != nil {
+		err := m.modelFeatures(host.Model, features)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, flag := range host.FeatureFlags {
+		features[flag.Name] = true
+	}
+	return features, nil
+}
+
+// decode finds the model describing features with the fewest
+// additional required or disabled features. Models of a specific
+// vendor are only considered when vendor matches, and models needing
+// features to be disabled only when allowDisable is set.
+func (m *CPUMap) decode(features map[string]bool, vendor string, allowDisable bool) (*DomainCPU, error) {
+	var best *DomainCPU
+	bestCost := -1
+	bestDisabled := 0
+	for _, model := range m.Models {
+		if model.Vendor != "" && model.Vendor != vendor {
+			continue
+		}
+		modelFeatures := make(map[string]bool)
+		err := m.modelFeatures(model.Name, modelFeatures)
+		if err != nil {
+			return nil, err
+		}
+
+		var require, disable []string
+		for feature := range features {
+			if !modelFeatures[feature] {
+				require = append(require, feature)
+			}
+		}
+		for feature := range modelFeatures {
+			if !features[feature] {
+				disable = append(disable, feature)
+			}
+		}
+		if !allowDisable && len(disable) != 0 {
+			continue
+		}
+
+		// Later models in the map are newer, so prefer them on a tie
+		cost := len(require) + len(disable)
+		if best != nil && (cost > bestCost ||
+			(cost == bestCost && len(disable) > bestDisabled)) {
+			continue
+		}
+
+		sort.Strings(require)
+		sort.Strings(disable)
+		cpu := &DomainCPU{
+			Mode:  "custom",
+			Match: "exact",
+			Model: &DomainCPUModel{
+				Fallback: "forbid",
+				Value:    model.Name,
+			},
+			Vendor: vendor,
+		}
+		for _, feature := range require {
+			cpu.Features = append(cpu.Features, DomainCPUFeature{
+				Policy: "require",
+				Name:   feature,
+			})
+		}
+		for _, feature := range disable {
+			cpu.Features = append(cpu.Features, DomainCPUFeature{
+				Policy: "disable",
+				Name:   feature,
+			})
+		}
+		best = cpu
+		bestCost = cost
+		bestDisabled = len(disable)
+	}
+	if best == nil {
+		return nil, fmt.Errorf("No CPU model matches vendor '%s'", vendor)
+	}
+	return best, nil
+}
+
+// BaselineCPU computes the best CPU definition which every one of
+// hosts can run, such as for a pool of hosts a guest may be migrated
+// between.
+func BaselineCPU(hosts []CapsHostCPU) (*DomainCPU, error) {
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("No host CPUs to compute a baseline for")
+	}
+
+	cpumap, err := cpuMapForArch(hosts[0].Arch)
+	if err != nil {
+		return nil, err
+	}
+
+	var common map[string]bool
+	vendor := hosts[0].Vendor
+	for i := range hosts {
+		hostmap, err := cpuMapForArch(hosts[i].Arch)
+		if err != nil {
+			return nil, err
+		}
+		if hostmap != cpumap {
+			return nil, fmt.Errorf("Host CPUs have different architectures")
+		}
+		if hosts[i].Vendor != vendor {
+			vendor = ""
+		}
+		features, err := cpumap.hostFeatures(&hosts[i])
+		if err != nil {
+			return nil, err
+		}
+		if common == nil {
+			common = features
+			continue
+		}
+		for feature := range common {
+			if !features[feature] {
+				delete(common, feature)
+			}
+		}
+	}
+
+	return cpumap.decode(common, vendor, true)
+}
+
+// guestFeatures resolves the features a guest CPU definition requires
+// and forbids.
+func (m *CPUMap) guestFeatures(cpu *DomainCPU) (map[string]bool, map[string]bool, error) {
+	required := make(map[string]bool)
+	forbidden := make(map[string]bool)
+	if cpu.Model != nil && cpu.Model.Value != "" {
+		err := m.modelFeatures(cpu.Model.Value, required)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, feature := range cpu.Features {
+		switch feature.Policy {
+		case "", "require", "force":
+			required[feature.Name] = true
+		case "disable":
+			delete(required, feature.Name)
+		case "forbid":
+			delete(required, feature.Name)
+			forbidden[feature.Name] = true
+		case "optional":
+		default:
+			return nil, nil, fmt.Errorf("Unknown policy '%s' of CPU feature '%s'", feature.Policy, feature.Name)
+		}
+	}
+	return required, forbidden, nil
+}
+
+// CompareCPU checks whether host can provide the guest CPU, following
+// the match mode of the guest: features the guest does not mention
+// make the host a superset, except with "strict" where they make it
+// incompatible. Forced features need not be provided by the host.
+func CompareCPU(guest *DomainCPU, host *CapsHostCPU) (*CPUComparison, error) {
+	if guest.Mode == "host-passthrough" || guest.Mode == "host-model" {
+		return &CPUComparison{
+			Result: CPUCompareIdentical,
+		}, nil
+	}
+
+	strict := false
+	switch guest.Match {
+	case "", "minimum", "exact":
+	case "strict":
+		strict = true
+	default:
+		return nil, fmt.Errorf("Unknown CPU match '%s'", guest.Match)
+	}
+
+	cpumap, err := cpuMapForArch(host.Arch)
+	if err != nil {
+		return nil, err
+	}
+
+	hostFeatures, err := cpumap.hostFeatures(host)
+	if err != nil {
+		return nil, err
+	}
+	required, forbidden, err := cpumap.guestFeatures(guest)
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[string]bool)
+	for _, feature := range guest.Features {
+		listed[feature.Name] = true
+		if feature.Policy == "force" || feature.Policy == "optional" {
+			delete(required, feature.Name)
+		}
+	}
+
+	result := &CPUComparison{
+		Result: CPUCompareIdentical,
+	}
+	if guest.Vendor != "" && host.Vendor != "" && guest.Vendor != host.Vendor {
+		result.Result = CPUCompareIncompatible
+	}
+
+	for feature := range required {
+		if !hostFeatures[feature] {
+			result.Missing = append(result.Missing, feature)
+		}
+	}
+	for feature := range forbidden {
+		if hostFeatures[feature] {
+			result.Forbidden = append(result.Forbidden, feature)
+		}
+	}
+	sort.Strings(result.Missing)
+	sort.Strings(result.Forbidden)
+
+	if len(result.Missing) != 0 || len(result.Forbidden) != 0 {
+		result.Result = CPUCompareIncompatible
+	}
+	if result.Result == CPUCompareIdentical {
+		for feature := range hostFeatures {
+			if required[feature] || listed[feature] {
+				continue
+			}
+			if strict {
+				result.Result = CPUCompareIncompatible
+			} else {
+				result.Result = CPUCompareSuperset
+			}
+			break
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,339 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCPUMapFeatures(t *testing.T) {
+	for _, model := range CPUMapX86.Models {
+		if model.Parent != "" && CPUMapX86.Model(model.Parent) == nil {
+			t.Fatalf("Model %s has unknown parent %s", model.Name, model.Parent)
+		}
+		for _, feature := range model.Features {
+			if !CPUMapX86.KnownFeature(feature) {
+				t.Fatalf("Model %s has unknown feature %s", model.Name, feature)
+			}
+		}
+	}
+}
+
+var cpuBaselineTestData = []struct {
+	Hosts    []CapsHostCPU
+	Expected []string
+}{
+	{
+		Hosts: []CapsHostCPU{
+			CapsHostCPU{
+				Arch:   "x86_64",
+				Model:  "Haswell-noTSX",
+				Vendor: "Intel",
+				FeatureFlags: []CapsHostCPUFeatureFlag{
+					CapsHostCPUFeatureFlag{Name: "vmx"},
+					CapsHostCPUFeatureFlag{Name: "ds"},
+				},
+			},
+			CapsHostCPU{
+				Arch:   "x86_64",
+				Model:  "Broadwell",
+				Vendor: "Intel",
+				FeatureFlags: []CapsHostCPUFeatureFlag{
+					CapsHostCPUFeatureFlag{Name: "vmx"},
+					CapsHostCPUFeatureFlag{Name: "invtsc"},
+				},
+			},
+		},
+		Expected: []string{
+			`<cpu match="exact" mode="custom">`,
+			`  <model fallback="forbid">Haswell-noTSX</model>`,
+			`  <vendor>Intel</vendor>`,
+			`  <feature policy="require" name="vmx"></feature>`,
+			`</cpu>`,
+		},
+	},
+	{
+		Hosts: []CapsHostCPU{
+			CapsHostCPU{
+				Arch:   "x86_64",
+				Model:  "Skylake-Client",
+				Vendor: "Intel",
+			},
+			CapsHostCPU{
+				Arch:   "x86_64",
+				Model:  "Westmere",
+				Vendor: "Intel",
+				FeatureFlags: []CapsHostCPUFeatureFlag{
+					CapsHostCPUFeatureFlag{Name: "avx"},
+				},
+			},
+		},
+		Expected: []string{
+			`<cpu match="exact" mode="custom">`,
+			`  <model fallback="forbid">Westmere</model>`,
+			`  <vendor>Intel</vendor>`,
+			`  <feature policy="require" name="avx"></feature>`,
+			`</cpu>`,
+		},
+	},
+	{
+		Hosts: []CapsHostCPU{
+			CapsHostCPU{
+				Arch:   "x86_64",
+				Model:  "SandyBridge",
+				Vendor: "Intel",
+			},
+			CapsHostCPU{
+				Arch:   "x86_64",
+				Model:  "Opteron_G4",
+				Vendor: "AMD",
+			},
+		},
+		Expected: []string{
+			`<cpu match="exact" mode="custom">`,
+			`  <model fallback="forbid">kvm64</model>`,
+			`  <feature policy="require" name="aes"></feature>`,
+			`  <feature policy="require" name="avx"></feature>`,
+			`  <feature policy="require" name="lahf_lm"></feature>`,
+			`  <feature policy="require" name="pclmuldq"></feature>`,
+			`  <feature policy="require" name="popcnt"></feature>`,
+			`  <feature policy="require" name="rdtscp"></feature>`,
+			`  <feature policy="require" name="sse4.1"></feature>`,
+			`  <feature policy="require" name="sse4.2"></feature>`,
+			`  <feature policy="require" name="ssse3"></feature>`,
+			`  <feature policy="require" name="xsave"></feature>`,
+			`</cpu>`,
+		},
+	},
+}
+
+func TestBaselineCPU(t *testing.T) {
+	for _, test := range cpuBaselineTestData {
+		cpu, err := BaselineCPU(test.Hosts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		doc, err := cpu.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := strings.Join(test.Expected, "\n")
+
+		if doc != expect {
+			t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+		}
+
+		for _, host := range test.Hosts {
+			result, err := CompareCPU(cpu, &host)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Result == CPUCompareIncompatible {
+				t.Fatalf("Baseline %s is incompatible with host %s: %+v", cpu.Model.Value, host.Model, result)
+			}
+		}
+	}
+}
+
+var cpuCompareHost = &CapsHostCPU{
+	Arch:   "x86_64",
+	Model:  "Haswell-noTSX",
+	Vendor: "Intel",
+	FeatureFlags: []CapsHostCPUFeatureFlag{
+		CapsHostCPUFeatureFlag{Name: "vmx"},
+	},
+}
+
+var cpuCompareTestData = []struct {
+	Guest    *DomainCPU
+	Expected *CPUComparison
+}{
+	{
+		Guest: &DomainCPU{
+			Mode: "custom",
+			Model: &DomainCPUModel{
+				Value: "Haswell-noTSX",
+			},
+			Features: []DomainCPUFeature{
+				DomainCPUFeature{Policy: "require", Name: "vmx"},
+			},
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareIdentical,
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Model: &DomainCPUModel{
+				Value: "IvyBridge",
+			},
+			Features: []DomainCPUFeature{
+				DomainCPUFeature{Policy: "disable", Name: "rdrand"},
+				DomainCPUFeature{Policy: "optional", Name: "svm"},
+			},
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareSuperset,
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Mode: "custom",
+			Model: &DomainCPUModel{
+				Value: "Broadwell",
+			},
+			Features: []DomainCPUFeature{
+				DomainCPUFeature{Policy: "forbid", Name: "vmx"},
+			},
+		},
+		Expected: &CPUComparison{
+			Result:    CPUCompareIncompatible,
+			Missing:   []string{"3dnowprefetch", "adx", "hle", "rdseed", "rtm", "smap"},
+			Forbidden: []string{"vmx"},
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Model: &DomainCPUModel{
+				Value: "Nehalem",
+			},
+			Vendor: "AMD",
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareIncompatible,
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Match: "minimum",
+			Model: &DomainCPUModel{
+				Value: "IvyBridge",
+			},
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareSuperset,
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Match: "strict",
+			Model: &DomainCPUModel{
+				Value: "IvyBridge",
+			},
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareIncompatible,
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Match: "strict",
+			Model: &DomainCPUModel{
+				Value: "Haswell-noTSX",
+			},
+			Features: []DomainCPUFeature{
+				DomainCPUFeature{Policy: "disable", Name: "vmx"},
+				DomainCPUFeature{Policy: "force", Name: "avx512f"},
+			},
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareIdentical,
+		},
+	},
+	{
+		Guest: &DomainCPU{
+			Mode: "host-passthrough",
+		},
+		Expected: &CPUComparison{
+			Result: CPUCompareIdentical,
+		},
+	},
+}
+
+func TestCompareCPU(t *testing.T) {
+	for _, test := range cpuCompareTestData {
+		result, err := CompareCPU(test.Guest, cpuCompareHost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(result, test.Expected) {
+			t.Fatalf("Bad comparison:\n%+v\n does not match\n%+v", result, test.Expected)
+		}
+	}
+
+	_, err := CompareCPU(&DomainCPU{Model: &DomainCPUModel{Value: "Bogus"}}, cpuCompareHost)
+	if err == nil {
+		t.Fatal("Expected error for unknown CPU model")
+	}
+}
+
+func TestCompareCPUHostModel(t *testing.T) {
+	guest := &DomainCPU{
+		Match: "exact",
+		Model: &DomainCPUModel{
+			Value: "Skylake-Server",
+		},
+	}
+
+	host := &CapsHostCPU{
+		Arch:   "x86_64",
+		Model:  "Skylake-Server-IBRS",
+		Vendor: "Intel",
+	}
+	result, err := CompareCPU(guest, host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Result != CPUCompareSuperset {
+		t.Fatalf("Expected superset, got %+v", result)
+	}
+
+	// Models missing from the map fall back to the feature flags
+	host = &CapsHostCPU{
+		Arch:   "x86_64",
+		Model:  "Icelake-Server",
+		Vendor: "Intel",
+		FeatureFlags: []CapsHostCPUFeatureFlag{
+			CapsHostCPUFeatureFlag{Name: "avx512vnni"},
+			CapsHostCPUFeatureFlag{Name: "vmx"},
+		},
+	}
+	result, err = CompareCPU(&DomainCPU{
+		Features: []DomainCPUFeature{
+			DomainCPUFeature{Policy: "require", Name: "vmx"},
+		},
+	}, host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Result != CPUCompareSuperset {
+		t.Fatalf("Expected superset, got %+v", result)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ExpandCPU resolves the model of a custom or host-model CPU definition
+// and returns its effective features. Every feature the guest gets is
+// listed with the "require" policy, followed by the features turned off
+// with the "disable" or "forbid" policy, each group sorted by name.
+func (m *CPUMap) ExpandCPU(cpu *DomainCPU) ([]DomainCPUFeature, error) {
+	if cpu.Mode == "host-passthrough" {
+		return nil, fmt.Errorf("Cannot expand a host-passthrough CPU")
+	}
+	if cpu.Mode == "host-model" && (cpu.Model == nil || cpu.Model.Value == "") {
+		return nil, fmt.Errorf("Cannot expand a host-model CPU without a model")
+	}
+
+	disabled := make(map[string]string)
+	for _, feature := range cpu.Features {
+		if !m.KnownFeature(feature.Name) {
+			return nil, fmt.Errorf("Unknown CPU feature '%s'", feature.Name)
+		}
+		switch feature.Policy {
+		case "disable", "forbid":
+			disabled[feature.Name] = feature.Policy
+		default:
+			delete(disabled, feature.Name)
+		}
+	}
+
+	required, _, err := m.guestFeatures(cpu)
+	if err != nil {
+		return nil, err
+	}
+
+	var features []DomainCPUFeature
+	for _, name := range cpuFeatureList(required) {
+		features = append(features, DomainCPUFeature{
+			Policy: "require",
+			Name:   name,
+		})
+	}
+
+	names := make([]string, 0, len(disabled))
+	for name := range disabled {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		features = append(features, DomainCPUFeature{
+			Policy: disabled[name],
+			Name:   name,
+		})
+	}
+	return features, nil
+}
+
+// CanonicalCPU returns the minimal custom CPU definition providing
+// exactly features, which makes CPU definitions written against
+// different models comparable.
+func (m *CPUMap) CanonicalCPU(features []string, vendor string) (*DomainCPU, error) {
+	set := make(map[string]bool)
+	for _, feature := range features {
+		if !m.KnownFeature(feature) {
+			return nil, fmt.Errorf("Unknown CPU feature '%s'", feature)
+		}
+		set[feature] = true
+	}
+	return m.decode(set, vendor, true)
+}
+
+// ExpandCPU expands an x86 CPU definition, see CPUMap.ExpandCPU
+func ExpandCPU(cpu *DomainCPU) ([]DomainCPUFeature, error) {
+	return CPUMapX86.ExpandCPU(cpu)
+}
+
+// CanonicalCPU builds a minimal x86 CPU definition, see
+// CPUMap.CanonicalCPU
+func CanonicalCPU(features []string, vendor string) (*DomainCPU, error) {
+	return CPUMapX86.CanonicalCPU(features, vendor)
+}
@@ -0,0 +1,135 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestExpandCPU(t *testing.T) {
+	cpu := &DomainCPU{
+		Mode: "custom",
+		Model: &DomainCPUModel{
+			Value: "Westmere",
+		},
+		Vendor: "Intel",
+		Features: []DomainCPUFeature{
+			DomainCPUFeature{Policy: "disable", Name: "aes"},
+			DomainCPUFeature{Policy: "require", Name: "vmx"},
+			DomainCPUFeature{Policy: "forbid", Name: "svm"},
+			DomainCPUFeature{Policy: "optional", Name: "invtsc"},
+		},
+	}
+
+	features, err := ExpandCPU(cpu)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model, err := CPUMapX86.ModelFeatures("Westmere")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expect []DomainCPUFeature
+	required := []string{"vmx"}
+	for _, name := range model {
+		if name != "aes" {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+	for _, name := range required {
+		expect = append(expect, DomainCPUFeature{Policy: "require", Name: name})
+	}
+	expect = append(expect,
+		DomainCPUFeature{Policy: "disable", Name: "aes"},
+		DomainCPUFeature{Policy: "forbid", Name: "svm"})
+
+	if !reflect.DeepEqual(features, expect) {
+		t.Fatalf("Bad features:\n%v\n does not match\n%v", features, expect)
+	}
+
+	canon, err := CanonicalCPU(required, "Intel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if canon.Model.Value != "Westmere" {
+		t.Fatalf("Expected Westmere model, got %s", canon.Model.Value)
+	}
+	expectCanon := []DomainCPUFeature{
+		DomainCPUFeature{Policy: "require", Name: "vmx"},
+		DomainCPUFeature{Policy: "disable", Name: "aes"},
+	}
+	if !reflect.DeepEqual(canon.Features, expectCanon) {
+		t.Fatalf("Bad canonical features:\n%v\n does not match\n%v", canon.Features, expectCanon)
+	}
+
+	roundtrip, err := ExpandCPU(canon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(roundtrip[:len(required)], expect[:len(required)]) {
+		t.Fatalf("Bad expansion of canonical CPU:\n%v", roundtrip)
+	}
+}
+
+var cpuExpandErrorTestData = []*DomainCPU{
+	&DomainCPU{
+		Mode: "host-passthrough",
+	},
+	&DomainCPU{
+		Mode: "host-model",
+	},
+	&DomainCPU{
+		Model: &DomainCPUModel{
+			Value: "Bogus",
+		},
+	},
+	&DomainCPU{
+		Model: &DomainCPUModel{
+			Value: "Westmere",
+		},
+		Features: []DomainCPUFeature{
+			DomainCPUFeature{Policy: "require", Name: "bogus"},
+		},
+	},
+}
+
+func TestExpandCPUErrors(t *testing.T) {
+	for _, cpu := range cpuExpandErrorTestData {
+		_, err := ExpandCPU(cpu)
+		if err == nil {
+			t.Fatalf("Expected error expanding %+v", cpu)
+		}
+	}
+
+	_, err := CanonicalCPU([]string{"sse2", "bogus"}, "")
+	if err == nil {
+		t.Fatal("Expected error for unknown CPU feature")
+	}
+}
@@ -0,0 +1,415 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CPUMapModel is a named CPU model and the features it provides. A
+// model inherits all features of its Parent.
+type CPUMapModel struct {
+	Name     string
+	Vendor   string
+	Parent   string
+	Features []string
+}
+
+// CPUMap is a database of CPU models for an architecture, in the
+// spirit of libvirt's cpu_map
+type CPUMap struct {
+	Arch []string
+	// Names of all features known for the architecture, including
+	// those not part of any model
+	Features []string
+	Models   []CPUMapModel
+}
+
+func (m *CPUMap) Model(name string) *CPUMapModel {
+	for i := range m.Models {
+		if m.Models[i].Name == name {
+			return &m.Models[i]
+		}
+	}
+	return nil
+}
+
+func (m *CPUMap) modelFeatures(name string, features map[string]bool) error {
+	model := m.Model(name)
+	if model == nil {
+		return fmt.Errorf("Unknown CPU model '%s'", name)
+	}
+	if model.Parent != "" {
+		err := m.modelFeatures(model.Parent, features)
+		if err != nil {
+			return err
+		}
+	}
+	for _, feature := range model.Features {
+		features[feature] = true
+	}
+	return nil
+}
+
+// ModelFeatures returns the sorted list of features of a model
+func (m *CPUMap) ModelFeatures(name string) ([]string, error) {
+	features := make(map[string]bool)
+	err := m.modelFeatures(name, features)
+	if err != nil {
+		return nil, err
+	}
+	return cpuFeatureList(features), nil
+}
+
+// KnownFeature reports whether name is a feature of the architecture
+func (m *CPUMap) KnownFeature(name string) bool {
+	for _, feature := range m.Features {
+		if feature == name {
+			return true
+		}
+	}
+	return false
+}
+
+func cpuFeatureList(features map[string]bool) []string {
+	list := make([]string, 0, len(features))
+	for feature, present := range features {
+		if present {
+			list = append(list, feature)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+func cpuMapForArch(arch string) (*CPUMap, error) {
+	if arch == "" {
+		return CPUMapX86, nil
+	}
+	for _, name := range CPUMapX86.Arch {
+		if name == arch {
+			return CPUMapX86, nil
+		}
+	}
+	return nil, fmt.Errorf("No CPU map for architecture '%s'", arch)
+}
+
+// CPUMapX86 holds the x86 CPU models known to libvirt
+var CPUMapX86 = &CPUMap{
+	Arch: []string{"i686", "x86_64"},
+	Features: []string{
+		// cpuid level 0x00000001 (edx)
+		"fpu", "vme", "de", "pse", "tsc", "msr", "pae", "mce",
+		"cx8", "apic", "sep", "mtrr", "pge", "mca", "cmov", "pat",
+		"pse36", "pn", "clflush", "ds", "acpi", "mmx", "fxsr", "sse",
+		"sse2", "ss", "ht", "tm", "ia64", "pbe",
+		// cpuid level 0x00000001 (ecx)
+		"pni", "pclmuldq", "dtes64", "monitor", "ds_cpl", "vmx", "smx", "est",
+		"tm2", "ssse3", "cid", "fma", "cx16", "xtpr", "pdcm", "pcid",
+		"dca", "sse4.1", "sse4.2", "x2apic", "movbe", "popcnt", "tsc-deadline", "aes",
+		"xsave", "osxsave", "avx", "f16c", "rdrand", "hypervisor",
+		// cpuid level 0x00000007 (ebx)
+		"fsgsbase", "tsc_adjust", "bmi1", "hle", "avx2", "smep", "bmi2", "erms",
+		"invpcid", "rtm", "cmt", "mpx", "avx512f", "avx512dq", "rdseed", "adx",
+		"smap", "avx512ifma", "clflushopt", "clwb", "intel-pt", "avx512pf", "avx512er", "avx512cd",
+		"sha-ni", "avx512bw", "avx512vl",
+		// cpuid level 0x00000007 (ecx)
+		"avx512vbmi", "umip", "pku", "ospke", "avx512vbmi2", "gfni", "vaes", "vpclmulqdq",
+		"avx512vnni", "avx512bitalg", "avx512-vpopcntdq", "la57", "rdpid",
+		// cpuid level 0x00000007 (edx)
+		"avx512-4vnniw", "avx512-4fmaps", "md-clear", "spec-ctrl", "stibp", "arch-capabilities", "ssbd",
+		// cpuid level 0x0000000d
+		"xsaveopt", "xsavec", "xgetbv1", "xsaves",
+		// cpuid level 0x0000000f and 0x00000006
+		"mbm_total", "mbm_local", "arat",
+		// cpuid level 0x80000001 (edx)
+		"syscall", "nx", "mmxext", "fxsr_opt", "pdpe1gb", "rdtscp", "lm", "3dnowext",
+		"3dnow",
+		// cpuid level 0x80000001 (ecx)
+		"lahf_lm", "cmp_legacy", "svm", "extapic", "cr8legacy", "abm", "sse4a", "misalignsse",
+		"3dnowprefetch", "osvw", "ibs", "xop", "skinit", "wdt", "lwp", "fma4",
+		"tce", "nodeid_msr", "tbm", "topoext", "perfctr_core", "perfctr_nb",
+		// cpuid level 0x80000007 and 0x80000008
+		"invtsc", "ibpb", "amd-ssbd", "virt-ssbd",
+		// cpuid level 0x40000001
+		"kvmclock", "kvm_nopiodelay", "kvm_mmu", "kvmclock2", "kvm_asyncpf", "kvm_steal_time", "kvm_pv_eoi", "kvm_pv_unhalt",
+		"kvm_clocksource_stable_bit",
+		// cpuid level 0xC0000001
+		"xstore", "xstore-en", "xcrypt", "xcrypt-en", "ace2", "ace2-en", "phe", "phe-en",
+		"pmm", "pmm-en",
+	},
+	Models: []CPUMapModel{
+		{
+			Name:     "486",
+			Features: []string{"fpu", "vme", "pse"},
+		},
+		{
+			Name:     "pentium",
+			Parent:   "486",
+			Features: []string{"de", "tsc", "msr", "mce", "cx8", "mmx"},
+		},
+		{
+			Name:     "pentium2",
+			Parent:   "pentium",
+			Features: []string{"sep", "mtrr", "pge", "mca", "cmov", "pat", "fxsr"},
+		},
+		{
+			Name:     "pentium3",
+			Parent:   "pentium2",
+			Features: []string{"sse"},
+		},
+		{
+			Name: "pentiumpro",
+			Features: []string{
+				"fpu", "de", "pse", "tsc", "msr", "pae", "mce", "cx8",
+				"apic", "sep", "pge", "cmov", "pat", "mmx", "fxsr", "sse",
+				"sse2",
+			},
+		},
+		{
+			Name:     "qemu32",
+			Parent:   "pentiumpro",
+			Features: []string{"pni"},
+		},
+		{
+			Name:     "kvm32",
+			Parent:   "pentiumpro",
+			Features: []string{"mtrr", "clflush", "mca", "pse36", "pni"},
+		},
+		{
+			Name:   "qemu64",
+			Parent: "pentiumpro",
+			Features: []string{
+				"pni", "cx16", "lm", "syscall", "nx", "svm", "pse36", "mtrr",
+				"mca", "clflush",
+			},
+		},
+		{
+			Name:   "kvm64",
+			Parent: "pentiumpro",
+			Features: []string{
+				"pni", "cx16", "lm", "syscall", "nx", "pse36", "mtrr", "mca",
+				"clflush",
+			},
+		},
+		{
+			Name:   "Conroe",
+			Vendor: "Intel",
+			Features: []string{
+				"apic", "clflush", "cmov", "cx8", "de", "fpu", "fxsr", "lahf_lm",
+				"lm", "mca", "mce", "mmx", "msr", "mtrr", "nx", "pae",
+				"pat", "pge", "pni", "pse", "pse36", "sep", "sse", "sse2",
+				"ssse3", "syscall", "tsc",
+			},
+		},
+		{
+			Name:     "Penryn",
+			Vendor:   "Intel",
+			Parent:   "Conroe",
+			Features: []string{"cx16", "sse4.1"},
+		},
+		{
+			Name:     "Nehalem",
+			Vendor:   "Intel",
+			Parent:   "Penryn",
+			Features: []string{"popcnt", "sse4.2"},
+		},
+		{
+			Name:     "Westmere",
+			Vendor:   "Intel",
+			Parent:   "Nehalem",
+			Features: []string{"aes", "pclmuldq", "rdtscp"},
+		},
+		{
+			Name:     "SandyBridge",
+			Vendor:   "Intel",
+			Parent:   "Westmere",
+			Features: []string{"avx", "tsc-deadline", "x2apic", "xsave", "xsaveopt"},
+		},
+		{
+			Name:     "IvyBridge",
+			Vendor:   "Intel",
+			Parent:   "SandyBridge",
+			Features: []string{"erms", "f16c", "fsgsbase", "rdrand", "smep"},
+		},
+		{
+			Name:   "Haswell-noTSX",
+			Vendor: "Intel",
+			Parent: "IvyBridge",
+			Features: []string{
+				"abm", "avx2", "bmi1", "bmi2", "fma", "invpcid", "movbe", "pcid",
+			},
+		},
+		{
+			Name:     "Haswell",
+			Vendor:   "Intel",
+			Parent:   "Haswell-noTSX",
+			Features: []string{"hle", "rtm"},
+		},
+		{
+			Name:     "Broadwell-noTSX",
+			Vendor:   "Intel",
+			Parent:   "Haswell-noTSX",
+			Features: []string{"3dnowprefetch", "adx", "rdseed", "smap"},
+		},
+		{
+			Name:     "Broadwell",
+			Vendor:   "Intel",
+			Parent:   "Broadwell-noTSX",
+			Features: []string{"hle", "rtm"},
+		},
+		{
+			Name:     "Skylake-Client",
+			Vendor:   "Intel",
+			Parent:   "Broadwell",
+			Features: []string{"arat", "clflushopt", "xgetbv1", "xsavec"},
+		},
+		{
+			Name:   "Skylake-Server",
+			Vendor: "Intel",
+			Parent: "Skylake-Client",
+			Features: []string{
+				"avx512bw", "avx512cd", "avx512dq", "avx512f", "avx512vl", "clwb", "pdpe1gb", "pku",
+			},
+		},
+		{
+			Name:     "Nehalem-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Nehalem",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Westmere-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Westmere",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "SandyBridge-IBRS",
+			Vendor:   "Intel",
+			Parent:   "SandyBridge",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "IvyBridge-IBRS",
+			Vendor:   "Intel",
+			Parent:   "IvyBridge",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Haswell-noTSX-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Haswell-noTSX",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Haswell-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Haswell",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Broadwell-noTSX-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Broadwell-noTSX",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Broadwell-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Broadwell",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Skylake-Client-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Skylake-Client",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:     "Skylake-Server-IBRS",
+			Vendor:   "Intel",
+			Parent:   "Skylake-Server",
+			Features: []string{"spec-ctrl"},
+		},
+		{
+			Name:   "Opteron_G1",
+			Vendor: "AMD",
+			Features: []string{
+				"apic", "clflush", "cmov", "cx8", "de", "fpu", "fxsr", "lm",
+				"mca", "mce", "mmx", "msr", "mtrr", "nx", "pae", "pat",
+				"pge", "pni", "pse", "pse36", "sep", "sse", "sse2", "syscall",
+				"tsc",
+			},
+		},
+		{
+			Name:     "Opteron_G2",
+			Vendor:   "AMD",
+			Parent:   "Opteron_G1",
+			Features: []string{"cx16", "lahf_lm", "rdtscp", "svm"},
+		},
+		{
+			Name:     "Opteron_G3",
+			Vendor:   "AMD",
+			Parent:   "Opteron_G2",
+			Features: []string{"abm", "misalignsse", "monitor", "popcnt", "sse4a"},
+		},
+		{
+			Name:   "Opteron_G4",
+			Vendor: "AMD",
+			Parent: "Opteron_G2",
+			Features: []string{
+				"3dnowprefetch", "abm", "aes", "avx", "fma4", "misalignsse", "pclmuldq", "pdpe1gb",
+				"popcnt", "sse4.1", "sse4.2", "sse4a", "ssse3", "xop", "xsave",
+			},
+		},
+		{
+			Name:     "Opteron_G5",
+			Vendor:   "AMD",
+			Parent:   "Opteron_G4",
+			Features: []string{"f16c", "fma", "tbm"},
+		},
+		{
+			Name:   "EPYC",
+			Vendor: "AMD",
+			Parent: "Opteron_G2",
+			Features: []string{
+				"3dnowprefetch", "abm", "adx", "aes", "arat", "avx", "avx2", "bmi1",
+				"bmi2", "clflushopt", "cr8legacy", "f16c", "fma", "fsgsbase", "fxsr_opt", "misalignsse",
+				"mmxext", "movbe", "osvw", "pclmuldq", "pdpe1gb", "popcnt", "rdrand", "rdseed",
+				"sha-ni", "smap", "smep", "sse4.1", "sse4.2", "sse4a", "ssse3", "xgetbv1",
+				"xsave", "xsavec", "xsaveopt",
+			},
+		},
+		{
+			Name:     "EPYC-IBPB",
+			Vendor:   "AMD",
+			Parent:   "EPYC",
+			Features: []string{"ibpb"},
+		},
+	},
+}
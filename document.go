@@ -1,5 +1,20 @@
 package libvirtxml
 
+//go:generate go run document_gen.go
+
+// Document is implemented by the top level structs of each XML schema.
+// Besides the methods below, every struct of the schemas has DeepCopy,
+// DeepCopyInto and Equal methods generated by document_gen.go. Equal
+// compares the documents libvirt would consider equivalent, such as
+// sizes written in different units or numbers in hex and decimal.
+// Structs which can be marshalled on their own additionally get
+// Canonicalize, returning a copy in the normalized form used for
+// comparing documents, Fingerprint, a SHA-256 hash of that form, and
+// MarshalFor, which formats the document for an older libvirt version
+// by either stripping or rejecting what that version does not know.
+// They can also be streamed with Decode and Encode, or read and written
+// as byte slices with UnmarshalBytes and MarshalBytes, skipping the
+// string copies of Unmarshal and Marshal.
 type Document interface {
 	Unmarshal(doc string) error
 	Marshal() (string, error)
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+)
+
+// canonicalHex writes numbers given in hex in lower case without
+// leading zeros. They are not turned into decimal, since libvirt
+// parses some of them as base 16 whatever their prefix.
+func canonicalHex(str string) string {
+	if len(str) < 3 || str[0] != '0' || (str[1] != 'x' && str[1] != 'X') {
+		return str
+	}
+	val, err := strconv.ParseUint(str[2:], 16, 64)
+	if err != nil {
+		return str
+	}
+	return "0x" + strconv.FormatUint(val, 16)
+}
+
+// canonicalScaled converts value, in units of scale bytes, to units
+// of target bytes, rounding up like libvirt does for memory sizes
+func canonicalScaled(value uint64, scale uint64, target uint64) uint64 {
+	bytes := value * scale
+	return (bytes + target - 1) / target
+}
+
+func canonicalScaledString(value string, scale uint64, target uint64) string {
+	val, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	return strconv.FormatUint(canonicalScaled(val, scale, target), 10)
+}
+
+func fingerprint(doc Document) (string, error) {
+	str, err := doc.Marshal()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type canonicalControllerList []DomainController
+
+func (l canonicalControllerList) Len() int      { return len(l) }
+func (l canonicalControllerList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l canonicalControllerList) Less(i, j int) bool {
+	if l[i].Type != l[j].Type {
+		return l[i].Type < l[j].Type
+	}
+	if l[i].Index == nil || l[j].Index == nil {
+		return l[j].Index != nil
+	}
+	return *l[i].Index < *l[j].Index
+}
+
+type canonicalLeaseList []DomainLease
+
+func (l canonicalLeaseList) Len() int      { return len(l) }
+func (l canonicalLeaseList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l canonicalLeaseList) Less(i, j int) bool {
+	if l[i].Lockspace != l[j].Lockspace {
+		return l[i].Lockspace < l[j].Lockspace
+	}
+	return l[i].Key < l[j].Key
+}
+
+type canonicalShmemList []DomainShmem
+
+func (l canonicalShmemList) Len() int           { return len(l) }
+func (l canonicalShmemList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l canonicalShmemList) Less(i, j int) bool { return l[i].Name < l[j].Name }
+
+type canonicalPanicList []DomainPanic
+
+func (l canonicalPanicList) Len() int           { return len(l) }
+func (l canonicalPanicList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l canonicalPanicList) Less(i, j int) bool { return l[i].Model < l[j].Model }
+
+// canonicalizeOrder sorts the devices whose order has no effect on
+// the guest. Controllers are kept sorted by libvirt itself, while
+// leases, shmems and panic devices are never assigned anything based
+// on their position. Disks, interfaces and the like are left
+// alone as their order decides the addresses and names they get.
+func (l *DomainDeviceList) canonicalizeOrder() {
+	sort.Stable(canonicalControllerList(l.Controllers))
+	sort.Stable(canonicalLeaseList(l.Leases))
+	sort.Stable(canonicalShmemList(l.Shmems))
+	sort.Stable(canonicalPanicList(l.Panics))
+}
@@ -0,0 +1,191 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var documentCanonicalXML = []string{
+	`<domain type="kvm">`,
+	`  <name>demo</name>`,
+	`  <memory unit="KiB">2097152</memory>`,
+	`  <devices>`,
+	`    <disk type="file" device="disk">`,
+	`      <source file="/srv/images/demo.img"></source>`,
+	`      <target dev="vda" bus="virtio"></target>`,
+	`    </disk>`,
+	`    <controller type="pci" index="0" model="pcie-root"></controller>`,
+	`    <controller type="usb" index="0"></controller>`,
+	`    <hostdev mode="subsystem" type="pci" managed="no">`,
+	`      <source>`,
+	`        <address domain="0x0000" bus="0x06" slot="0x12" function="0x5"></address>`,
+	`      </source>`,
+	`    </hostdev>`,
+	`    <shmem name="a"></shmem>`,
+	`    <shmem name="b">`,
+	`      <size unit="bytes">4194304</size>`,
+	`    </shmem>`,
+	`  </devices>`,
+	`</domain>`,
+}
+
+var documentCanonicalTests = []struct {
+	A    []string
+	B    []string
+	Same bool
+}{
+	{
+		[]string{
+			`<domain type="kvm">`,
+			`  <name>demo</name>`,
+			`  <memory unit="GiB">2</memory>`,
+			`  <features/>`,
+			`  <devices>`,
+			`    <disk type="file">`,
+			`      <source file="/srv/images/demo.img"/>`,
+			`      <target dev="vda" bus="virtio"/>`,
+			`    </disk>`,
+			`    <controller type="usb" index="0"/>`,
+			`    <controller type="pci" index="0" model="pcie-root"/>`,
+			`    <hostdev mode="subsystem" type="pci">`,
+			`      <source><address bus="6" slot="18" function="5"/></source>`,
+			`    </hostdev>`,
+			`    <shmem name="b"><size unit="M">4</size></shmem>`,
+			`    <shmem name="a"/>`,
+			`  </devices>`,
+			`</domain>`,
+		},
+		documentCanonicalXML,
+		true,
+	},
+	{
+		[]string{
+			`<domain type="kvm"><devices>`,
+			`  <disk type="file"><target dev="vda"/></disk>`,
+			`  <disk type="file"><target dev="vdb"/></disk>`,
+			`</devices></domain>`,
+		},
+		[]string{
+			`<domain type="kvm"><devices>`,
+			`  <disk type="file"><target dev="vdb"/></disk>`,
+			`  <disk type="file"><target dev="vda"/></disk>`,
+			`</devices></domain>`,
+		},
+		false,
+	},
+	{
+		[]string{`<domain type="kvm"><memory unit="MB">1024</memory></domain>`},
+		[]string{`<domain type="kvm"><memory unit="MiB">1024</memory></domain>`},
+		false,
+	},
+}
+
+func TestDocumentCanonicalize(t *testing.T) {
+	for _, test := range documentCanonicalTests {
+		a := &Domain{}
+		err := a.Unmarshal(strings.Join(test.A, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := &Domain{}
+		err = b.Unmarshal(strings.Join(test.B, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if test.Same {
+			doc, err := a.Canonicalize().Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			expect := strings.Join(test.B, "\n")
+			if doc != expect {
+				t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+			}
+		}
+
+		fa, err := a.Fingerprint()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fb, err := b.Fingerprint()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (fa == fb) != test.Same {
+			t.Fatalf("Expected same fingerprint %t comparing\n%s\nwith\n%s",
+				test.Same, strings.Join(test.A, "\n"), strings.Join(test.B, "\n"))
+		}
+	}
+}
+
+func TestDocumentCanonicalizeCopy(t *testing.T) {
+	dom := &Domain{
+		Memory: &DomainMemory{
+			Value: 2,
+			Unit:  "GiB",
+		},
+		Features: &DomainFeatureList{},
+	}
+	canon := dom.Canonicalize()
+	if canon.Memory.Value != 2097152 || canon.Memory.Unit != "KiB" || canon.Features != nil {
+		t.Fatal("Domain was not canonicalized")
+	}
+	if dom.Memory.Value != 2 || dom.Memory.Unit != "GiB" || dom.Features == nil {
+		t.Fatal("Canonicalize modified the original domain")
+	}
+
+	dev := &NodeDevice{
+		Name: "pci_0000_00_02_0",
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{
+				Product: NodeDeviceIDName{
+					ID: "0x0A2E",
+				},
+			},
+		},
+	}
+	canonDev := dev.Canonicalize()
+	if canonDev.Capability.PCI.Product.ID != "0xa2e" {
+		t.Fatalf("Expected product ID 0xa2e, got %s", canonDev.Capability.PCI.Product.ID)
+	}
+
+	dom = &Domain{
+		Title: "0x0A",
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Serial: "0X00AB",
+				},
+			},
+		},
+	}
+	canon = dom.Canonicalize()
+	if canon.Title != "0x0A" || canon.Devices.Disks[0].Serial != "0X00AB" {
+		t.Fatalf("Free form text was changed to %s and %s", canon.Title, canon.Devices.Disks[0].Serial)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"strconv"
+)
+
+// equalHex compares attributes holding numbers, treating numbers
+// written in hex such as "0x1f" and in decimal such as "31" as equal
+func equalHex(a, b string) bool {
+	if a == b {
+		return true
+	}
+	i1, err1 := parseHex(a)
+	i2, err2 := parseHex(b)
+	return err1 == nil && err2 == nil && i1 == i2
+}
+
+// parseHex parses a number in hex with a "0x" prefix, or in decimal.
+// Unlike strconv with base 0 it never takes a leading zero as octal.
+func parseHex(str string) (uint64, error) {
+	if len(str) > 2 && str[0] == '0' && (str[1] == 'x' || str[1] == 'X') {
+		return strconv.ParseUint(str[2:], 16, 64)
+	}
+	return strconv.ParseUint(str, 10, 64)
+}
+
+// equalStringDefault compares attribute values where an empty one
+// stands for the default libvirt fills in
+func equalStringDefault(a, b, def string) bool {
+	if a == "" {
+		a = def
+	}
+	if b == "" {
+		b = def
+	}
+	return a == b
+}
+
+// equalUintDefault compares optional numbers where zero means the
+// attribute was left out
+func equalUintDefault(a, b, def uint) bool {
+	if a == 0 {
+		a = def
+	}
+	if b == 0 {
+		b = def
+	}
+	return a == b
+}
+
+func equalUintPtrDefault(a, b *uint, def uint) bool {
+	va, vb := def, def
+	if a != nil {
+		va = *a
+	}
+	if b != nil {
+		vb = *b
+	}
+	return va == vb
+}
+
+// equalScaled compares sizes given in possibly different units. Sizes
+// with units libvirt does not know are only equal if written the same.
+func equalScaled(a uint64, aunit string, b uint64, bunit string, defunit string) bool {
+	ascale, err1 := unitScale(aunit, defunit)
+	bscale, err2 := unitScale(bunit, defunit)
+	if err1 != nil || err2 != nil {
+		return a == b && aunit == bunit
+	}
+	return a*ascale == b*bscale
+}
+
+func equalScaledString(a string, aunit string, b string, bunit string, defunit string) bool {
+	va, err1 := strconv.ParseUint(a, 10, 64)
+	vb, err2 := strconv.ParseUint(b, 10, 64)
+	if err1 != nil || err2 != nil {
+		return a == b && aunit == bunit
+	}
+	return equalScaled(va, aunit, vb, bunit, defunit)
+}
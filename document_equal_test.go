@@ -0,0 +1,227 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDocumentDeepCopy(t *testing.T) {
+	for _, test := range domainTestData {
+		obj := reflect.ValueOf(test.Object)
+		dup := obj.MethodByName("DeepCopy").Call(nil)[0]
+		if dup.Pointer() == obj.Pointer() {
+			t.Fatal("DeepCopy returned the original")
+		}
+		equal := obj.MethodByName("Equal").Call([]reflect.Value{dup})[0].Bool()
+		if !equal {
+			t.Fatalf("Copy of %s is not equal", obj.Type())
+		}
+
+		doc, err := dup.Interface().(Document).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := strings.Join(test.Expected, "\n")
+		if doc != expect {
+			t.Fatal("Bad xml of copy:\n", doc, "\n does not match\n", expect, "\n")
+		}
+
+		parsed := reflect.New(obj.Elem().Type())
+		err = parsed.Interface().(Document).Unmarshal(expect)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dup = parsed.MethodByName("DeepCopy").Call(nil)[0]
+		equal = parsed.MethodByName("Equal").Call([]reflect.Value{dup})[0].Bool()
+		if !equal {
+			t.Fatalf("Copy of parsed %s is not equal", obj.Type())
+		}
+	}
+
+	// A parsed document sets XMLName, which must not matter
+	dom := &Domain{}
+	err := dom.Unmarshal(`<domain type="kvm"><name>demo</name></domain>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dom.Equal(&Domain{Type: "kvm", Name: "demo"}) {
+		t.Fatal("Parsed domain is not equal to the constructed one")
+	}
+}
+
+func TestDocumentDeepCopyShared(t *testing.T) {
+	slot := uint(3)
+	dom := &Domain{
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Source: &DomainDiskSource{
+						File: &DomainDiskSourceFile{
+							File: "/srv/images/demo.img",
+						},
+					},
+					Address: &DomainAddress{
+						PCI: &DomainAddressPCI{
+							Slot: &slot,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dup := dom.DeepCopy()
+	*dup.Devices.Disks[0].Address.PCI.Slot = 4
+	dup.Devices.Disks[0].Source.File.File = "/srv/images/other.img"
+	dup.Devices.Disks = append(dup.Devices.Disks, DomainDisk{})
+
+	if slot != 3 || dom.Devices.Disks[0].Source.File.File != "/srv/images/demo.img" ||
+		len(dom.Devices.Disks) != 1 {
+		t.Fatal("Modifying the copy changed the original")
+	}
+	if dom.Equal(dup) {
+		t.Fatal("Modified copy is still equal")
+	}
+}
+
+var documentEqualTests = []struct {
+	A     []string
+	B     []string
+	Equal bool
+}{
+	{
+		[]string{`<domain><memory unit="GiB">2</memory></domain>`},
+		[]string{`<domain><memory>2097152</memory></domain>`},
+		true,
+	},
+	{
+		[]string{`<domain><memory unit="k">2048</memory></domain>`},
+		[]string{`<domain><memory unit="KiB">2048</memory></domain>`},
+		true,
+	},
+	{
+		[]string{`<domain><memory unit="GB">2</memory></domain>`},
+		[]string{`<domain><memory unit="GiB">2</memory></domain>`},
+		false,
+	},
+	{
+		[]string{
+			`<domain><devices><hostdev mode="subsystem" type="pci">`,
+			`  <source><address domain="0x0000" bus="0x06" slot="0x12" function="0x5"/></source>`,
+			`  <address type="pci" domain="0x0000" bus="0x00" slot="0x07" function="0x0"/>`,
+			`</hostdev></devices></domain>`,
+		},
+		[]string{
+			`<domain><devices><hostdev mode="subsystem" type="pci" managed="no">`,
+			`  <source><address bus="6" slot="18" function="5"/></source>`,
+			`  <address type="pci" bus="0" slot="7"/>`,
+			`</hostdev></devices></domain>`,
+		},
+		true,
+	},
+	{
+		[]string{`<domain><devices><hostdev mode="subsystem" type="pci" managed="yes"/></devices></domain>`},
+		[]string{`<domain><devices><hostdev mode="subsystem" type="pci"/></devices></domain>`},
+		false,
+	},
+	{
+		[]string{`<domain><devices><disk type="file"><target dev="vda"/></disk></devices></domain>`},
+		[]string{`<domain><devices><disk type="file" device="disk"><target dev="vda"/></disk></devices></domain>`},
+		true,
+	},
+	{
+		[]string{`<domain><devices><disk type="file"><target dev="vda"/></disk></devices></domain>`},
+		[]string{`<domain><devices><disk type="file"><target dev="vdb"/></disk></devices></domain>`},
+		false,
+	},
+	{
+		[]string{`<domain><devices><disk type="file"><readonly/></disk></devices></domain>`},
+		[]string{`<domain><devices><disk type="file"></disk></devices></domain>`},
+		false,
+	},
+	{
+		[]string{`<domain><name>16</name></domain>`},
+		[]string{`<domain><name>0x10</name></domain>`},
+		false,
+	},
+	{
+		[]string{`<domain><devices><disk type="file"><serial>010</serial></disk></devices></domain>`},
+		[]string{`<domain><devices><disk type="file"><serial>8</serial></disk></devices></domain>`},
+		false,
+	},
+}
+
+func TestDocumentEqual(t *testing.T) {
+	for _, test := range documentEqualTests {
+		a := &Domain{}
+		err := a.Unmarshal(strings.Join(test.A, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := &Domain{}
+		err = b.Unmarshal(strings.Join(test.B, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Equal(b) != test.Equal || b.Equal(a) != test.Equal {
+			t.Fatalf("Expected equal %t comparing\n%s\nwith\n%s",
+				test.Equal, strings.Join(test.A, "\n"), strings.Join(test.B, "\n"))
+		}
+	}
+}
+
+func TestDocumentEqualHex(t *testing.T) {
+	tests := []struct {
+		A     string
+		B     string
+		Equal bool
+	}{
+		{"0x1af4", "6900", true},
+		{"0x1AF4", "0x1af4", true},
+		{"0x00a0", "0xa0", true},
+		{"010", "8", false},
+		{"010", "10", true},
+		{"0x10", "0x11", false},
+	}
+
+	for _, test := range tests {
+		a := &NodeDevice{}
+		err := a.Unmarshal(`<device><capability type="pci"><vendor id="` + test.A + `"/></capability></device>`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := &NodeDevice{}
+		err = b.Unmarshal(`<device><capability type="pci"><vendor id="` + test.B + `"/></capability></device>`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Equal(b) != test.Equal || b.Equal(a) != test.Equal {
+			t.Fatalf("Expected equal %t comparing vendor ID %s with %s", test.Equal, test.A, test.B)
+		}
+	}
+}
@@ -0,0 +1,632 @@
+// +build ignore
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+// This program generates the DeepCopy, Equal and Canonicalize methods
+// of the document structs into document_generated.go. Run it with
+// "go generate" after changing any of the structs.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The files declaring the document structs
+var files = []string{
+	"capabilities.go",
+	"domain.go",
+	"domain_capabilities.go",
+	"domain_snapshot.go",
+	"interface.go",
+	"network.go",
+	"node_device.go",
+	"nwfilter.go",
+	"secret.go",
+	"storage_encryption.go",
+	"storage_pool.go",
+	"storage_vol.go",
+}
+
+type scaled struct {
+	fields  []string
+	defunit string
+}
+
+// Sizes whose value is scaled by the Unit field of the same struct,
+// with the unit libvirt assumes when it is left out
+var scaledTypes = map[string]scaled{
+	"CapsHostCPUPageSize":            {[]string{"Size"}, "KiB"},
+	"CapsHostCacheBank":              {[]string{"Size"}, "B"},
+	"CapsHostCacheControl":           {[]string{"Granularity", "Min"}, "B"},
+	"CapsHostNUMAMemory":             {[]string{"Size"}, "KiB"},
+	"CapsHostNUMAPageInfo":           {[]string{"Size"}, "KiB"},
+	"DomainCPUCacheTuneCache":        {[]string{"Size"}, "KiB"},
+	"DomainCell":                     {[]string{"Memory"}, "KiB"},
+	"DomainControllerPCIHole64":      {[]string{"Size"}, "KiB"},
+	"DomainCurrentMemory":            {[]string{"Value"}, "KiB"},
+	"DomainFeatureHPTPageSize":       {[]string{"Value"}, "KiB"},
+	"DomainFeatureSMMTSeg":           {[]string{"Value"}, "MiB"},
+	"DomainFilesystemSpaceHardLimit": {[]string{"Value"}, "B"},
+	"DomainFilesystemSpaceSoftLimit": {[]string{"Value"}, "B"},
+	"DomainMaxMemory":                {[]string{"Value"}, "KiB"},
+	"DomainMemory":                   {[]string{"Value"}, "KiB"},
+	"DomainMemoryHugepage":           {[]string{"Size"}, "KiB"},
+	"DomainMemoryTuneLimit":          {[]string{"Value"}, "KiB"},
+	"DomainMemorydevSourcePagesize":  {[]string{"Value"}, "KiB"},
+	"DomainMemorydevTargetSize":      {[]string{"Value"}, "KiB"},
+	"DomainShmemSize":                {[]string{"Value"}, "B"},
+	"StoragePoolSize":                {[]string{"Value"}, "B"},
+	"StorageVolumeSize":              {[]string{"Value"}, "B"},
+}
+
+// Values libvirt fills in when an attribute is left out
+var defaults = map[string]string{
+	"DomainAddressDrive.Bus":        "0",
+	"DomainAddressDrive.Controller": "0",
+	"DomainAddressDrive.Target":     "0",
+	"DomainAddressDrive.Unit":       "0",
+	"DomainAddressPCI.Bus":          "0",
+	"DomainAddressPCI.Domain":       "0",
+	"DomainAddressPCI.Function":     "0",
+	"DomainAddressPCI.Slot":         "0",
+	"DomainDisk.Device":             "disk",
+	"DomainHostdev.Managed":         "no",
+	"DomainInterfaceLink.State":     "up",
+	"DomainVideoModel.Heads":        "1",
+	"NetworkForward.Mode":           "nat",
+	"StorageVolume.Type":            "file",
+}
+
+// String attributes holding numbers, which libvirt accepts in hex as
+// well as in decimal. Only these are compared and canonicalized as
+// numbers, all other strings are kept as written.
+var hexFields = map[string]bool{
+	"NodeDeviceIDName.ID": true,
+}
+
+type schemaRef struct {
+	file   string
+	define string
+}
+
+// The libvirt schema each document is validated against, with the
+// define describing it for the parts of a larger document. Validate
+// is only generated for the documents whose schema is vendored.
+var documentSchemas = map[string]schemaRef{
+	"Caps":              {"capability.rng", ""},
+	"CapsHostCPU":       {"capability.rng", "hostcpu"},
+	"Domain":            {"domain.rng", ""},
+	"DomainCPU":         {"domain.rng", "cpu"},
+	"DomainCaps":        {"domaincaps.rng", ""},
+	"DomainChannel":     {"domain.rng", "channel"},
+	"DomainConsole":     {"domain.rng", "console"},
+	"DomainController":  {"domain.rng", "controller"},
+	"DomainDisk":        {"domain.rng", "disk"},
+	"DomainFilesystem":  {"domain.rng", "filesystem"},
+	"DomainGraphic":     {"domain.rng", "graphic"},
+	"DomainHostdev":     {"domain.rng", "hostdev"},
+	"DomainHub":         {"domain.rng", "hub"},
+	"DomainIOMMU":       {"domain.rng", "iommu"},
+	"DomainInput":       {"domain.rng", "input"},
+	"DomainInterface":   {"domain.rng", "interface"},
+	"DomainLease":       {"domain.rng", "lease"},
+	"DomainMemBalloon":  {"domain.rng", "memballoon"},
+	"DomainMemorydev":   {"domain.rng", "memorydev"},
+	"DomainNVRAM":       {"domain.rng", "nvram"},
+	"DomainPanic":       {"domain.rng", "panic"},
+	"DomainParallel":    {"domain.rng", "parallel"},
+	"DomainRNG":         {"domain.rng", "rng"},
+	"DomainRedirDev":    {"domain.rng", "redirdev"},
+	"DomainRedirFilter": {"domain.rng", "redirfilter"},
+	"DomainSerial":      {"domain.rng", "serial"},
+	"DomainShmem":       {"domain.rng", "shmem"},
+	"DomainSmartcard":   {"domain.rng", "smartcard"},
+	"DomainSnapshot":    {"domainsnapshot.rng", ""},
+	"DomainSound":       {"domain.rng", "sound"},
+	"DomainTPM":         {"domain.rng", "tpm"},
+	"DomainVSock":       {"domain.rng", "vsock"},
+	"DomainVideo":       {"domain.rng", "video"},
+	"DomainWatchdog":    {"domain.rng", "watchdog"},
+	"Interface":         {"interface.rng", ""},
+	"NWFilter":          {"nwfilter.rng", ""},
+	"Network":           {"network.rng", ""},
+	"NodeDevice":        {"nodedev.rng", ""},
+	"Secret":            {"secret.rng", ""},
+	"StoragePool":       {"storagepool.rng", ""},
+	"StorageVolume":     {"storagevol.rng", ""},
+}
+
+// Documents libvirt has no schema for, the parts of a network passed
+// to virNetworkUpdate
+var noSchema = map[string]bool{
+	"NetworkDHCPHost":         true,
+	"NetworkDHCPRange":        true,
+	"NetworkDNSHost":          true,
+	"NetworkDNSSRV":           true,
+	"NetworkDNSTXT":           true,
+	"NetworkForwardInterface": true,
+	"NetworkPortGroup":        true,
+}
+
+// Structs whose hand written canonicalizeOrder method sorts the lists
+// where libvirt does not care about the order
+var orderedTypes = map[string]bool{
+	"DomainDeviceList": true,
+}
+
+// Structs only holding child elements whose presence still matters
+// when they have none
+var keepEmpty = map[string]bool{
+	"DomainInterfaceVirtualPort": true,
+	"NetworkDHCP":                true,
+	"NetworkVirtualPort":         true,
+}
+
+var basic = map[string]bool{
+	"bool":    true,
+	"byte":    true,
+	"float64": true,
+	"int":     true,
+	"int64":   true,
+	"string":  true,
+	"uint":    true,
+	"uint32":  true,
+	"uint64":  true,
+}
+
+type generator struct {
+	structs   map[string]*ast.StructType
+	named     map[string]string
+	documents map[string]bool
+	out       bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.out, format, args...)
+}
+
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.StructType:
+		if len(t.Fields.List) == 0 {
+			return "struct{}"
+		}
+	}
+	panic(fmt.Sprintf("Unsupported type %T", expr))
+}
+
+func (g *generator) isStruct(name string) bool {
+	_, ok := g.structs[name]
+	if !ok {
+		_, ok = g.named[name]
+	}
+	return ok
+}
+
+// isContainer reports whether name is a struct only holding child
+// elements, which libvirt leaves out of the XML when it has none
+func (g *generator) isContainer(name string) bool {
+	if base, ok := g.named[name]; ok {
+		name = base
+	}
+	st, ok := g.structs[name]
+	if !ok || keepEmpty[name] {
+		return false
+	}
+	children := 0
+	for _, field := range st.Fields.List {
+		ftype := typeString(field.Type)
+		if ftype == "xml.Name" {
+			continue
+		}
+		// Devices are never empty, even without any children
+		if field.Tag == nil || len(field.Names) == 0 || field.Names[0].Name == "Alias" {
+			return false
+		}
+		opts := strings.Split(fieldTag(field), ",")
+		if opts[0] == "" || opts[0] == "-" || len(opts) > 1 && opts[1] != "omitempty" {
+			return false
+		}
+		if !strings.HasPrefix(ftype, "*") && !strings.HasPrefix(ftype, "[]") {
+			return false
+		}
+		children++
+	}
+	return children != 0
+}
+
+func fieldName(field *ast.Field) []string {
+	if len(field.Names) == 0 {
+		return []string{typeString(field.Type)}
+	}
+	var names []string
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+	return names
+}
+
+func (g *generator) copyField(typ, name, ftype string) {
+	switch {
+	case basic[ftype] || ftype == "xml.Name":
+	case ftype == "*struct{}":
+		g.printf("if in.%s != nil {\nout.%s = &struct{}{}\n}\n", name, name)
+	case strings.HasPrefix(ftype, "*") && basic[ftype[1:]]:
+		g.printf("if in.%s != nil {\nv := *in.%s\nout.%s = &v\n}\n", name, name, name)
+	case strings.HasPrefix(ftype, "*") && g.isStruct(ftype[1:]):
+		g.printf("if in.%s != nil {\nout.%s = in.%s.DeepCopy()\n}\n", name, name, name)
+	case g.isStruct(ftype):
+		g.printf("in.%s.DeepCopyInto(&out.%s)\n", name, name)
+	case strings.HasPrefix(ftype, "[]") && basic[ftype[2:]]:
+		g.printf("if in.%s != nil {\nout.%s = make(%s, len(in.%s))\ncopy(out.%s, in.%s)\n}\n",
+			name, name, ftype, name, name, name)
+	case strings.HasPrefix(ftype, "[]") && g.isStruct(ftype[2:]):
+		g.printf("if in.%s != nil {\nout.%s = make(%s, len(in.%s))\nfor i := range in.%s {\nin.%s[i].DeepCopyInto(&out.%s[i])\n}\n}\n",
+			name, name, ftype, name, name, name, name)
+	default:
+		panic(fmt.Sprintf("Cannot copy %s.%s of type %s", typ, name, ftype))
+	}
+}
+
+func (g *generator) equalField(typ, name, ftype string) {
+	def, hasDef := defaults[typ+"."+name]
+	switch {
+	case ftype == "xml.Name":
+		// Only set when parsing, and fixed by the schema anyway
+	case ftype == "string" && hasDef:
+		g.printf("if !equalStringDefault(a.%s, b.%s, %q) {\nreturn false\n}\n", name, name, def)
+	case ftype == "string" && hexFields[typ+"."+name]:
+		g.printf("if !equalHex(a.%s, b.%s) {\nreturn false\n}\n", name, name)
+	case ftype == "uint" && hasDef:
+		g.printf("if !equalUintDefault(a.%s, b.%s, %s) {\nreturn false\n}\n", name, name, def)
+	case ftype == "*uint" && hasDef:
+		g.printf("if !equalUintPtrDefault(a.%s, b.%s, %s) {\nreturn false\n}\n", name, name, def)
+	case ftype == "*struct{}":
+		g.printf("if (a.%s == nil) != (b.%s == nil) {\nreturn false\n}\n", name, name)
+	case basic[ftype]:
+		g.printf("if a.%s != b.%s {\nreturn false\n}\n", name, name)
+	case strings.HasPrefix(ftype, "*") && basic[ftype[1:]]:
+		g.printf("if (a.%s == nil) != (b.%s == nil) || (a.%s != nil && *a.%s != *b.%s) {\nreturn false\n}\n",
+			name, name, name, name, name)
+	case strings.HasPrefix(ftype, "*") && g.isStruct(ftype[1:]):
+		g.printf("if !a.%s.Equal(b.%s) {\nreturn false\n}\n", name, name)
+	case g.isStruct(ftype):
+		g.printf("if !a.%s.Equal(&b.%s) {\nreturn false\n}\n", name, name)
+	case strings.HasPrefix(ftype, "[]") && basic[ftype[2:]]:
+		g.printf("if len(a.%s) != len(b.%s) {\nreturn false\n}\nfor i := range a.%s {\nif a.%s[i] != b.%s[i] {\nreturn false\n}\n}\n",
+			name, name, name, name, name)
+	case strings.HasPrefix(ftype, "[]") && g.isStruct(ftype[2:]):
+		g.printf("if len(a.%s) != len(b.%s) {\nreturn false\n}\nfor i := range a.%s {\nif !a.%s[i].Equal(&b.%s[i]) {\nreturn false\n}\n}\n",
+			name, name, name, name, name)
+	default:
+		panic(fmt.Sprintf("Cannot compare %s.%s of type %s", typ, name, ftype))
+	}
+}
+
+func (g *generator) scaledField(typ string, field string, ftype string, defunit string) {
+	switch ftype {
+	case "string":
+		g.printf("if !equalScaledString(a.%s, a.Unit, b.%s, b.Unit, %q) {\nreturn false\n}\n",
+			field, field, defunit)
+	case "int", "uint", "uint64":
+		g.printf("if !equalScaled(uint64(a.%s), a.Unit, uint64(b.%s), b.Unit, %q) {\nreturn false\n}\n",
+			field, field, defunit)
+	default:
+		panic(fmt.Sprintf("Cannot scale %s.%s of type %s", typ, field, ftype))
+	}
+}
+
+func fieldTag(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		panic(err)
+	}
+	return reflect.StructTag(tag).Get("xml")
+}
+
+// canonicalField normalizes a field. Empty containers are dropped,
+// unless the field is marshalled by hand as the choice of which
+// pointer is set carries information.
+func (g *generator) canonicalField(typ, name, ftype string, tag string) {
+	def, hasDef := defaults[typ+"."+name]
+	switch {
+	case ftype == "string" && hasDef:
+		g.printf("if in.%s == \"\" {\nin.%s = %q\n}\n", name, name, def)
+	case ftype == "string" && hexFields[typ+"."+name]:
+		g.printf("in.%s = canonicalHex(in.%s)\n", name, name)
+	case ftype == "uint" && hasDef:
+		g.printf("if in.%s == 0 {\nin.%s = %s\n}\n", name, name, def)
+	case ftype == "*uint" && hasDef:
+		g.printf("if in.%s == nil {\nv := uint(%s)\nin.%s = &v\n}\n", name, def, name)
+	case strings.HasPrefix(ftype, "*") && g.isStruct(ftype[1:]):
+		g.printf("if in.%s != nil {\nin.%s.canonicalize()\n", name, name)
+		if g.isContainer(ftype[1:]) && tag != "-" {
+			g.printf("if in.%s.empty() {\nin.%s = nil\n}\n", name, name)
+		}
+		g.printf("}\n")
+	case g.isStruct(ftype):
+		g.printf("in.%s.canonicalize()\n", name)
+	case strings.HasPrefix(ftype, "[]") && g.isStruct(ftype[2:]):
+		g.printf("for i := range in.%s {\nin.%s[i].canonicalize()\n}\n", name, name)
+	}
+	if strings.HasPrefix(ftype, "[]") {
+		g.printf("if len(in.%s) == 0 {\nin.%s = nil\n}\n", name, name)
+	}
+}
+
+// canonicalScaled converts sizes to KiB, or to bytes for the sizes
+// libvirt counts in bytes by default
+func (g *generator) canonicalScaled(typ string, st *ast.StructType, scale scaled) {
+	target, unit := 1024, "KiB"
+	if scale.defunit == "B" {
+		target, unit = 1, "bytes"
+	}
+	g.printf("if scale, err := unitScale(in.Unit, %q); err == nil {\n", scale.defunit)
+	for _, field := range st.Fields.List {
+		ftype := typeString(field.Type)
+		for _, fname := range fieldName(field) {
+			found := false
+			for _, f := range scale.fields {
+				if f == fname {
+					found = true
+				}
+			}
+			if !found {
+				continue
+			}
+			switch ftype {
+			case "string":
+				g.printf("in.%s = canonicalScaledString(in.%s, scale, %d)\n", fname, fname, target)
+			case "int", "uint", "uint64":
+				g.printf("in.%s = %s(canonicalScaled(uint64(in.%s), scale, %d))\n", fname, ftype, fname, target)
+			default:
+				panic(fmt.Sprintf("Cannot scale %s.%s of type %s", typ, fname, ftype))
+			}
+		}
+	}
+	g.printf("in.Unit = %q\n}\n", unit)
+}
+
+func (g *generator) generateStruct(name string, st *ast.StructType) {
+	g.printf("func (in *%s) DeepCopy() *%s {\nif in == nil {\nreturn nil\n}\nout := new(%s)\nin.DeepCopyInto(out)\nreturn out\n}\n\n",
+		name, name, name)
+	g.printf("func (in *%s) DeepCopyInto(out *%s) {\n*out = *in\n", name, name)
+	for _, field := range st.Fields.List {
+		ftype := typeString(field.Type)
+		for _, fname := range fieldName(field) {
+			g.copyField(name, fname, ftype)
+		}
+	}
+	g.printf("}\n\n")
+
+	scale, isScaled := scaledTypes[name]
+	g.printf("func (a *%s) Equal(b *%s) bool {\nif a == nil || b == nil {\nreturn a == b\n}\n", name, name)
+	for _, field := range st.Fields.List {
+		ftype := typeString(field.Type)
+		for _, fname := range fieldName(field) {
+			if isScaled && fname == "Unit" {
+				continue
+			}
+			scaledField := false
+			if isScaled {
+				for _, f := range scale.fields {
+					if f == fname {
+						scaledField = true
+					}
+				}
+			}
+			if scaledField {
+				g.scaledField(name, fname, ftype, scale.defunit)
+			} else {
+				g.equalField(name, fname, ftype)
+			}
+		}
+	}
+	g.printf("return true\n}\n\n")
+
+	g.printf("func (in *%s) canonicalize() {\n", name)
+	for _, field := range st.Fields.List {
+		ftype := typeString(field.Type)
+		for _, fname := range fieldName(field) {
+			if isScaled && fname == "Unit" {
+				continue
+			}
+			g.canonicalField(name, fname, ftype, fieldTag(field))
+		}
+	}
+	if isScaled {
+		g.canonicalScaled(name, st, scale)
+	}
+	if orderedTypes[name] {
+		g.printf("in.canonicalizeOrder()\n")
+	}
+	g.printf("}\n\n")
+
+	if g.isContainer(name) {
+		g.printf("func (in *%s) empty() bool {\nreturn ", name)
+		first := true
+		for _, field := range st.Fields.List {
+			ftype := typeString(field.Type)
+			if ftype == "xml.Name" {
+				continue
+			}
+			if !first {
+				g.printf(" &&\n")
+			}
+			first = false
+			if strings.HasPrefix(ftype, "*") {
+				g.printf("in.%s == nil", field.Names[0].Name)
+			} else {
+				g.printf("len(in.%s) == 0", field.Names[0].Name)
+			}
+		}
+		g.printf("\n}\n\n")
+	}
+	g.generateDocument(name)
+}
+
+// generateDocument adds the exported canonicalization, streaming and
+// validation methods to the structs that can be marshalled on their own
+func (g *generator) generateDocument(name string) {
+	if !g.documents[name] {
+		return
+	}
+	g.printf("func (d *%s) Canonicalize() *%s {\nc := d.DeepCopy()\nc.canonicalize()\nreturn c\n}\n\n",
+		name, name)
+	g.printf("func (d *%s) Fingerprint() (string, error) {\nreturn fingerprint(d.Canonicalize())\n}\n\n",
+		name)
+	g.printf("func (d *%s) MarshalFor(version uint, policy string) (string, error) {\nreturn marshalFor(d.DeepCopy(), version, policy)\n}\n\n",
+		name)
+	g.printf("func (d *%s) Decode(r io.Reader) error {\nreturn decodeDocument(r, d)\n}\n\n", name)
+	g.printf("func (d *%s) Encode(w io.Writer) error {\nreturn encodeDocument(w, d)\n}\n\n", name)
+	g.printf("func (d *%s) UnmarshalBytes(doc []byte) error {\nreturn unmarshalBytes(doc, d)\n}\n\n", name)
+	g.printf("func (d *%s) MarshalBytes() ([]byte, error) {\nreturn marshalBytes(d)\n}\n\n", name)
+	if ref, ok := documentSchemas[name]; ok {
+		g.printf("func (d *%s) schemaRef() (string, string) {\nreturn %q, %q\n}\n\n", name, ref.file, ref.define)
+		// Only offer validation where the schema is vendored
+		if _, err := os.Stat(filepath.Join("schemas", ref.file)); err == nil {
+			g.printf("func (d *%s) Validate() error {\nreturn validateDocument(d, %q, %q)\n}\n\n", name, ref.file, ref.define)
+		}
+	} else if !noSchema[name] {
+		panic(fmt.Sprintf("No schema for document %s", name))
+	}
+}
+
+// generateNamed handles struct types declared in terms of another
+// struct type, which lack that type's methods
+func (g *generator) generateNamed(name, base string) {
+	g.printf("func (in *%s) DeepCopy() *%s {\nif in == nil {\nreturn nil\n}\nout := new(%s)\nin.DeepCopyInto(out)\nreturn out\n}\n\n",
+		name, name, name)
+	g.printf("func (in *%s) DeepCopyInto(out *%s) {\n(*%s)(in).DeepCopyInto((*%s)(out))\n}\n\n",
+		name, name, base, base)
+	g.printf("func (a *%s) Equal(b *%s) bool {\nreturn (*%s)(a).Equal((*%s)(b))\n}\n\n",
+		name, name, base, base)
+	g.printf("func (in *%s) canonicalize() {\n(*%s)(in).canonicalize()\n}\n\n", name, base)
+	if g.isContainer(base) {
+		g.printf("func (in *%s) empty() bool {\nreturn (*%s)(in).empty()\n}\n\n", name, base)
+	}
+	g.generateDocument(name)
+}
+
+func main() {
+	g := &generator{
+		structs:   make(map[string]*ast.StructType),
+		named:     make(map[string]string),
+		documents: make(map[string]bool),
+	}
+
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				if fn.Recv != nil && fn.Name.Name == "Marshal" {
+					g.documents[typeString(fn.Recv.List[0].Type)[1:]] = true
+				}
+				continue
+			}
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if !ts.Name.IsExported() {
+					continue
+				}
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					g.structs[ts.Name.Name] = t
+				case *ast.Ident:
+					g.named[ts.Name.Name] = t.Name
+				}
+			}
+		}
+	}
+
+	var names []string
+	for name := range g.structs {
+		names = append(names, name)
+	}
+	for name, base := range g.named {
+		if _, ok := g.structs[base]; !ok {
+			delete(g.named, name)
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g.printf("// Code generated by document_gen.go. DO NOT EDIT.\n\npackage libvirtxml\n\nimport \"io\"\n\n")
+	for _, name := range names {
+		if st, ok := g.structs[name]; ok {
+			g.generateStruct(name, st)
+		} else {
+			g.generateNamed(name, g.named[name])
+		}
+	}
+
+	src, err := format.Source(g.out.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = ioutil.WriteFile("document_generated.go", src, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,28171 @@
+// Code generated by document_gen.go. DO NOT EDIT.
+
+package libvirtxml
+
+import "io"
+
+func (in *Caps) DeepCopy() *Caps {
+	if in == nil {
+		return nil
+	}
+	out := new(Caps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Caps) DeepCopyInto(out *Caps) {
+	*out = *in
+	in.Host.DeepCopyInto(&out.Host)
+	if in.Guests != nil {
+		out.Guests = make([]CapsGuest, len(in.Guests))
+		for i := range in.Guests {
+			in.Guests[i].DeepCopyInto(&out.Guests[i])
+		}
+	}
+}
+
+func (a *Caps) Equal(b *Caps) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Host.Equal(&b.Host) {
+		return false
+	}
+	if len(a.Guests) != len(b.Guests) {
+		return false
+	}
+	for i := range a.Guests {
+		if !a.Guests[i].Equal(&b.Guests[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *Caps) canonicalize() {
+	in.Host.canonicalize()
+	for i := range in.Guests {
+		in.Guests[i].canonicalize()
+	}
+	if len(in.Guests) == 0 {
+		in.Guests = nil
+	}
+}
+
+func (d *Caps) Canonicalize() *Caps {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *Caps) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *Caps) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *Caps) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *Caps) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *Caps) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *Caps) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *Caps) schemaRef() (string, string) {
+	return "capability.rng", ""
+}
+
+func (in *CapsGuest) DeepCopy() *CapsGuest {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuest) DeepCopyInto(out *CapsGuest) {
+	*out = *in
+	in.Arch.DeepCopyInto(&out.Arch)
+	if in.Features != nil {
+		out.Features = in.Features.DeepCopy()
+	}
+}
+
+func (a *CapsGuest) Equal(b *CapsGuest) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.OSType != b.OSType {
+		return false
+	}
+	if !a.Arch.Equal(&b.Arch) {
+		return false
+	}
+	if !a.Features.Equal(b.Features) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsGuest) canonicalize() {
+	in.Arch.canonicalize()
+	if in.Features != nil {
+		in.Features.canonicalize()
+		if in.Features.empty() {
+			in.Features = nil
+		}
+	}
+}
+
+func (in *CapsGuestArch) DeepCopy() *CapsGuestArch {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestArch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestArch) DeepCopyInto(out *CapsGuestArch) {
+	*out = *in
+	if in.Machines != nil {
+		out.Machines = make([]CapsGuestMachine, len(in.Machines))
+		for i := range in.Machines {
+			in.Machines[i].DeepCopyInto(&out.Machines[i])
+		}
+	}
+	if in.Domains != nil {
+		out.Domains = make([]CapsGuestDomain, len(in.Domains))
+		for i := range in.Domains {
+			in.Domains[i].DeepCopyInto(&out.Domains[i])
+		}
+	}
+}
+
+func (a *CapsGuestArch) Equal(b *CapsGuestArch) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.WordSize != b.WordSize {
+		return false
+	}
+	if a.Emulator != b.Emulator {
+		return false
+	}
+	if a.Loader != b.Loader {
+		return false
+	}
+	if len(a.Machines) != len(b.Machines) {
+		return false
+	}
+	for i := range a.Machines {
+		if !a.Machines[i].Equal(&b.Machines[i]) {
+			return false
+		}
+	}
+	if len(a.Domains) != len(b.Domains) {
+		return false
+	}
+	for i := range a.Domains {
+		if !a.Domains[i].Equal(&b.Domains[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsGuestArch) canonicalize() {
+	for i := range in.Machines {
+		in.Machines[i].canonicalize()
+	}
+	if len(in.Machines) == 0 {
+		in.Machines = nil
+	}
+	for i := range in.Domains {
+		in.Domains[i].canonicalize()
+	}
+	if len(in.Domains) == 0 {
+		in.Domains = nil
+	}
+}
+
+func (in *CapsGuestDomain) DeepCopy() *CapsGuestDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestDomain) DeepCopyInto(out *CapsGuestDomain) {
+	*out = *in
+	if in.Machines != nil {
+		out.Machines = make([]CapsGuestMachine, len(in.Machines))
+		for i := range in.Machines {
+			in.Machines[i].DeepCopyInto(&out.Machines[i])
+		}
+	}
+}
+
+func (a *CapsGuestDomain) Equal(b *CapsGuestDomain) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Emulator != b.Emulator {
+		return false
+	}
+	if len(a.Machines) != len(b.Machines) {
+		return false
+	}
+	for i := range a.Machines {
+		if !a.Machines[i].Equal(&b.Machines[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsGuestDomain) canonicalize() {
+	for i := range in.Machines {
+		in.Machines[i].canonicalize()
+	}
+	if len(in.Machines) == 0 {
+		in.Machines = nil
+	}
+}
+
+func (in *CapsGuestFeatureACPI) DeepCopy() *CapsGuestFeatureACPI {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureACPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureACPI) DeepCopyInto(out *CapsGuestFeatureACPI) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureACPI) Equal(b *CapsGuestFeatureACPI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Default != b.Default {
+		return false
+	}
+	if a.Toggle != b.Toggle {
+		return false
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureACPI) canonicalize() {
+}
+
+func (in *CapsGuestFeatureAPIC) DeepCopy() *CapsGuestFeatureAPIC {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureAPIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureAPIC) DeepCopyInto(out *CapsGuestFeatureAPIC) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureAPIC) Equal(b *CapsGuestFeatureAPIC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Default != b.Default {
+		return false
+	}
+	if a.Toggle != b.Toggle {
+		return false
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureAPIC) canonicalize() {
+}
+
+func (in *CapsGuestFeatureCPUSelection) DeepCopy() *CapsGuestFeatureCPUSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureCPUSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureCPUSelection) DeepCopyInto(out *CapsGuestFeatureCPUSelection) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureCPUSelection) Equal(b *CapsGuestFeatureCPUSelection) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureCPUSelection) canonicalize() {
+}
+
+func (in *CapsGuestFeatureDeviceBoot) DeepCopy() *CapsGuestFeatureDeviceBoot {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureDeviceBoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureDeviceBoot) DeepCopyInto(out *CapsGuestFeatureDeviceBoot) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureDeviceBoot) Equal(b *CapsGuestFeatureDeviceBoot) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureDeviceBoot) canonicalize() {
+}
+
+func (in *CapsGuestFeatureDiskSnapshot) DeepCopy() *CapsGuestFeatureDiskSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureDiskSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureDiskSnapshot) DeepCopyInto(out *CapsGuestFeatureDiskSnapshot) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureDiskSnapshot) Equal(b *CapsGuestFeatureDiskSnapshot) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Default != b.Default {
+		return false
+	}
+	if a.Toggle != b.Toggle {
+		return false
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureDiskSnapshot) canonicalize() {
+}
+
+func (in *CapsGuestFeatureIA64BE) DeepCopy() *CapsGuestFeatureIA64BE {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureIA64BE)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureIA64BE) DeepCopyInto(out *CapsGuestFeatureIA64BE) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureIA64BE) Equal(b *CapsGuestFeatureIA64BE) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureIA64BE) canonicalize() {
+}
+
+func (in *CapsGuestFeatureNonPAE) DeepCopy() *CapsGuestFeatureNonPAE {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatureNonPAE)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatureNonPAE) DeepCopyInto(out *CapsGuestFeatureNonPAE) {
+	*out = *in
+}
+
+func (a *CapsGuestFeatureNonPAE) Equal(b *CapsGuestFeatureNonPAE) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsGuestFeatureNonPAE) canonicalize() {
+}
+
+func (in *CapsGuestFeaturePAE) DeepCopy() *CapsGuestFeaturePAE {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeaturePAE)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeaturePAE) DeepCopyInto(out *CapsGuestFeaturePAE) {
+	*out = *in
+}
+
+func (a *CapsGuestFeaturePAE) Equal(b *CapsGuestFeaturePAE) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsGuestFeaturePAE) canonicalize() {
+}
+
+func (in *CapsGuestFeatures) DeepCopy() *CapsGuestFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestFeatures) DeepCopyInto(out *CapsGuestFeatures) {
+	*out = *in
+	if in.CPUSelection != nil {
+		out.CPUSelection = in.CPUSelection.DeepCopy()
+	}
+	if in.DeviceBoot != nil {
+		out.DeviceBoot = in.DeviceBoot.DeepCopy()
+	}
+	if in.DiskSnapshot != nil {
+		out.DiskSnapshot = in.DiskSnapshot.DeepCopy()
+	}
+	if in.PAE != nil {
+		out.PAE = in.PAE.DeepCopy()
+	}
+	if in.NonPAE != nil {
+		out.NonPAE = in.NonPAE.DeepCopy()
+	}
+	if in.APIC != nil {
+		out.APIC = in.APIC.DeepCopy()
+	}
+	if in.ACPI != nil {
+		out.ACPI = in.ACPI.DeepCopy()
+	}
+	if in.IA64BE != nil {
+		out.IA64BE = in.IA64BE.DeepCopy()
+	}
+}
+
+func (a *CapsGuestFeatures) Equal(b *CapsGuestFeatures) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.CPUSelection.Equal(b.CPUSelection) {
+		return false
+	}
+	if !a.DeviceBoot.Equal(b.DeviceBoot) {
+		return false
+	}
+	if !a.DiskSnapshot.Equal(b.DiskSnapshot) {
+		return false
+	}
+	if !a.PAE.Equal(b.PAE) {
+		return false
+	}
+	if !a.NonPAE.Equal(b.NonPAE) {
+		return false
+	}
+	if !a.APIC.Equal(b.APIC) {
+		return false
+	}
+	if !a.ACPI.Equal(b.ACPI) {
+		return false
+	}
+	if !a.IA64BE.Equal(b.IA64BE) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsGuestFeatures) canonicalize() {
+	if in.CPUSelection != nil {
+		in.CPUSelection.canonicalize()
+	}
+	if in.DeviceBoot != nil {
+		in.DeviceBoot.canonicalize()
+	}
+	if in.DiskSnapshot != nil {
+		in.DiskSnapshot.canonicalize()
+	}
+	if in.PAE != nil {
+		in.PAE.canonicalize()
+	}
+	if in.NonPAE != nil {
+		in.NonPAE.canonicalize()
+	}
+	if in.APIC != nil {
+		in.APIC.canonicalize()
+	}
+	if in.ACPI != nil {
+		in.ACPI.canonicalize()
+	}
+	if in.IA64BE != nil {
+		in.IA64BE.canonicalize()
+	}
+}
+
+func (in *CapsGuestFeatures) empty() bool {
+	return in.CPUSelection == nil &&
+		in.DeviceBoot == nil &&
+		in.DiskSnapshot == nil &&
+		in.PAE == nil &&
+		in.NonPAE == nil &&
+		in.APIC == nil &&
+		in.ACPI == nil &&
+		in.IA64BE == nil
+}
+
+func (in *CapsGuestMachine) DeepCopy() *CapsGuestMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsGuestMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsGuestMachine) DeepCopyInto(out *CapsGuestMachine) {
+	*out = *in
+}
+
+func (a *CapsGuestMachine) Equal(b *CapsGuestMachine) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.MaxCPUs != b.MaxCPUs {
+		return false
+	}
+	if a.Canonical != b.Canonical {
+		return false
+	}
+	return true
+}
+
+func (in *CapsGuestMachine) canonicalize() {
+}
+
+func (in *CapsHost) DeepCopy() *CapsHost {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHost) DeepCopyInto(out *CapsHost) {
+	*out = *in
+	if in.CPU != nil {
+		out.CPU = in.CPU.DeepCopy()
+	}
+	if in.PowerManagement != nil {
+		out.PowerManagement = in.PowerManagement.DeepCopy()
+	}
+	if in.IOMMU != nil {
+		out.IOMMU = in.IOMMU.DeepCopy()
+	}
+	if in.MigrationFeatures != nil {
+		out.MigrationFeatures = in.MigrationFeatures.DeepCopy()
+	}
+	if in.NUMA != nil {
+		out.NUMA = in.NUMA.DeepCopy()
+	}
+	if in.Cache != nil {
+		out.Cache = in.Cache.DeepCopy()
+	}
+	if in.SecModel != nil {
+		out.SecModel = make([]CapsHostSecModel, len(in.SecModel))
+		for i := range in.SecModel {
+			in.SecModel[i].DeepCopyInto(&out.SecModel[i])
+		}
+	}
+}
+
+func (a *CapsHost) Equal(b *CapsHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if !a.CPU.Equal(b.CPU) {
+		return false
+	}
+	if !a.PowerManagement.Equal(b.PowerManagement) {
+		return false
+	}
+	if !a.IOMMU.Equal(b.IOMMU) {
+		return false
+	}
+	if !a.MigrationFeatures.Equal(b.MigrationFeatures) {
+		return false
+	}
+	if !a.NUMA.Equal(b.NUMA) {
+		return false
+	}
+	if !a.Cache.Equal(b.Cache) {
+		return false
+	}
+	if len(a.SecModel) != len(b.SecModel) {
+		return false
+	}
+	for i := range a.SecModel {
+		if !a.SecModel[i].Equal(&b.SecModel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHost) canonicalize() {
+	if in.CPU != nil {
+		in.CPU.canonicalize()
+	}
+	if in.PowerManagement != nil {
+		in.PowerManagement.canonicalize()
+		if in.PowerManagement.empty() {
+			in.PowerManagement = nil
+		}
+	}
+	if in.IOMMU != nil {
+		in.IOMMU.canonicalize()
+	}
+	if in.MigrationFeatures != nil {
+		in.MigrationFeatures.canonicalize()
+		if in.MigrationFeatures.empty() {
+			in.MigrationFeatures = nil
+		}
+	}
+	if in.NUMA != nil {
+		in.NUMA.canonicalize()
+		if in.NUMA.empty() {
+			in.NUMA = nil
+		}
+	}
+	if in.Cache != nil {
+		in.Cache.canonicalize()
+		if in.Cache.empty() {
+			in.Cache = nil
+		}
+	}
+	for i := range in.SecModel {
+		in.SecModel[i].canonicalize()
+	}
+	if len(in.SecModel) == 0 {
+		in.SecModel = nil
+	}
+}
+
+func (in *CapsHostCPU) DeepCopy() *CapsHostCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPU) DeepCopyInto(out *CapsHostCPU) {
+	*out = *in
+	if in.Topology != nil {
+		out.Topology = in.Topology.DeepCopy()
+	}
+	if in.FeatureFlags != nil {
+		out.FeatureFlags = make([]CapsHostCPUFeatureFlag, len(in.FeatureFlags))
+		for i := range in.FeatureFlags {
+			in.FeatureFlags[i].DeepCopyInto(&out.FeatureFlags[i])
+		}
+	}
+	if in.Features != nil {
+		out.Features = in.Features.DeepCopy()
+	}
+	if in.PageSizes != nil {
+		out.PageSizes = make([]CapsHostCPUPageSize, len(in.PageSizes))
+		for i := range in.PageSizes {
+			in.PageSizes[i].DeepCopyInto(&out.PageSizes[i])
+		}
+	}
+	if in.Microcode != nil {
+		out.Microcode = in.Microcode.DeepCopy()
+	}
+}
+
+func (a *CapsHostCPU) Equal(b *CapsHostCPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Arch != b.Arch {
+		return false
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if !a.Topology.Equal(b.Topology) {
+		return false
+	}
+	if len(a.FeatureFlags) != len(b.FeatureFlags) {
+		return false
+	}
+	for i := range a.FeatureFlags {
+		if !a.FeatureFlags[i].Equal(&b.FeatureFlags[i]) {
+			return false
+		}
+	}
+	if !a.Features.Equal(b.Features) {
+		return false
+	}
+	if len(a.PageSizes) != len(b.PageSizes) {
+		return false
+	}
+	for i := range a.PageSizes {
+		if !a.PageSizes[i].Equal(&b.PageSizes[i]) {
+			return false
+		}
+	}
+	if !a.Microcode.Equal(b.Microcode) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCPU) canonicalize() {
+	if in.Topology != nil {
+		in.Topology.canonicalize()
+	}
+	for i := range in.FeatureFlags {
+		in.FeatureFlags[i].canonicalize()
+	}
+	if len(in.FeatureFlags) == 0 {
+		in.FeatureFlags = nil
+	}
+	if in.Features != nil {
+		in.Features.canonicalize()
+		if in.Features.empty() {
+			in.Features = nil
+		}
+	}
+	for i := range in.PageSizes {
+		in.PageSizes[i].canonicalize()
+	}
+	if len(in.PageSizes) == 0 {
+		in.PageSizes = nil
+	}
+	if in.Microcode != nil {
+		in.Microcode.canonicalize()
+	}
+}
+
+func (d *CapsHostCPU) Canonicalize() *CapsHostCPU {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *CapsHostCPU) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *CapsHostCPU) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *CapsHostCPU) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *CapsHostCPU) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *CapsHostCPU) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *CapsHostCPU) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *CapsHostCPU) schemaRef() (string, string) {
+	return "capability.rng", "hostcpu"
+}
+
+func (in *CapsHostCPUFeature) DeepCopy() *CapsHostCPUFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPUFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPUFeature) DeepCopyInto(out *CapsHostCPUFeature) {
+	*out = *in
+}
+
+func (a *CapsHostCPUFeature) Equal(b *CapsHostCPUFeature) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsHostCPUFeature) canonicalize() {
+}
+
+func (in *CapsHostCPUFeatureFlag) DeepCopy() *CapsHostCPUFeatureFlag {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPUFeatureFlag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPUFeatureFlag) DeepCopyInto(out *CapsHostCPUFeatureFlag) {
+	*out = *in
+}
+
+func (a *CapsHostCPUFeatureFlag) Equal(b *CapsHostCPUFeatureFlag) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCPUFeatureFlag) canonicalize() {
+}
+
+func (in *CapsHostCPUFeatures) DeepCopy() *CapsHostCPUFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPUFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPUFeatures) DeepCopyInto(out *CapsHostCPUFeatures) {
+	*out = *in
+	if in.PAE != nil {
+		out.PAE = in.PAE.DeepCopy()
+	}
+	if in.NonPAE != nil {
+		out.NonPAE = in.NonPAE.DeepCopy()
+	}
+	if in.SVM != nil {
+		out.SVM = in.SVM.DeepCopy()
+	}
+	if in.VMX != nil {
+		out.VMX = in.VMX.DeepCopy()
+	}
+}
+
+func (a *CapsHostCPUFeatures) Equal(b *CapsHostCPUFeatures) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.PAE.Equal(b.PAE) {
+		return false
+	}
+	if !a.NonPAE.Equal(b.NonPAE) {
+		return false
+	}
+	if !a.SVM.Equal(b.SVM) {
+		return false
+	}
+	if !a.VMX.Equal(b.VMX) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCPUFeatures) canonicalize() {
+	if in.PAE != nil {
+		in.PAE.canonicalize()
+	}
+	if in.NonPAE != nil {
+		in.NonPAE.canonicalize()
+	}
+	if in.SVM != nil {
+		in.SVM.canonicalize()
+	}
+	if in.VMX != nil {
+		in.VMX.canonicalize()
+	}
+}
+
+func (in *CapsHostCPUFeatures) empty() bool {
+	return in.PAE == nil &&
+		in.NonPAE == nil &&
+		in.SVM == nil &&
+		in.VMX == nil
+}
+
+func (in *CapsHostCPUMicrocode) DeepCopy() *CapsHostCPUMicrocode {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPUMicrocode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPUMicrocode) DeepCopyInto(out *CapsHostCPUMicrocode) {
+	*out = *in
+}
+
+func (a *CapsHostCPUMicrocode) Equal(b *CapsHostCPUMicrocode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Version != b.Version {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCPUMicrocode) canonicalize() {
+}
+
+func (in *CapsHostCPUPageSize) DeepCopy() *CapsHostCPUPageSize {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPUPageSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPUPageSize) DeepCopyInto(out *CapsHostCPUPageSize) {
+	*out = *in
+}
+
+func (a *CapsHostCPUPageSize) Equal(b *CapsHostCPUPageSize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCPUPageSize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Size = int(canonicalScaled(uint64(in.Size), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *CapsHostCPUTopology) DeepCopy() *CapsHostCPUTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCPUTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCPUTopology) DeepCopyInto(out *CapsHostCPUTopology) {
+	*out = *in
+}
+
+func (a *CapsHostCPUTopology) Equal(b *CapsHostCPUTopology) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Sockets != b.Sockets {
+		return false
+	}
+	if a.Cores != b.Cores {
+		return false
+	}
+	if a.Threads != b.Threads {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCPUTopology) canonicalize() {
+}
+
+func (in *CapsHostCache) DeepCopy() *CapsHostCache {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCache) DeepCopyInto(out *CapsHostCache) {
+	*out = *in
+	if in.Banks != nil {
+		out.Banks = make([]CapsHostCacheBank, len(in.Banks))
+		for i := range in.Banks {
+			in.Banks[i].DeepCopyInto(&out.Banks[i])
+		}
+	}
+}
+
+func (a *CapsHostCache) Equal(b *CapsHostCache) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Banks) != len(b.Banks) {
+		return false
+	}
+	for i := range a.Banks {
+		if !a.Banks[i].Equal(&b.Banks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostCache) canonicalize() {
+	for i := range in.Banks {
+		in.Banks[i].canonicalize()
+	}
+	if len(in.Banks) == 0 {
+		in.Banks = nil
+	}
+}
+
+func (in *CapsHostCache) empty() bool {
+	return len(in.Banks) == 0
+}
+
+func (in *CapsHostCacheBank) DeepCopy() *CapsHostCacheBank {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCacheBank)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCacheBank) DeepCopyInto(out *CapsHostCacheBank) {
+	*out = *in
+	if in.Control != nil {
+		out.Control = make([]CapsHostCacheControl, len(in.Control))
+		for i := range in.Control {
+			in.Control[i].DeepCopyInto(&out.Control[i])
+		}
+	}
+}
+
+func (a *CapsHostCacheBank) Equal(b *CapsHostCacheBank) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.Level != b.Level {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "B") {
+		return false
+	}
+	if a.CPUs != b.CPUs {
+		return false
+	}
+	if len(a.Control) != len(b.Control) {
+		return false
+	}
+	for i := range a.Control {
+		if !a.Control[i].Equal(&b.Control[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostCacheBank) canonicalize() {
+	for i := range in.Control {
+		in.Control[i].canonicalize()
+	}
+	if len(in.Control) == 0 {
+		in.Control = nil
+	}
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Size = uint(canonicalScaled(uint64(in.Size), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *CapsHostCacheControl) DeepCopy() *CapsHostCacheControl {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostCacheControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostCacheControl) DeepCopyInto(out *CapsHostCacheControl) {
+	*out = *in
+}
+
+func (a *CapsHostCacheControl) Equal(b *CapsHostCacheControl) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Granularity), a.Unit, uint64(b.Granularity), b.Unit, "B") {
+		return false
+	}
+	if !equalScaled(uint64(a.Min), a.Unit, uint64(b.Min), b.Unit, "B") {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.MaxAllows != b.MaxAllows {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostCacheControl) canonicalize() {
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Granularity = uint(canonicalScaled(uint64(in.Granularity), scale, 1))
+		in.Min = uint(canonicalScaled(uint64(in.Min), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *CapsHostIOMMU) DeepCopy() *CapsHostIOMMU {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostIOMMU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostIOMMU) DeepCopyInto(out *CapsHostIOMMU) {
+	*out = *in
+}
+
+func (a *CapsHostIOMMU) Equal(b *CapsHostIOMMU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Support != b.Support {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostIOMMU) canonicalize() {
+}
+
+func (in *CapsHostMigrationFeatures) DeepCopy() *CapsHostMigrationFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostMigrationFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostMigrationFeatures) DeepCopyInto(out *CapsHostMigrationFeatures) {
+	*out = *in
+	if in.Live != nil {
+		out.Live = in.Live.DeepCopy()
+	}
+	if in.URITransports != nil {
+		out.URITransports = in.URITransports.DeepCopy()
+	}
+}
+
+func (a *CapsHostMigrationFeatures) Equal(b *CapsHostMigrationFeatures) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Live.Equal(b.Live) {
+		return false
+	}
+	if !a.URITransports.Equal(b.URITransports) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostMigrationFeatures) canonicalize() {
+	if in.Live != nil {
+		in.Live.canonicalize()
+	}
+	if in.URITransports != nil {
+		in.URITransports.canonicalize()
+		if in.URITransports.empty() {
+			in.URITransports = nil
+		}
+	}
+}
+
+func (in *CapsHostMigrationFeatures) empty() bool {
+	return in.Live == nil &&
+		in.URITransports == nil
+}
+
+func (in *CapsHostMigrationLive) DeepCopy() *CapsHostMigrationLive {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostMigrationLive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostMigrationLive) DeepCopyInto(out *CapsHostMigrationLive) {
+	*out = *in
+}
+
+func (a *CapsHostMigrationLive) Equal(b *CapsHostMigrationLive) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsHostMigrationLive) canonicalize() {
+}
+
+func (in *CapsHostMigrationURITransports) DeepCopy() *CapsHostMigrationURITransports {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostMigrationURITransports)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostMigrationURITransports) DeepCopyInto(out *CapsHostMigrationURITransports) {
+	*out = *in
+	if in.URI != nil {
+		out.URI = make([]string, len(in.URI))
+		copy(out.URI, in.URI)
+	}
+}
+
+func (a *CapsHostMigrationURITransports) Equal(b *CapsHostMigrationURITransports) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.URI) != len(b.URI) {
+		return false
+	}
+	for i := range a.URI {
+		if a.URI[i] != b.URI[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostMigrationURITransports) canonicalize() {
+	if len(in.URI) == 0 {
+		in.URI = nil
+	}
+}
+
+func (in *CapsHostMigrationURITransports) empty() bool {
+	return len(in.URI) == 0
+}
+
+func (in *CapsHostNUMACPU) DeepCopy() *CapsHostNUMACPU {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMACPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMACPU) DeepCopyInto(out *CapsHostNUMACPU) {
+	*out = *in
+	if in.SocketID != nil {
+		v := *in.SocketID
+		out.SocketID = &v
+	}
+	if in.CoreID != nil {
+		v := *in.CoreID
+		out.CoreID = &v
+	}
+}
+
+func (a *CapsHostNUMACPU) Equal(b *CapsHostNUMACPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if (a.SocketID == nil) != (b.SocketID == nil) || (a.SocketID != nil && *a.SocketID != *b.SocketID) {
+		return false
+	}
+	if (a.CoreID == nil) != (b.CoreID == nil) || (a.CoreID != nil && *a.CoreID != *b.CoreID) {
+		return false
+	}
+	if a.Siblings != b.Siblings {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostNUMACPU) canonicalize() {
+}
+
+func (in *CapsHostNUMACPUs) DeepCopy() *CapsHostNUMACPUs {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMACPUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMACPUs) DeepCopyInto(out *CapsHostNUMACPUs) {
+	*out = *in
+	if in.CPUs != nil {
+		out.CPUs = make([]CapsHostNUMACPU, len(in.CPUs))
+		for i := range in.CPUs {
+			in.CPUs[i].DeepCopyInto(&out.CPUs[i])
+		}
+	}
+}
+
+func (a *CapsHostNUMACPUs) Equal(b *CapsHostNUMACPUs) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Num != b.Num {
+		return false
+	}
+	if len(a.CPUs) != len(b.CPUs) {
+		return false
+	}
+	for i := range a.CPUs {
+		if !a.CPUs[i].Equal(&b.CPUs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostNUMACPUs) canonicalize() {
+	for i := range in.CPUs {
+		in.CPUs[i].canonicalize()
+	}
+	if len(in.CPUs) == 0 {
+		in.CPUs = nil
+	}
+}
+
+func (in *CapsHostNUMACell) DeepCopy() *CapsHostNUMACell {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMACell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMACell) DeepCopyInto(out *CapsHostNUMACell) {
+	*out = *in
+	if in.Memory != nil {
+		out.Memory = in.Memory.DeepCopy()
+	}
+	if in.PageInfo != nil {
+		out.PageInfo = make([]CapsHostNUMAPageInfo, len(in.PageInfo))
+		for i := range in.PageInfo {
+			in.PageInfo[i].DeepCopyInto(&out.PageInfo[i])
+		}
+	}
+	if in.Distances != nil {
+		out.Distances = in.Distances.DeepCopy()
+	}
+	if in.CPUS != nil {
+		out.CPUS = in.CPUS.DeepCopy()
+	}
+}
+
+func (a *CapsHostNUMACell) Equal(b *CapsHostNUMACell) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if !a.Memory.Equal(b.Memory) {
+		return false
+	}
+	if len(a.PageInfo) != len(b.PageInfo) {
+		return false
+	}
+	for i := range a.PageInfo {
+		if !a.PageInfo[i].Equal(&b.PageInfo[i]) {
+			return false
+		}
+	}
+	if !a.Distances.Equal(b.Distances) {
+		return false
+	}
+	if !a.CPUS.Equal(b.CPUS) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostNUMACell) canonicalize() {
+	if in.Memory != nil {
+		in.Memory.canonicalize()
+	}
+	for i := range in.PageInfo {
+		in.PageInfo[i].canonicalize()
+	}
+	if len(in.PageInfo) == 0 {
+		in.PageInfo = nil
+	}
+	if in.Distances != nil {
+		in.Distances.canonicalize()
+		if in.Distances.empty() {
+			in.Distances = nil
+		}
+	}
+	if in.CPUS != nil {
+		in.CPUS.canonicalize()
+	}
+}
+
+func (in *CapsHostNUMACells) DeepCopy() *CapsHostNUMACells {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMACells)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMACells) DeepCopyInto(out *CapsHostNUMACells) {
+	*out = *in
+	if in.Cells != nil {
+		out.Cells = make([]CapsHostNUMACell, len(in.Cells))
+		for i := range in.Cells {
+			in.Cells[i].DeepCopyInto(&out.Cells[i])
+		}
+	}
+}
+
+func (a *CapsHostNUMACells) Equal(b *CapsHostNUMACells) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Num != b.Num {
+		return false
+	}
+	if len(a.Cells) != len(b.Cells) {
+		return false
+	}
+	for i := range a.Cells {
+		if !a.Cells[i].Equal(&b.Cells[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostNUMACells) canonicalize() {
+	for i := range in.Cells {
+		in.Cells[i].canonicalize()
+	}
+	if len(in.Cells) == 0 {
+		in.Cells = nil
+	}
+}
+
+func (in *CapsHostNUMADistances) DeepCopy() *CapsHostNUMADistances {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMADistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMADistances) DeepCopyInto(out *CapsHostNUMADistances) {
+	*out = *in
+	if in.Siblings != nil {
+		out.Siblings = make([]CapsHostNUMASibling, len(in.Siblings))
+		for i := range in.Siblings {
+			in.Siblings[i].DeepCopyInto(&out.Siblings[i])
+		}
+	}
+}
+
+func (a *CapsHostNUMADistances) Equal(b *CapsHostNUMADistances) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Siblings) != len(b.Siblings) {
+		return false
+	}
+	for i := range a.Siblings {
+		if !a.Siblings[i].Equal(&b.Siblings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostNUMADistances) canonicalize() {
+	for i := range in.Siblings {
+		in.Siblings[i].canonicalize()
+	}
+	if len(in.Siblings) == 0 {
+		in.Siblings = nil
+	}
+}
+
+func (in *CapsHostNUMADistances) empty() bool {
+	return len(in.Siblings) == 0
+}
+
+func (in *CapsHostNUMAMemory) DeepCopy() *CapsHostNUMAMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMAMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMAMemory) DeepCopyInto(out *CapsHostNUMAMemory) {
+	*out = *in
+}
+
+func (a *CapsHostNUMAMemory) Equal(b *CapsHostNUMAMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostNUMAMemory) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Size = uint64(canonicalScaled(uint64(in.Size), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *CapsHostNUMAPageInfo) DeepCopy() *CapsHostNUMAPageInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMAPageInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMAPageInfo) DeepCopyInto(out *CapsHostNUMAPageInfo) {
+	*out = *in
+}
+
+func (a *CapsHostNUMAPageInfo) Equal(b *CapsHostNUMAPageInfo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "KiB") {
+		return false
+	}
+	if a.Count != b.Count {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostNUMAPageInfo) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Size = int(canonicalScaled(uint64(in.Size), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *CapsHostNUMASibling) DeepCopy() *CapsHostNUMASibling {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMASibling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMASibling) DeepCopyInto(out *CapsHostNUMASibling) {
+	*out = *in
+}
+
+func (a *CapsHostNUMASibling) Equal(b *CapsHostNUMASibling) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostNUMASibling) canonicalize() {
+}
+
+func (in *CapsHostNUMATopology) DeepCopy() *CapsHostNUMATopology {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostNUMATopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostNUMATopology) DeepCopyInto(out *CapsHostNUMATopology) {
+	*out = *in
+	if in.Cells != nil {
+		out.Cells = in.Cells.DeepCopy()
+	}
+}
+
+func (a *CapsHostNUMATopology) Equal(b *CapsHostNUMATopology) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Cells.Equal(b.Cells) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostNUMATopology) canonicalize() {
+	if in.Cells != nil {
+		in.Cells.canonicalize()
+	}
+}
+
+func (in *CapsHostNUMATopology) empty() bool {
+	return in.Cells == nil
+}
+
+func (in *CapsHostPowerManagement) DeepCopy() *CapsHostPowerManagement {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostPowerManagement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostPowerManagement) DeepCopyInto(out *CapsHostPowerManagement) {
+	*out = *in
+	if in.SuspendMem != nil {
+		out.SuspendMem = in.SuspendMem.DeepCopy()
+	}
+	if in.SuspendDisk != nil {
+		out.SuspendDisk = in.SuspendDisk.DeepCopy()
+	}
+	if in.SuspendHybrid != nil {
+		out.SuspendHybrid = in.SuspendHybrid.DeepCopy()
+	}
+}
+
+func (a *CapsHostPowerManagement) Equal(b *CapsHostPowerManagement) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SuspendMem.Equal(b.SuspendMem) {
+		return false
+	}
+	if !a.SuspendDisk.Equal(b.SuspendDisk) {
+		return false
+	}
+	if !a.SuspendHybrid.Equal(b.SuspendHybrid) {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostPowerManagement) canonicalize() {
+	if in.SuspendMem != nil {
+		in.SuspendMem.canonicalize()
+	}
+	if in.SuspendDisk != nil {
+		in.SuspendDisk.canonicalize()
+	}
+	if in.SuspendHybrid != nil {
+		in.SuspendHybrid.canonicalize()
+	}
+}
+
+func (in *CapsHostPowerManagement) empty() bool {
+	return in.SuspendMem == nil &&
+		in.SuspendDisk == nil &&
+		in.SuspendHybrid == nil
+}
+
+func (in *CapsHostPowerManagementMode) DeepCopy() *CapsHostPowerManagementMode {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostPowerManagementMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostPowerManagementMode) DeepCopyInto(out *CapsHostPowerManagementMode) {
+	*out = *in
+}
+
+func (a *CapsHostPowerManagementMode) Equal(b *CapsHostPowerManagementMode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *CapsHostPowerManagementMode) canonicalize() {
+}
+
+func (in *CapsHostSecModel) DeepCopy() *CapsHostSecModel {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostSecModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostSecModel) DeepCopyInto(out *CapsHostSecModel) {
+	*out = *in
+	if in.Labels != nil {
+		out.Labels = make([]CapsHostSecModelLabel, len(in.Labels))
+		for i := range in.Labels {
+			in.Labels[i].DeepCopyInto(&out.Labels[i])
+		}
+	}
+}
+
+func (a *CapsHostSecModel) Equal(b *CapsHostSecModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.DOI != b.DOI {
+		return false
+	}
+	if len(a.Labels) != len(b.Labels) {
+		return false
+	}
+	for i := range a.Labels {
+		if !a.Labels[i].Equal(&b.Labels[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *CapsHostSecModel) canonicalize() {
+	for i := range in.Labels {
+		in.Labels[i].canonicalize()
+	}
+	if len(in.Labels) == 0 {
+		in.Labels = nil
+	}
+}
+
+func (in *CapsHostSecModelLabel) DeepCopy() *CapsHostSecModelLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(CapsHostSecModelLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CapsHostSecModelLabel) DeepCopyInto(out *CapsHostSecModelLabel) {
+	*out = *in
+}
+
+func (a *CapsHostSecModelLabel) Equal(b *CapsHostSecModelLabel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *CapsHostSecModelLabel) canonicalize() {
+}
+
+func (in *Domain) DeepCopy() *Domain {
+	if in == nil {
+		return nil
+	}
+	out := new(Domain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Domain) DeepCopyInto(out *Domain) {
+	*out = *in
+	if in.ID != nil {
+		v := *in.ID
+		out.ID = &v
+	}
+	if in.GenID != nil {
+		out.GenID = in.GenID.DeepCopy()
+	}
+	if in.Metadata != nil {
+		out.Metadata = in.Metadata.DeepCopy()
+	}
+	if in.MaximumMemory != nil {
+		out.MaximumMemory = in.MaximumMemory.DeepCopy()
+	}
+	if in.Memory != nil {
+		out.Memory = in.Memory.DeepCopy()
+	}
+	if in.CurrentMemory != nil {
+		out.CurrentMemory = in.CurrentMemory.DeepCopy()
+	}
+	if in.BlockIOTune != nil {
+		out.BlockIOTune = in.BlockIOTune.DeepCopy()
+	}
+	if in.MemoryTune != nil {
+		out.MemoryTune = in.MemoryTune.DeepCopy()
+	}
+	if in.MemoryBacking != nil {
+		out.MemoryBacking = in.MemoryBacking.DeepCopy()
+	}
+	if in.VCPU != nil {
+		out.VCPU = in.VCPU.DeepCopy()
+	}
+	if in.VCPUs != nil {
+		out.VCPUs = in.VCPUs.DeepCopy()
+	}
+	if in.IOThreadIDs != nil {
+		out.IOThreadIDs = in.IOThreadIDs.DeepCopy()
+	}
+	if in.CPUTune != nil {
+		out.CPUTune = in.CPUTune.DeepCopy()
+	}
+	if in.NUMATune != nil {
+		out.NUMATune = in.NUMATune.DeepCopy()
+	}
+	if in.Resource != nil {
+		out.Resource = in.Resource.DeepCopy()
+	}
+	if in.SysInfo != nil {
+		out.SysInfo = in.SysInfo.DeepCopy()
+	}
+	if in.OS != nil {
+		out.OS = in.OS.DeepCopy()
+	}
+	if in.IDMap != nil {
+		out.IDMap = in.IDMap.DeepCopy()
+	}
+	if in.Features != nil {
+		out.Features = in.Features.DeepCopy()
+	}
+	if in.CPU != nil {
+		out.CPU = in.CPU.DeepCopy()
+	}
+	if in.Clock != nil {
+		out.Clock = in.Clock.DeepCopy()
+	}
+	if in.PM != nil {
+		out.PM = in.PM.DeepCopy()
+	}
+	if in.Perf != nil {
+		out.Perf = in.Perf.DeepCopy()
+	}
+	if in.Devices != nil {
+		out.Devices = in.Devices.DeepCopy()
+	}
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+	if in.QEMUCommandline != nil {
+		out.QEMUCommandline = in.QEMUCommandline.DeepCopy()
+	}
+	if in.LXCNamespace != nil {
+		out.LXCNamespace = in.LXCNamespace.DeepCopy()
+	}
+	if in.VMWareDataCenterPath != nil {
+		out.VMWareDataCenterPath = in.VMWareDataCenterPath.DeepCopy()
+	}
+	if in.KeyWrap != nil {
+		out.KeyWrap = in.KeyWrap.DeepCopy()
+	}
+	if in.LaunchSecurity != nil {
+		out.LaunchSecurity = in.LaunchSecurity.DeepCopy()
+	}
+}
+
+func (a *Domain) Equal(b *Domain) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if (a.ID == nil) != (b.ID == nil) || (a.ID != nil && *a.ID != *b.ID) {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if !a.GenID.Equal(b.GenID) {
+		return false
+	}
+	if a.Title != b.Title {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if !a.Metadata.Equal(b.Metadata) {
+		return false
+	}
+	if !a.MaximumMemory.Equal(b.MaximumMemory) {
+		return false
+	}
+	if !a.Memory.Equal(b.Memory) {
+		return false
+	}
+	if !a.CurrentMemory.Equal(b.CurrentMemory) {
+		return false
+	}
+	if !a.BlockIOTune.Equal(b.BlockIOTune) {
+		return false
+	}
+	if !a.MemoryTune.Equal(b.MemoryTune) {
+		return false
+	}
+	if !a.MemoryBacking.Equal(b.MemoryBacking) {
+		return false
+	}
+	if !a.VCPU.Equal(b.VCPU) {
+		return false
+	}
+	if !a.VCPUs.Equal(b.VCPUs) {
+		return false
+	}
+	if a.IOThreads != b.IOThreads {
+		return false
+	}
+	if !a.IOThreadIDs.Equal(b.IOThreadIDs) {
+		return false
+	}
+	if !a.CPUTune.Equal(b.CPUTune) {
+		return false
+	}
+	if !a.NUMATune.Equal(b.NUMATune) {
+		return false
+	}
+	if !a.Resource.Equal(b.Resource) {
+		return false
+	}
+	if !a.SysInfo.Equal(b.SysInfo) {
+		return false
+	}
+	if a.Bootloader != b.Bootloader {
+		return false
+	}
+	if a.BootloaderArgs != b.BootloaderArgs {
+		return false
+	}
+	if !a.OS.Equal(b.OS) {
+		return false
+	}
+	if !a.IDMap.Equal(b.IDMap) {
+		return false
+	}
+	if !a.Features.Equal(b.Features) {
+		return false
+	}
+	if !a.CPU.Equal(b.CPU) {
+		return false
+	}
+	if !a.Clock.Equal(b.Clock) {
+		return false
+	}
+	if a.OnPoweroff != b.OnPoweroff {
+		return false
+	}
+	if a.OnReboot != b.OnReboot {
+		return false
+	}
+	if a.OnCrash != b.OnCrash {
+		return false
+	}
+	if !a.PM.Equal(b.PM) {
+		return false
+	}
+	if !a.Perf.Equal(b.Perf) {
+		return false
+	}
+	if !a.Devices.Equal(b.Devices) {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	if !a.QEMUCommandline.Equal(b.QEMUCommandline) {
+		return false
+	}
+	if !a.LXCNamespace.Equal(b.LXCNamespace) {
+		return false
+	}
+	if !a.VMWareDataCenterPath.Equal(b.VMWareDataCenterPath) {
+		return false
+	}
+	if !a.KeyWrap.Equal(b.KeyWrap) {
+		return false
+	}
+	if !a.LaunchSecurity.Equal(b.LaunchSecurity) {
+		return false
+	}
+	return true
+}
+
+func (in *Domain) canonicalize() {
+	if in.GenID != nil {
+		in.GenID.canonicalize()
+	}
+	if in.Metadata != nil {
+		in.Metadata.canonicalize()
+	}
+	if in.MaximumMemory != nil {
+		in.MaximumMemory.canonicalize()
+	}
+	if in.Memory != nil {
+		in.Memory.canonicalize()
+	}
+	if in.CurrentMemory != nil {
+		in.CurrentMemory.canonicalize()
+	}
+	if in.BlockIOTune != nil {
+		in.BlockIOTune.canonicalize()
+	}
+	if in.MemoryTune != nil {
+		in.MemoryTune.canonicalize()
+		if in.MemoryTune.empty() {
+			in.MemoryTune = nil
+		}
+	}
+	if in.MemoryBacking != nil {
+		in.MemoryBacking.canonicalize()
+		if in.MemoryBacking.empty() {
+			in.MemoryBacking = nil
+		}
+	}
+	if in.VCPU != nil {
+		in.VCPU.canonicalize()
+	}
+	if in.VCPUs != nil {
+		in.VCPUs.canonicalize()
+		if in.VCPUs.empty() {
+			in.VCPUs = nil
+		}
+	}
+	if in.IOThreadIDs != nil {
+		in.IOThreadIDs.canonicalize()
+		if in.IOThreadIDs.empty() {
+			in.IOThreadIDs = nil
+		}
+	}
+	if in.CPUTune != nil {
+		in.CPUTune.canonicalize()
+		if in.CPUTune.empty() {
+			in.CPUTune = nil
+		}
+	}
+	if in.NUMATune != nil {
+		in.NUMATune.canonicalize()
+		if in.NUMATune.empty() {
+			in.NUMATune = nil
+		}
+	}
+	if in.Resource != nil {
+		in.Resource.canonicalize()
+	}
+	if in.SysInfo != nil {
+		in.SysInfo.canonicalize()
+	}
+	if in.OS != nil {
+		in.OS.canonicalize()
+	}
+	if in.IDMap != nil {
+		in.IDMap.canonicalize()
+		if in.IDMap.empty() {
+			in.IDMap = nil
+		}
+	}
+	if in.Features != nil {
+		in.Features.canonicalize()
+		if in.Features.empty() {
+			in.Features = nil
+		}
+	}
+	if in.CPU != nil {
+		in.CPU.canonicalize()
+	}
+	if in.Clock != nil {
+		in.Clock.canonicalize()
+	}
+	if in.PM != nil {
+		in.PM.canonicalize()
+		if in.PM.empty() {
+			in.PM = nil
+		}
+	}
+	if in.Perf != nil {
+		in.Perf.canonicalize()
+		if in.Perf.empty() {
+			in.Perf = nil
+		}
+	}
+	if in.Devices != nil {
+		in.Devices.canonicalize()
+	}
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+	if in.QEMUCommandline != nil {
+		in.QEMUCommandline.canonicalize()
+		if in.QEMUCommandline.empty() {
+			in.QEMUCommandline = nil
+		}
+	}
+	if in.LXCNamespace != nil {
+		in.LXCNamespace.canonicalize()
+		if in.LXCNamespace.empty() {
+			in.LXCNamespace = nil
+		}
+	}
+	if in.VMWareDataCenterPath != nil {
+		in.VMWareDataCenterPath.canonicalize()
+	}
+	if in.KeyWrap != nil {
+		in.KeyWrap.canonicalize()
+		if in.KeyWrap.empty() {
+			in.KeyWrap = nil
+		}
+	}
+	if in.LaunchSecurity != nil {
+		in.LaunchSecurity.canonicalize()
+	}
+}
+
+func (d *Domain) Canonicalize() *Domain {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *Domain) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *Domain) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *Domain) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *Domain) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *Domain) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *Domain) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *Domain) schemaRef() (string, string) {
+	return "domain.rng", ""
+}
+
+func (in *DomainACPI) DeepCopy() *DomainACPI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainACPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainACPI) DeepCopyInto(out *DomainACPI) {
+	*out = *in
+	if in.Tables != nil {
+		out.Tables = make([]DomainACPITable, len(in.Tables))
+		for i := range in.Tables {
+			in.Tables[i].DeepCopyInto(&out.Tables[i])
+		}
+	}
+}
+
+func (a *DomainACPI) Equal(b *DomainACPI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Tables) != len(b.Tables) {
+		return false
+	}
+	for i := range a.Tables {
+		if !a.Tables[i].Equal(&b.Tables[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainACPI) canonicalize() {
+	for i := range in.Tables {
+		in.Tables[i].canonicalize()
+	}
+	if len(in.Tables) == 0 {
+		in.Tables = nil
+	}
+}
+
+func (in *DomainACPI) empty() bool {
+	return len(in.Tables) == 0
+}
+
+func (in *DomainACPITable) DeepCopy() *DomainACPITable {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainACPITable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainACPITable) DeepCopyInto(out *DomainACPITable) {
+	*out = *in
+}
+
+func (a *DomainACPITable) Equal(b *DomainACPITable) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *DomainACPITable) canonicalize() {
+}
+
+func (in *DomainAddress) DeepCopy() *DomainAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddress) DeepCopyInto(out *DomainAddress) {
+	*out = *in
+	if in.PCI != nil {
+		out.PCI = in.PCI.DeepCopy()
+	}
+	if in.Drive != nil {
+		out.Drive = in.Drive.DeepCopy()
+	}
+	if in.VirtioSerial != nil {
+		out.VirtioSerial = in.VirtioSerial.DeepCopy()
+	}
+	if in.CCID != nil {
+		out.CCID = in.CCID.DeepCopy()
+	}
+	if in.USB != nil {
+		out.USB = in.USB.DeepCopy()
+	}
+	if in.SpaprVIO != nil {
+		out.SpaprVIO = in.SpaprVIO.DeepCopy()
+	}
+	if in.VirtioS390 != nil {
+		out.VirtioS390 = in.VirtioS390.DeepCopy()
+	}
+	if in.CCW != nil {
+		out.CCW = in.CCW.DeepCopy()
+	}
+	if in.VirtioMMIO != nil {
+		out.VirtioMMIO = in.VirtioMMIO.DeepCopy()
+	}
+	if in.ISA != nil {
+		out.ISA = in.ISA.DeepCopy()
+	}
+	if in.DIMM != nil {
+		out.DIMM = in.DIMM.DeepCopy()
+	}
+}
+
+func (a *DomainAddress) Equal(b *DomainAddress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.PCI.Equal(b.PCI) {
+		return false
+	}
+	if !a.Drive.Equal(b.Drive) {
+		return false
+	}
+	if !a.VirtioSerial.Equal(b.VirtioSerial) {
+		return false
+	}
+	if !a.CCID.Equal(b.CCID) {
+		return false
+	}
+	if !a.USB.Equal(b.USB) {
+		return false
+	}
+	if !a.SpaprVIO.Equal(b.SpaprVIO) {
+		return false
+	}
+	if !a.VirtioS390.Equal(b.VirtioS390) {
+		return false
+	}
+	if !a.CCW.Equal(b.CCW) {
+		return false
+	}
+	if !a.VirtioMMIO.Equal(b.VirtioMMIO) {
+		return false
+	}
+	if !a.ISA.Equal(b.ISA) {
+		return false
+	}
+	if !a.DIMM.Equal(b.DIMM) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddress) canonicalize() {
+	if in.PCI != nil {
+		in.PCI.canonicalize()
+	}
+	if in.Drive != nil {
+		in.Drive.canonicalize()
+	}
+	if in.VirtioSerial != nil {
+		in.VirtioSerial.canonicalize()
+	}
+	if in.CCID != nil {
+		in.CCID.canonicalize()
+	}
+	if in.USB != nil {
+		in.USB.canonicalize()
+	}
+	if in.SpaprVIO != nil {
+		in.SpaprVIO.canonicalize()
+	}
+	if in.VirtioS390 != nil {
+		in.VirtioS390.canonicalize()
+	}
+	if in.CCW != nil {
+		in.CCW.canonicalize()
+	}
+	if in.VirtioMMIO != nil {
+		in.VirtioMMIO.canonicalize()
+	}
+	if in.ISA != nil {
+		in.ISA.canonicalize()
+	}
+	if in.DIMM != nil {
+		in.DIMM.canonicalize()
+	}
+}
+
+func (in *DomainAddressCCID) DeepCopy() *DomainAddressCCID {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressCCID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressCCID) DeepCopyInto(out *DomainAddressCCID) {
+	*out = *in
+	if in.Controller != nil {
+		v := *in.Controller
+		out.Controller = &v
+	}
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+}
+
+func (a *DomainAddressCCID) Equal(b *DomainAddressCCID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Controller == nil) != (b.Controller == nil) || (a.Controller != nil && *a.Controller != *b.Controller) {
+		return false
+	}
+	if (a.Slot == nil) != (b.Slot == nil) || (a.Slot != nil && *a.Slot != *b.Slot) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressCCID) canonicalize() {
+}
+
+func (in *DomainAddressCCW) DeepCopy() *DomainAddressCCW {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressCCW)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressCCW) DeepCopyInto(out *DomainAddressCCW) {
+	*out = *in
+	if in.CSSID != nil {
+		v := *in.CSSID
+		out.CSSID = &v
+	}
+	if in.SSID != nil {
+		v := *in.SSID
+		out.SSID = &v
+	}
+	if in.DevNo != nil {
+		v := *in.DevNo
+		out.DevNo = &v
+	}
+}
+
+func (a *DomainAddressCCW) Equal(b *DomainAddressCCW) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.CSSID == nil) != (b.CSSID == nil) || (a.CSSID != nil && *a.CSSID != *b.CSSID) {
+		return false
+	}
+	if (a.SSID == nil) != (b.SSID == nil) || (a.SSID != nil && *a.SSID != *b.SSID) {
+		return false
+	}
+	if (a.DevNo == nil) != (b.DevNo == nil) || (a.DevNo != nil && *a.DevNo != *b.DevNo) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressCCW) canonicalize() {
+}
+
+func (in *DomainAddressDIMM) DeepCopy() *DomainAddressDIMM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressDIMM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressDIMM) DeepCopyInto(out *DomainAddressDIMM) {
+	*out = *in
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+	if in.Base != nil {
+		v := *in.Base
+		out.Base = &v
+	}
+}
+
+func (a *DomainAddressDIMM) Equal(b *DomainAddressDIMM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Slot == nil) != (b.Slot == nil) || (a.Slot != nil && *a.Slot != *b.Slot) {
+		return false
+	}
+	if (a.Base == nil) != (b.Base == nil) || (a.Base != nil && *a.Base != *b.Base) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressDIMM) canonicalize() {
+}
+
+func (in *DomainAddressDrive) DeepCopy() *DomainAddressDrive {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressDrive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressDrive) DeepCopyInto(out *DomainAddressDrive) {
+	*out = *in
+	if in.Controller != nil {
+		v := *in.Controller
+		out.Controller = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Target != nil {
+		v := *in.Target
+		out.Target = &v
+	}
+	if in.Unit != nil {
+		v := *in.Unit
+		out.Unit = &v
+	}
+}
+
+func (a *DomainAddressDrive) Equal(b *DomainAddressDrive) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalUintPtrDefault(a.Controller, b.Controller, 0) {
+		return false
+	}
+	if !equalUintPtrDefault(a.Bus, b.Bus, 0) {
+		return false
+	}
+	if !equalUintPtrDefault(a.Target, b.Target, 0) {
+		return false
+	}
+	if !equalUintPtrDefault(a.Unit, b.Unit, 0) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressDrive) canonicalize() {
+	if in.Controller == nil {
+		v := uint(0)
+		in.Controller = &v
+	}
+	if in.Bus == nil {
+		v := uint(0)
+		in.Bus = &v
+	}
+	if in.Target == nil {
+		v := uint(0)
+		in.Target = &v
+	}
+	if in.Unit == nil {
+		v := uint(0)
+		in.Unit = &v
+	}
+}
+
+func (in *DomainAddressISA) DeepCopy() *DomainAddressISA {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressISA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressISA) DeepCopyInto(out *DomainAddressISA) {
+	*out = *in
+	if in.IOBase != nil {
+		v := *in.IOBase
+		out.IOBase = &v
+	}
+	if in.IRQ != nil {
+		v := *in.IRQ
+		out.IRQ = &v
+	}
+}
+
+func (a *DomainAddressISA) Equal(b *DomainAddressISA) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.IOBase == nil) != (b.IOBase == nil) || (a.IOBase != nil && *a.IOBase != *b.IOBase) {
+		return false
+	}
+	if (a.IRQ == nil) != (b.IRQ == nil) || (a.IRQ != nil && *a.IRQ != *b.IRQ) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressISA) canonicalize() {
+}
+
+func (in *DomainAddressMDev) DeepCopy() *DomainAddressMDev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressMDev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressMDev) DeepCopyInto(out *DomainAddressMDev) {
+	*out = *in
+}
+
+func (a *DomainAddressMDev) Equal(b *DomainAddressMDev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressMDev) canonicalize() {
+}
+
+func (in *DomainAddressPCI) DeepCopy() *DomainAddressPCI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressPCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressPCI) DeepCopyInto(out *DomainAddressPCI) {
+	*out = *in
+	if in.Domain != nil {
+		v := *in.Domain
+		out.Domain = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+	if in.Function != nil {
+		v := *in.Function
+		out.Function = &v
+	}
+}
+
+func (a *DomainAddressPCI) Equal(b *DomainAddressPCI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalUintPtrDefault(a.Domain, b.Domain, 0) {
+		return false
+	}
+	if !equalUintPtrDefault(a.Bus, b.Bus, 0) {
+		return false
+	}
+	if !equalUintPtrDefault(a.Slot, b.Slot, 0) {
+		return false
+	}
+	if !equalUintPtrDefault(a.Function, b.Function, 0) {
+		return false
+	}
+	if a.MultiFunction != b.MultiFunction {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressPCI) canonicalize() {
+	if in.Domain == nil {
+		v := uint(0)
+		in.Domain = &v
+	}
+	if in.Bus == nil {
+		v := uint(0)
+		in.Bus = &v
+	}
+	if in.Slot == nil {
+		v := uint(0)
+		in.Slot = &v
+	}
+	if in.Function == nil {
+		v := uint(0)
+		in.Function = &v
+	}
+}
+
+func (in *DomainAddressSpaprVIO) DeepCopy() *DomainAddressSpaprVIO {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressSpaprVIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressSpaprVIO) DeepCopyInto(out *DomainAddressSpaprVIO) {
+	*out = *in
+	if in.Reg != nil {
+		v := *in.Reg
+		out.Reg = &v
+	}
+}
+
+func (a *DomainAddressSpaprVIO) Equal(b *DomainAddressSpaprVIO) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Reg == nil) != (b.Reg == nil) || (a.Reg != nil && *a.Reg != *b.Reg) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressSpaprVIO) canonicalize() {
+}
+
+func (in *DomainAddressUSB) DeepCopy() *DomainAddressUSB {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressUSB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressUSB) DeepCopyInto(out *DomainAddressUSB) {
+	*out = *in
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Device != nil {
+		v := *in.Device
+		out.Device = &v
+	}
+}
+
+func (a *DomainAddressUSB) Equal(b *DomainAddressUSB) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Bus == nil) != (b.Bus == nil) || (a.Bus != nil && *a.Bus != *b.Bus) {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if (a.Device == nil) != (b.Device == nil) || (a.Device != nil && *a.Device != *b.Device) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressUSB) canonicalize() {
+}
+
+func (in *DomainAddressVirtioMMIO) DeepCopy() *DomainAddressVirtioMMIO {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressVirtioMMIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressVirtioMMIO) DeepCopyInto(out *DomainAddressVirtioMMIO) {
+	*out = *in
+}
+
+func (a *DomainAddressVirtioMMIO) Equal(b *DomainAddressVirtioMMIO) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainAddressVirtioMMIO) canonicalize() {
+}
+
+func (in *DomainAddressVirtioS390) DeepCopy() *DomainAddressVirtioS390 {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressVirtioS390)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressVirtioS390) DeepCopyInto(out *DomainAddressVirtioS390) {
+	*out = *in
+}
+
+func (a *DomainAddressVirtioS390) Equal(b *DomainAddressVirtioS390) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainAddressVirtioS390) canonicalize() {
+}
+
+func (in *DomainAddressVirtioSerial) DeepCopy() *DomainAddressVirtioSerial {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAddressVirtioSerial)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAddressVirtioSerial) DeepCopyInto(out *DomainAddressVirtioSerial) {
+	*out = *in
+	if in.Controller != nil {
+		v := *in.Controller
+		out.Controller = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+}
+
+func (a *DomainAddressVirtioSerial) Equal(b *DomainAddressVirtioSerial) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Controller == nil) != (b.Controller == nil) || (a.Controller != nil && *a.Controller != *b.Controller) {
+		return false
+	}
+	if (a.Bus == nil) != (b.Bus == nil) || (a.Bus != nil && *a.Bus != *b.Bus) {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAddressVirtioSerial) canonicalize() {
+}
+
+func (in *DomainAlias) DeepCopy() *DomainAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainAlias) DeepCopyInto(out *DomainAlias) {
+	*out = *in
+}
+
+func (a *DomainAlias) Equal(b *DomainAlias) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainAlias) canonicalize() {
+}
+
+func (in *DomainBIOS) DeepCopy() *DomainBIOS {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBIOS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainBIOS) DeepCopyInto(out *DomainBIOS) {
+	*out = *in
+	if in.RebootTimeout != nil {
+		v := *in.RebootTimeout
+		out.RebootTimeout = &v
+	}
+}
+
+func (a *DomainBIOS) Equal(b *DomainBIOS) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.UseSerial != b.UseSerial {
+		return false
+	}
+	if (a.RebootTimeout == nil) != (b.RebootTimeout == nil) || (a.RebootTimeout != nil && *a.RebootTimeout != *b.RebootTimeout) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainBIOS) canonicalize() {
+}
+
+func (in *DomainBlockIOTune) DeepCopy() *DomainBlockIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBlockIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainBlockIOTune) DeepCopyInto(out *DomainBlockIOTune) {
+	*out = *in
+	if in.Device != nil {
+		out.Device = make([]DomainBlockIOTuneDevice, len(in.Device))
+		for i := range in.Device {
+			in.Device[i].DeepCopyInto(&out.Device[i])
+		}
+	}
+}
+
+func (a *DomainBlockIOTune) Equal(b *DomainBlockIOTune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Weight != b.Weight {
+		return false
+	}
+	if len(a.Device) != len(b.Device) {
+		return false
+	}
+	for i := range a.Device {
+		if !a.Device[i].Equal(&b.Device[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainBlockIOTune) canonicalize() {
+	for i := range in.Device {
+		in.Device[i].canonicalize()
+	}
+	if len(in.Device) == 0 {
+		in.Device = nil
+	}
+}
+
+func (in *DomainBlockIOTuneDevice) DeepCopy() *DomainBlockIOTuneDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBlockIOTuneDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainBlockIOTuneDevice) DeepCopyInto(out *DomainBlockIOTuneDevice) {
+	*out = *in
+}
+
+func (a *DomainBlockIOTuneDevice) Equal(b *DomainBlockIOTuneDevice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if a.Weight != b.Weight {
+		return false
+	}
+	if a.ReadIopsSec != b.ReadIopsSec {
+		return false
+	}
+	if a.WriteIopsSec != b.WriteIopsSec {
+		return false
+	}
+	if a.ReadBytesSec != b.ReadBytesSec {
+		return false
+	}
+	if a.WriteBytesSec != b.WriteBytesSec {
+		return false
+	}
+	return true
+}
+
+func (in *DomainBlockIOTuneDevice) canonicalize() {
+}
+
+func (in *DomainBootDevice) DeepCopy() *DomainBootDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBootDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainBootDevice) DeepCopyInto(out *DomainBootDevice) {
+	*out = *in
+}
+
+func (a *DomainBootDevice) Equal(b *DomainBootDevice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	return true
+}
+
+func (in *DomainBootDevice) canonicalize() {
+}
+
+func (in *DomainBootMenu) DeepCopy() *DomainBootMenu {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBootMenu)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainBootMenu) DeepCopyInto(out *DomainBootMenu) {
+	*out = *in
+}
+
+func (a *DomainBootMenu) Equal(b *DomainBootMenu) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enable != b.Enable {
+		return false
+	}
+	if a.Timeout != b.Timeout {
+		return false
+	}
+	return true
+}
+
+func (in *DomainBootMenu) canonicalize() {
+}
+
+func (in *DomainCPU) DeepCopy() *DomainCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPU) DeepCopyInto(out *DomainCPU) {
+	*out = *in
+	if in.Model != nil {
+		out.Model = in.Model.DeepCopy()
+	}
+	if in.Topology != nil {
+		out.Topology = in.Topology.DeepCopy()
+	}
+	if in.Cache != nil {
+		out.Cache = in.Cache.DeepCopy()
+	}
+	if in.Features != nil {
+		out.Features = make([]DomainCPUFeature, len(in.Features))
+		for i := range in.Features {
+			in.Features[i].DeepCopyInto(&out.Features[i])
+		}
+	}
+	if in.Numa != nil {
+		out.Numa = in.Numa.DeepCopy()
+	}
+}
+
+func (a *DomainCPU) Equal(b *DomainCPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Check != b.Check {
+		return false
+	}
+	if !a.Model.Equal(b.Model) {
+		return false
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if !a.Topology.Equal(b.Topology) {
+		return false
+	}
+	if !a.Cache.Equal(b.Cache) {
+		return false
+	}
+	if len(a.Features) != len(b.Features) {
+		return false
+	}
+	for i := range a.Features {
+		if !a.Features[i].Equal(&b.Features[i]) {
+			return false
+		}
+	}
+	if !a.Numa.Equal(b.Numa) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPU) canonicalize() {
+	if in.Model != nil {
+		in.Model.canonicalize()
+	}
+	if in.Topology != nil {
+		in.Topology.canonicalize()
+	}
+	if in.Cache != nil {
+		in.Cache.canonicalize()
+	}
+	for i := range in.Features {
+		in.Features[i].canonicalize()
+	}
+	if len(in.Features) == 0 {
+		in.Features = nil
+	}
+	if in.Numa != nil {
+		in.Numa.canonicalize()
+		if in.Numa.empty() {
+			in.Numa = nil
+		}
+	}
+}
+
+func (d *DomainCPU) Canonicalize() *DomainCPU {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainCPU) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainCPU) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainCPU) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainCPU) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainCPU) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainCPU) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainCPU) schemaRef() (string, string) {
+	return "domain.rng", "cpu"
+}
+
+func (in *DomainCPUCache) DeepCopy() *DomainCPUCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUCache) DeepCopyInto(out *DomainCPUCache) {
+	*out = *in
+}
+
+func (a *DomainCPUCache) Equal(b *DomainCPUCache) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Level != b.Level {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUCache) canonicalize() {
+}
+
+func (in *DomainCPUCacheTune) DeepCopy() *DomainCPUCacheTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUCacheTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUCacheTune) DeepCopyInto(out *DomainCPUCacheTune) {
+	*out = *in
+	if in.Cache != nil {
+		out.Cache = make([]DomainCPUCacheTuneCache, len(in.Cache))
+		for i := range in.Cache {
+			in.Cache[i].DeepCopyInto(&out.Cache[i])
+		}
+	}
+}
+
+func (a *DomainCPUCacheTune) Equal(b *DomainCPUCacheTune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.VCPUs != b.VCPUs {
+		return false
+	}
+	if len(a.Cache) != len(b.Cache) {
+		return false
+	}
+	for i := range a.Cache {
+		if !a.Cache[i].Equal(&b.Cache[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCPUCacheTune) canonicalize() {
+	for i := range in.Cache {
+		in.Cache[i].canonicalize()
+	}
+	if len(in.Cache) == 0 {
+		in.Cache = nil
+	}
+}
+
+func (in *DomainCPUCacheTuneCache) DeepCopy() *DomainCPUCacheTuneCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUCacheTuneCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUCacheTuneCache) DeepCopyInto(out *DomainCPUCacheTuneCache) {
+	*out = *in
+}
+
+func (a *DomainCPUCacheTuneCache) Equal(b *DomainCPUCacheTuneCache) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.Level != b.Level {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUCacheTuneCache) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Size = uint(canonicalScaled(uint64(in.Size), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainCPUFeature) DeepCopy() *DomainCPUFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUFeature) DeepCopyInto(out *DomainCPUFeature) {
+	*out = *in
+}
+
+func (a *DomainCPUFeature) Equal(b *DomainCPUFeature) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Policy != b.Policy {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUFeature) canonicalize() {
+}
+
+func (in *DomainCPUModel) DeepCopy() *DomainCPUModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUModel) DeepCopyInto(out *DomainCPUModel) {
+	*out = *in
+}
+
+func (a *DomainCPUModel) Equal(b *DomainCPUModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Fallback != b.Fallback {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	if a.VendorID != b.VendorID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUModel) canonicalize() {
+}
+
+func (in *DomainCPUTopology) DeepCopy() *DomainCPUTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTopology) DeepCopyInto(out *DomainCPUTopology) {
+	*out = *in
+}
+
+func (a *DomainCPUTopology) Equal(b *DomainCPUTopology) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Sockets != b.Sockets {
+		return false
+	}
+	if a.Cores != b.Cores {
+		return false
+	}
+	if a.Threads != b.Threads {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTopology) canonicalize() {
+}
+
+func (in *DomainCPUTune) DeepCopy() *DomainCPUTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTune) DeepCopyInto(out *DomainCPUTune) {
+	*out = *in
+	if in.Shares != nil {
+		out.Shares = in.Shares.DeepCopy()
+	}
+	if in.Period != nil {
+		out.Period = in.Period.DeepCopy()
+	}
+	if in.Quota != nil {
+		out.Quota = in.Quota.DeepCopy()
+	}
+	if in.GlobalPeriod != nil {
+		out.GlobalPeriod = in.GlobalPeriod.DeepCopy()
+	}
+	if in.GlobalQuota != nil {
+		out.GlobalQuota = in.GlobalQuota.DeepCopy()
+	}
+	if in.EmulatorPeriod != nil {
+		out.EmulatorPeriod = in.EmulatorPeriod.DeepCopy()
+	}
+	if in.EmulatorQuota != nil {
+		out.EmulatorQuota = in.EmulatorQuota.DeepCopy()
+	}
+	if in.IOThreadPeriod != nil {
+		out.IOThreadPeriod = in.IOThreadPeriod.DeepCopy()
+	}
+	if in.IOThreadQuota != nil {
+		out.IOThreadQuota = in.IOThreadQuota.DeepCopy()
+	}
+	if in.VCPUPin != nil {
+		out.VCPUPin = make([]DomainCPUTuneVCPUPin, len(in.VCPUPin))
+		for i := range in.VCPUPin {
+			in.VCPUPin[i].DeepCopyInto(&out.VCPUPin[i])
+		}
+	}
+	if in.EmulatorPin != nil {
+		out.EmulatorPin = in.EmulatorPin.DeepCopy()
+	}
+	if in.IOThreadPin != nil {
+		out.IOThreadPin = make([]DomainCPUTuneIOThreadPin, len(in.IOThreadPin))
+		for i := range in.IOThreadPin {
+			in.IOThreadPin[i].DeepCopyInto(&out.IOThreadPin[i])
+		}
+	}
+	if in.VCPUSched != nil {
+		out.VCPUSched = make([]DomainCPUTuneVCPUSched, len(in.VCPUSched))
+		for i := range in.VCPUSched {
+			in.VCPUSched[i].DeepCopyInto(&out.VCPUSched[i])
+		}
+	}
+	if in.IOThreadSched != nil {
+		out.IOThreadSched = make([]DomainCPUTuneIOThreadSched, len(in.IOThreadSched))
+		for i := range in.IOThreadSched {
+			in.IOThreadSched[i].DeepCopyInto(&out.IOThreadSched[i])
+		}
+	}
+	if in.CacheTune != nil {
+		out.CacheTune = make([]DomainCPUCacheTune, len(in.CacheTune))
+		for i := range in.CacheTune {
+			in.CacheTune[i].DeepCopyInto(&out.CacheTune[i])
+		}
+	}
+}
+
+func (a *DomainCPUTune) Equal(b *DomainCPUTune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Shares.Equal(b.Shares) {
+		return false
+	}
+	if !a.Period.Equal(b.Period) {
+		return false
+	}
+	if !a.Quota.Equal(b.Quota) {
+		return false
+	}
+	if !a.GlobalPeriod.Equal(b.GlobalPeriod) {
+		return false
+	}
+	if !a.GlobalQuota.Equal(b.GlobalQuota) {
+		return false
+	}
+	if !a.EmulatorPeriod.Equal(b.EmulatorPeriod) {
+		return false
+	}
+	if !a.EmulatorQuota.Equal(b.EmulatorQuota) {
+		return false
+	}
+	if !a.IOThreadPeriod.Equal(b.IOThreadPeriod) {
+		return false
+	}
+	if !a.IOThreadQuota.Equal(b.IOThreadQuota) {
+		return false
+	}
+	if len(a.VCPUPin) != len(b.VCPUPin) {
+		return false
+	}
+	for i := range a.VCPUPin {
+		if !a.VCPUPin[i].Equal(&b.VCPUPin[i]) {
+			return false
+		}
+	}
+	if !a.EmulatorPin.Equal(b.EmulatorPin) {
+		return false
+	}
+	if len(a.IOThreadPin) != len(b.IOThreadPin) {
+		return false
+	}
+	for i := range a.IOThreadPin {
+		if !a.IOThreadPin[i].Equal(&b.IOThreadPin[i]) {
+			return false
+		}
+	}
+	if len(a.VCPUSched) != len(b.VCPUSched) {
+		return false
+	}
+	for i := range a.VCPUSched {
+		if !a.VCPUSched[i].Equal(&b.VCPUSched[i]) {
+			return false
+		}
+	}
+	if len(a.IOThreadSched) != len(b.IOThreadSched) {
+		return false
+	}
+	for i := range a.IOThreadSched {
+		if !a.IOThreadSched[i].Equal(&b.IOThreadSched[i]) {
+			return false
+		}
+	}
+	if len(a.CacheTune) != len(b.CacheTune) {
+		return false
+	}
+	for i := range a.CacheTune {
+		if !a.CacheTune[i].Equal(&b.CacheTune[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCPUTune) canonicalize() {
+	if in.Shares != nil {
+		in.Shares.canonicalize()
+	}
+	if in.Period != nil {
+		in.Period.canonicalize()
+	}
+	if in.Quota != nil {
+		in.Quota.canonicalize()
+	}
+	if in.GlobalPeriod != nil {
+		in.GlobalPeriod.canonicalize()
+	}
+	if in.GlobalQuota != nil {
+		in.GlobalQuota.canonicalize()
+	}
+	if in.EmulatorPeriod != nil {
+		in.EmulatorPeriod.canonicalize()
+	}
+	if in.EmulatorQuota != nil {
+		in.EmulatorQuota.canonicalize()
+	}
+	if in.IOThreadPeriod != nil {
+		in.IOThreadPeriod.canonicalize()
+	}
+	if in.IOThreadQuota != nil {
+		in.IOThreadQuota.canonicalize()
+	}
+	for i := range in.VCPUPin {
+		in.VCPUPin[i].canonicalize()
+	}
+	if len(in.VCPUPin) == 0 {
+		in.VCPUPin = nil
+	}
+	if in.EmulatorPin != nil {
+		in.EmulatorPin.canonicalize()
+	}
+	for i := range in.IOThreadPin {
+		in.IOThreadPin[i].canonicalize()
+	}
+	if len(in.IOThreadPin) == 0 {
+		in.IOThreadPin = nil
+	}
+	for i := range in.VCPUSched {
+		in.VCPUSched[i].canonicalize()
+	}
+	if len(in.VCPUSched) == 0 {
+		in.VCPUSched = nil
+	}
+	for i := range in.IOThreadSched {
+		in.IOThreadSched[i].canonicalize()
+	}
+	if len(in.IOThreadSched) == 0 {
+		in.IOThreadSched = nil
+	}
+	for i := range in.CacheTune {
+		in.CacheTune[i].canonicalize()
+	}
+	if len(in.CacheTune) == 0 {
+		in.CacheTune = nil
+	}
+}
+
+func (in *DomainCPUTune) empty() bool {
+	return in.Shares == nil &&
+		in.Period == nil &&
+		in.Quota == nil &&
+		in.GlobalPeriod == nil &&
+		in.GlobalQuota == nil &&
+		in.EmulatorPeriod == nil &&
+		in.EmulatorQuota == nil &&
+		in.IOThreadPeriod == nil &&
+		in.IOThreadQuota == nil &&
+		len(in.VCPUPin) == 0 &&
+		in.EmulatorPin == nil &&
+		len(in.IOThreadPin) == 0 &&
+		len(in.VCPUSched) == 0 &&
+		len(in.IOThreadSched) == 0 &&
+		len(in.CacheTune) == 0
+}
+
+func (in *DomainCPUTuneEmulatorPin) DeepCopy() *DomainCPUTuneEmulatorPin {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneEmulatorPin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneEmulatorPin) DeepCopyInto(out *DomainCPUTuneEmulatorPin) {
+	*out = *in
+}
+
+func (a *DomainCPUTuneEmulatorPin) Equal(b *DomainCPUTuneEmulatorPin) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.CPUSet != b.CPUSet {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneEmulatorPin) canonicalize() {
+}
+
+func (in *DomainCPUTuneIOThreadPin) DeepCopy() *DomainCPUTuneIOThreadPin {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneIOThreadPin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneIOThreadPin) DeepCopyInto(out *DomainCPUTuneIOThreadPin) {
+	*out = *in
+}
+
+func (a *DomainCPUTuneIOThreadPin) Equal(b *DomainCPUTuneIOThreadPin) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IOThread != b.IOThread {
+		return false
+	}
+	if a.CPUSet != b.CPUSet {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneIOThreadPin) canonicalize() {
+}
+
+func (in *DomainCPUTuneIOThreadSched) DeepCopy() *DomainCPUTuneIOThreadSched {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneIOThreadSched)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneIOThreadSched) DeepCopyInto(out *DomainCPUTuneIOThreadSched) {
+	*out = *in
+	if in.Priority != nil {
+		v := *in.Priority
+		out.Priority = &v
+	}
+}
+
+func (a *DomainCPUTuneIOThreadSched) Equal(b *DomainCPUTuneIOThreadSched) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IOThreads != b.IOThreads {
+		return false
+	}
+	if a.Scheduler != b.Scheduler {
+		return false
+	}
+	if (a.Priority == nil) != (b.Priority == nil) || (a.Priority != nil && *a.Priority != *b.Priority) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneIOThreadSched) canonicalize() {
+}
+
+func (in *DomainCPUTunePeriod) DeepCopy() *DomainCPUTunePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTunePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTunePeriod) DeepCopyInto(out *DomainCPUTunePeriod) {
+	*out = *in
+}
+
+func (a *DomainCPUTunePeriod) Equal(b *DomainCPUTunePeriod) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTunePeriod) canonicalize() {
+}
+
+func (in *DomainCPUTuneQuota) DeepCopy() *DomainCPUTuneQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneQuota) DeepCopyInto(out *DomainCPUTuneQuota) {
+	*out = *in
+}
+
+func (a *DomainCPUTuneQuota) Equal(b *DomainCPUTuneQuota) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneQuota) canonicalize() {
+}
+
+func (in *DomainCPUTuneShares) DeepCopy() *DomainCPUTuneShares {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneShares)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneShares) DeepCopyInto(out *DomainCPUTuneShares) {
+	*out = *in
+}
+
+func (a *DomainCPUTuneShares) Equal(b *DomainCPUTuneShares) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneShares) canonicalize() {
+}
+
+func (in *DomainCPUTuneVCPUPin) DeepCopy() *DomainCPUTuneVCPUPin {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneVCPUPin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneVCPUPin) DeepCopyInto(out *DomainCPUTuneVCPUPin) {
+	*out = *in
+}
+
+func (a *DomainCPUTuneVCPUPin) Equal(b *DomainCPUTuneVCPUPin) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.VCPU != b.VCPU {
+		return false
+	}
+	if a.CPUSet != b.CPUSet {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneVCPUPin) canonicalize() {
+}
+
+func (in *DomainCPUTuneVCPUSched) DeepCopy() *DomainCPUTuneVCPUSched {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCPUTuneVCPUSched)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCPUTuneVCPUSched) DeepCopyInto(out *DomainCPUTuneVCPUSched) {
+	*out = *in
+	if in.Priority != nil {
+		v := *in.Priority
+		out.Priority = &v
+	}
+}
+
+func (a *DomainCPUTuneVCPUSched) Equal(b *DomainCPUTuneVCPUSched) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.VCPUs != b.VCPUs {
+		return false
+	}
+	if a.Scheduler != b.Scheduler {
+		return false
+	}
+	if (a.Priority == nil) != (b.Priority == nil) || (a.Priority != nil && *a.Priority != *b.Priority) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCPUTuneVCPUSched) canonicalize() {
+}
+
+func (in *DomainCaps) DeepCopy() *DomainCaps {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCaps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCaps) DeepCopyInto(out *DomainCaps) {
+	*out = *in
+	if in.VCPU != nil {
+		out.VCPU = in.VCPU.DeepCopy()
+	}
+	if in.IOThreads != nil {
+		out.IOThreads = in.IOThreads.DeepCopy()
+	}
+	in.OS.DeepCopyInto(&out.OS)
+	if in.CPU != nil {
+		out.CPU = in.CPU.DeepCopy()
+	}
+	if in.Devices != nil {
+		out.Devices = in.Devices.DeepCopy()
+	}
+	if in.Features != nil {
+		out.Features = in.Features.DeepCopy()
+	}
+}
+
+func (a *DomainCaps) Equal(b *DomainCaps) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if a.Domain != b.Domain {
+		return false
+	}
+	if a.Machine != b.Machine {
+		return false
+	}
+	if a.Arch != b.Arch {
+		return false
+	}
+	if !a.VCPU.Equal(b.VCPU) {
+		return false
+	}
+	if !a.IOThreads.Equal(b.IOThreads) {
+		return false
+	}
+	if !a.OS.Equal(&b.OS) {
+		return false
+	}
+	if !a.CPU.Equal(b.CPU) {
+		return false
+	}
+	if !a.Devices.Equal(b.Devices) {
+		return false
+	}
+	if !a.Features.Equal(b.Features) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCaps) canonicalize() {
+	if in.VCPU != nil {
+		in.VCPU.canonicalize()
+	}
+	if in.IOThreads != nil {
+		in.IOThreads.canonicalize()
+	}
+	in.OS.canonicalize()
+	if in.CPU != nil {
+		in.CPU.canonicalize()
+		if in.CPU.empty() {
+			in.CPU = nil
+		}
+	}
+	if in.Devices != nil {
+		in.Devices.canonicalize()
+		if in.Devices.empty() {
+			in.Devices = nil
+		}
+	}
+	if in.Features != nil {
+		in.Features.canonicalize()
+		if in.Features.empty() {
+			in.Features = nil
+		}
+	}
+}
+
+func (d *DomainCaps) Canonicalize() *DomainCaps {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainCaps) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainCaps) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainCaps) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainCaps) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainCaps) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainCaps) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainCaps) schemaRef() (string, string) {
+	return "domaincaps.rng", ""
+}
+
+func (in *DomainCapsCPU) DeepCopy() *DomainCapsCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsCPU) DeepCopyInto(out *DomainCapsCPU) {
+	*out = *in
+	if in.Modes != nil {
+		out.Modes = make([]DomainCapsCPUMode, len(in.Modes))
+		for i := range in.Modes {
+			in.Modes[i].DeepCopyInto(&out.Modes[i])
+		}
+	}
+}
+
+func (a *DomainCapsCPU) Equal(b *DomainCapsCPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Modes) != len(b.Modes) {
+		return false
+	}
+	for i := range a.Modes {
+		if !a.Modes[i].Equal(&b.Modes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCapsCPU) canonicalize() {
+	for i := range in.Modes {
+		in.Modes[i].canonicalize()
+	}
+	if len(in.Modes) == 0 {
+		in.Modes = nil
+	}
+}
+
+func (in *DomainCapsCPU) empty() bool {
+	return len(in.Modes) == 0
+}
+
+func (in *DomainCapsCPUFeature) DeepCopy() *DomainCapsCPUFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsCPUFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsCPUFeature) DeepCopyInto(out *DomainCapsCPUFeature) {
+	*out = *in
+}
+
+func (a *DomainCapsCPUFeature) Equal(b *DomainCapsCPUFeature) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Policy != b.Policy {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsCPUFeature) canonicalize() {
+}
+
+func (in *DomainCapsCPUMode) DeepCopy() *DomainCapsCPUMode {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsCPUMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsCPUMode) DeepCopyInto(out *DomainCapsCPUMode) {
+	*out = *in
+	if in.Models != nil {
+		out.Models = make([]DomainCapsCPUModel, len(in.Models))
+		for i := range in.Models {
+			in.Models[i].DeepCopyInto(&out.Models[i])
+		}
+	}
+	if in.Features != nil {
+		out.Features = make([]DomainCapsCPUFeature, len(in.Features))
+		for i := range in.Features {
+			in.Features[i].DeepCopyInto(&out.Features[i])
+		}
+	}
+}
+
+func (a *DomainCapsCPUMode) Equal(b *DomainCapsCPUMode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	if len(a.Models) != len(b.Models) {
+		return false
+	}
+	for i := range a.Models {
+		if !a.Models[i].Equal(&b.Models[i]) {
+			return false
+		}
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if len(a.Features) != len(b.Features) {
+		return false
+	}
+	for i := range a.Features {
+		if !a.Features[i].Equal(&b.Features[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCapsCPUMode) canonicalize() {
+	for i := range in.Models {
+		in.Models[i].canonicalize()
+	}
+	if len(in.Models) == 0 {
+		in.Models = nil
+	}
+	for i := range in.Features {
+		in.Features[i].canonicalize()
+	}
+	if len(in.Features) == 0 {
+		in.Features = nil
+	}
+}
+
+func (in *DomainCapsCPUModel) DeepCopy() *DomainCapsCPUModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsCPUModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsCPUModel) DeepCopyInto(out *DomainCapsCPUModel) {
+	*out = *in
+}
+
+func (a *DomainCapsCPUModel) Equal(b *DomainCapsCPUModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Usable != b.Usable {
+		return false
+	}
+	if a.Fallback != b.Fallback {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsCPUModel) canonicalize() {
+}
+
+func (in *DomainCapsDevice) DeepCopy() *DomainCapsDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsDevice) DeepCopyInto(out *DomainCapsDevice) {
+	*out = *in
+	if in.Enums != nil {
+		out.Enums = make([]DomainCapsEnum, len(in.Enums))
+		for i := range in.Enums {
+			in.Enums[i].DeepCopyInto(&out.Enums[i])
+		}
+	}
+}
+
+func (a *DomainCapsDevice) Equal(b *DomainCapsDevice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	if len(a.Enums) != len(b.Enums) {
+		return false
+	}
+	for i := range a.Enums {
+		if !a.Enums[i].Equal(&b.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCapsDevice) canonicalize() {
+	for i := range in.Enums {
+		in.Enums[i].canonicalize()
+	}
+	if len(in.Enums) == 0 {
+		in.Enums = nil
+	}
+}
+
+func (in *DomainCapsDevices) DeepCopy() *DomainCapsDevices {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsDevices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsDevices) DeepCopyInto(out *DomainCapsDevices) {
+	*out = *in
+	if in.Disk != nil {
+		out.Disk = in.Disk.DeepCopy()
+	}
+	if in.Graphics != nil {
+		out.Graphics = in.Graphics.DeepCopy()
+	}
+	if in.Video != nil {
+		out.Video = in.Video.DeepCopy()
+	}
+	if in.HostDev != nil {
+		out.HostDev = in.HostDev.DeepCopy()
+	}
+}
+
+func (a *DomainCapsDevices) Equal(b *DomainCapsDevices) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Disk.Equal(b.Disk) {
+		return false
+	}
+	if !a.Graphics.Equal(b.Graphics) {
+		return false
+	}
+	if !a.Video.Equal(b.Video) {
+		return false
+	}
+	if !a.HostDev.Equal(b.HostDev) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsDevices) canonicalize() {
+	if in.Disk != nil {
+		in.Disk.canonicalize()
+	}
+	if in.Graphics != nil {
+		in.Graphics.canonicalize()
+	}
+	if in.Video != nil {
+		in.Video.canonicalize()
+	}
+	if in.HostDev != nil {
+		in.HostDev.canonicalize()
+	}
+}
+
+func (in *DomainCapsDevices) empty() bool {
+	return in.Disk == nil &&
+		in.Graphics == nil &&
+		in.Video == nil &&
+		in.HostDev == nil
+}
+
+func (in *DomainCapsEnum) DeepCopy() *DomainCapsEnum {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsEnum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsEnum) DeepCopyInto(out *DomainCapsEnum) {
+	*out = *in
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		copy(out.Values, in.Values)
+	}
+}
+
+func (a *DomainCapsEnum) Equal(b *DomainCapsEnum) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if len(a.Values) != len(b.Values) {
+		return false
+	}
+	for i := range a.Values {
+		if a.Values[i] != b.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCapsEnum) canonicalize() {
+	if len(in.Values) == 0 {
+		in.Values = nil
+	}
+}
+
+func (in *DomainCapsFeatureGIC) DeepCopy() *DomainCapsFeatureGIC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsFeatureGIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsFeatureGIC) DeepCopyInto(out *DomainCapsFeatureGIC) {
+	*out = *in
+	if in.Enums != nil {
+		out.Enums = make([]DomainCapsEnum, len(in.Enums))
+		for i := range in.Enums {
+			in.Enums[i].DeepCopyInto(&out.Enums[i])
+		}
+	}
+}
+
+func (a *DomainCapsFeatureGIC) Equal(b *DomainCapsFeatureGIC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	if len(a.Enums) != len(b.Enums) {
+		return false
+	}
+	for i := range a.Enums {
+		if !a.Enums[i].Equal(&b.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCapsFeatureGIC) canonicalize() {
+	for i := range in.Enums {
+		in.Enums[i].canonicalize()
+	}
+	if len(in.Enums) == 0 {
+		in.Enums = nil
+	}
+}
+
+func (in *DomainCapsFeatureGenID) DeepCopy() *DomainCapsFeatureGenID {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsFeatureGenID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsFeatureGenID) DeepCopyInto(out *DomainCapsFeatureGenID) {
+	*out = *in
+}
+
+func (a *DomainCapsFeatureGenID) Equal(b *DomainCapsFeatureGenID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsFeatureGenID) canonicalize() {
+}
+
+func (in *DomainCapsFeatureSEV) DeepCopy() *DomainCapsFeatureSEV {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsFeatureSEV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsFeatureSEV) DeepCopyInto(out *DomainCapsFeatureSEV) {
+	*out = *in
+}
+
+func (a *DomainCapsFeatureSEV) Equal(b *DomainCapsFeatureSEV) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	if a.CBitPos != b.CBitPos {
+		return false
+	}
+	if a.ReducedPhysBits != b.ReducedPhysBits {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsFeatureSEV) canonicalize() {
+}
+
+func (in *DomainCapsFeatureVMCoreInfo) DeepCopy() *DomainCapsFeatureVMCoreInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsFeatureVMCoreInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsFeatureVMCoreInfo) DeepCopyInto(out *DomainCapsFeatureVMCoreInfo) {
+	*out = *in
+}
+
+func (a *DomainCapsFeatureVMCoreInfo) Equal(b *DomainCapsFeatureVMCoreInfo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsFeatureVMCoreInfo) canonicalize() {
+}
+
+func (in *DomainCapsFeatures) DeepCopy() *DomainCapsFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsFeatures) DeepCopyInto(out *DomainCapsFeatures) {
+	*out = *in
+	if in.GIC != nil {
+		out.GIC = in.GIC.DeepCopy()
+	}
+	if in.VMCoreInfo != nil {
+		out.VMCoreInfo = in.VMCoreInfo.DeepCopy()
+	}
+	if in.GenID != nil {
+		out.GenID = in.GenID.DeepCopy()
+	}
+	if in.SEV != nil {
+		out.SEV = in.SEV.DeepCopy()
+	}
+}
+
+func (a *DomainCapsFeatures) Equal(b *DomainCapsFeatures) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.GIC.Equal(b.GIC) {
+		return false
+	}
+	if !a.VMCoreInfo.Equal(b.VMCoreInfo) {
+		return false
+	}
+	if !a.GenID.Equal(b.GenID) {
+		return false
+	}
+	if !a.SEV.Equal(b.SEV) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsFeatures) canonicalize() {
+	if in.GIC != nil {
+		in.GIC.canonicalize()
+	}
+	if in.VMCoreInfo != nil {
+		in.VMCoreInfo.canonicalize()
+	}
+	if in.GenID != nil {
+		in.GenID.canonicalize()
+	}
+	if in.SEV != nil {
+		in.SEV.canonicalize()
+	}
+}
+
+func (in *DomainCapsFeatures) empty() bool {
+	return in.GIC == nil &&
+		in.VMCoreInfo == nil &&
+		in.GenID == nil &&
+		in.SEV == nil
+}
+
+func (in *DomainCapsIOThreads) DeepCopy() *DomainCapsIOThreads {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsIOThreads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsIOThreads) DeepCopyInto(out *DomainCapsIOThreads) {
+	*out = *in
+}
+
+func (a *DomainCapsIOThreads) Equal(b *DomainCapsIOThreads) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsIOThreads) canonicalize() {
+}
+
+func (in *DomainCapsOS) DeepCopy() *DomainCapsOS {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsOS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsOS) DeepCopyInto(out *DomainCapsOS) {
+	*out = *in
+	if in.Loader != nil {
+		out.Loader = in.Loader.DeepCopy()
+	}
+}
+
+func (a *DomainCapsOS) Equal(b *DomainCapsOS) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	if !a.Loader.Equal(b.Loader) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsOS) canonicalize() {
+	if in.Loader != nil {
+		in.Loader.canonicalize()
+	}
+}
+
+func (in *DomainCapsOSLoader) DeepCopy() *DomainCapsOSLoader {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsOSLoader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsOSLoader) DeepCopyInto(out *DomainCapsOSLoader) {
+	*out = *in
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		copy(out.Values, in.Values)
+	}
+	if in.Enums != nil {
+		out.Enums = make([]DomainCapsEnum, len(in.Enums))
+		for i := range in.Enums {
+			in.Enums[i].DeepCopyInto(&out.Enums[i])
+		}
+	}
+}
+
+func (a *DomainCapsOSLoader) Equal(b *DomainCapsOSLoader) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Supported != b.Supported {
+		return false
+	}
+	if len(a.Values) != len(b.Values) {
+		return false
+	}
+	for i := range a.Values {
+		if a.Values[i] != b.Values[i] {
+			return false
+		}
+	}
+	if len(a.Enums) != len(b.Enums) {
+		return false
+	}
+	for i := range a.Enums {
+		if !a.Enums[i].Equal(&b.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCapsOSLoader) canonicalize() {
+	if len(in.Values) == 0 {
+		in.Values = nil
+	}
+	for i := range in.Enums {
+		in.Enums[i].canonicalize()
+	}
+	if len(in.Enums) == 0 {
+		in.Enums = nil
+	}
+}
+
+func (in *DomainCapsVCPU) DeepCopy() *DomainCapsVCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCapsVCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCapsVCPU) DeepCopyInto(out *DomainCapsVCPU) {
+	*out = *in
+}
+
+func (a *DomainCapsVCPU) Equal(b *DomainCapsVCPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Max != b.Max {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCapsVCPU) canonicalize() {
+}
+
+func (in *DomainCell) DeepCopy() *DomainCell {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCell) DeepCopyInto(out *DomainCell) {
+	*out = *in
+	if in.ID != nil {
+		v := *in.ID
+		out.ID = &v
+	}
+	if in.Distances != nil {
+		out.Distances = in.Distances.DeepCopy()
+	}
+}
+
+func (a *DomainCell) Equal(b *DomainCell) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.ID == nil) != (b.ID == nil) || (a.ID != nil && *a.ID != *b.ID) {
+		return false
+	}
+	if a.CPUs != b.CPUs {
+		return false
+	}
+	if !equalScaledString(a.Memory, a.Unit, b.Memory, b.Unit, "KiB") {
+		return false
+	}
+	if a.MemAccess != b.MemAccess {
+		return false
+	}
+	if a.Discard != b.Discard {
+		return false
+	}
+	if !a.Distances.Equal(b.Distances) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCell) canonicalize() {
+	if in.Distances != nil {
+		in.Distances.canonicalize()
+		if in.Distances.empty() {
+			in.Distances = nil
+		}
+	}
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Memory = canonicalScaledString(in.Memory, scale, 1024)
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainCellDistances) DeepCopy() *DomainCellDistances {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCellDistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCellDistances) DeepCopyInto(out *DomainCellDistances) {
+	*out = *in
+	if in.Siblings != nil {
+		out.Siblings = make([]DomainCellSibling, len(in.Siblings))
+		for i := range in.Siblings {
+			in.Siblings[i].DeepCopyInto(&out.Siblings[i])
+		}
+	}
+}
+
+func (a *DomainCellDistances) Equal(b *DomainCellDistances) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Siblings) != len(b.Siblings) {
+		return false
+	}
+	for i := range a.Siblings {
+		if !a.Siblings[i].Equal(&b.Siblings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainCellDistances) canonicalize() {
+	for i := range in.Siblings {
+		in.Siblings[i].canonicalize()
+	}
+	if len(in.Siblings) == 0 {
+		in.Siblings = nil
+	}
+}
+
+func (in *DomainCellDistances) empty() bool {
+	return len(in.Siblings) == 0
+}
+
+func (in *DomainCellSibling) DeepCopy() *DomainCellSibling {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCellSibling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCellSibling) DeepCopyInto(out *DomainCellSibling) {
+	*out = *in
+}
+
+func (a *DomainCellSibling) Equal(b *DomainCellSibling) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCellSibling) canonicalize() {
+}
+
+func (in *DomainChannel) DeepCopy() *DomainChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChannel) DeepCopyInto(out *DomainChannel) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Log != nil {
+		out.Log = in.Log.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainChannel) Equal(b *DomainChannel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Log.Equal(b.Log) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChannel) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Log != nil {
+		in.Log.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainChannel) Canonicalize() *DomainChannel {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainChannel) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainChannel) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainChannel) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainChannel) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainChannel) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainChannel) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainChannel) schemaRef() (string, string) {
+	return "domain.rng", "channel"
+}
+
+func (in *DomainChannelTarget) DeepCopy() *DomainChannelTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChannelTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChannelTarget) DeepCopyInto(out *DomainChannelTarget) {
+	*out = *in
+	if in.VirtIO != nil {
+		out.VirtIO = in.VirtIO.DeepCopy()
+	}
+	if in.Xen != nil {
+		out.Xen = in.Xen.DeepCopy()
+	}
+	if in.GuestFWD != nil {
+		out.GuestFWD = in.GuestFWD.DeepCopy()
+	}
+}
+
+func (a *DomainChannelTarget) Equal(b *DomainChannelTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.VirtIO.Equal(b.VirtIO) {
+		return false
+	}
+	if !a.Xen.Equal(b.Xen) {
+		return false
+	}
+	if !a.GuestFWD.Equal(b.GuestFWD) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChannelTarget) canonicalize() {
+	if in.VirtIO != nil {
+		in.VirtIO.canonicalize()
+	}
+	if in.Xen != nil {
+		in.Xen.canonicalize()
+	}
+	if in.GuestFWD != nil {
+		in.GuestFWD.canonicalize()
+	}
+}
+
+func (in *DomainChannelTargetGuestFWD) DeepCopy() *DomainChannelTargetGuestFWD {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChannelTargetGuestFWD)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChannelTargetGuestFWD) DeepCopyInto(out *DomainChannelTargetGuestFWD) {
+	*out = *in
+}
+
+func (a *DomainChannelTargetGuestFWD) Equal(b *DomainChannelTargetGuestFWD) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChannelTargetGuestFWD) canonicalize() {
+}
+
+func (in *DomainChannelTargetVirtIO) DeepCopy() *DomainChannelTargetVirtIO {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChannelTargetVirtIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChannelTargetVirtIO) DeepCopyInto(out *DomainChannelTargetVirtIO) {
+	*out = *in
+}
+
+func (a *DomainChannelTargetVirtIO) Equal(b *DomainChannelTargetVirtIO) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.State != b.State {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChannelTargetVirtIO) canonicalize() {
+}
+
+func (in *DomainChannelTargetXen) DeepCopy() *DomainChannelTargetXen {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChannelTargetXen)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChannelTargetXen) DeepCopyInto(out *DomainChannelTargetXen) {
+	*out = *in
+}
+
+func (a *DomainChannelTargetXen) Equal(b *DomainChannelTargetXen) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.State != b.State {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChannelTargetXen) canonicalize() {
+}
+
+func (in *DomainChardevLog) DeepCopy() *DomainChardevLog {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevLog) DeepCopyInto(out *DomainChardevLog) {
+	*out = *in
+}
+
+func (a *DomainChardevLog) Equal(b *DomainChardevLog) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.File != b.File {
+		return false
+	}
+	if a.Append != b.Append {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevLog) canonicalize() {
+}
+
+func (in *DomainChardevProtocol) DeepCopy() *DomainChardevProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevProtocol) DeepCopyInto(out *DomainChardevProtocol) {
+	*out = *in
+}
+
+func (a *DomainChardevProtocol) Equal(b *DomainChardevProtocol) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevProtocol) canonicalize() {
+}
+
+func (in *DomainChardevSource) DeepCopy() *DomainChardevSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSource) DeepCopyInto(out *DomainChardevSource) {
+	*out = *in
+	if in.Null != nil {
+		out.Null = in.Null.DeepCopy()
+	}
+	if in.VC != nil {
+		out.VC = in.VC.DeepCopy()
+	}
+	if in.Pty != nil {
+		out.Pty = in.Pty.DeepCopy()
+	}
+	if in.Dev != nil {
+		out.Dev = in.Dev.DeepCopy()
+	}
+	if in.File != nil {
+		out.File = in.File.DeepCopy()
+	}
+	if in.Pipe != nil {
+		out.Pipe = in.Pipe.DeepCopy()
+	}
+	if in.StdIO != nil {
+		out.StdIO = in.StdIO.DeepCopy()
+	}
+	if in.UDP != nil {
+		out.UDP = in.UDP.DeepCopy()
+	}
+	if in.TCP != nil {
+		out.TCP = in.TCP.DeepCopy()
+	}
+	if in.UNIX != nil {
+		out.UNIX = in.UNIX.DeepCopy()
+	}
+	if in.SpiceVMC != nil {
+		out.SpiceVMC = in.SpiceVMC.DeepCopy()
+	}
+	if in.SpicePort != nil {
+		out.SpicePort = in.SpicePort.DeepCopy()
+	}
+	if in.NMDM != nil {
+		out.NMDM = in.NMDM.DeepCopy()
+	}
+}
+
+func (a *DomainChardevSource) Equal(b *DomainChardevSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Null.Equal(b.Null) {
+		return false
+	}
+	if !a.VC.Equal(b.VC) {
+		return false
+	}
+	if !a.Pty.Equal(b.Pty) {
+		return false
+	}
+	if !a.Dev.Equal(b.Dev) {
+		return false
+	}
+	if !a.File.Equal(b.File) {
+		return false
+	}
+	if !a.Pipe.Equal(b.Pipe) {
+		return false
+	}
+	if !a.StdIO.Equal(b.StdIO) {
+		return false
+	}
+	if !a.UDP.Equal(b.UDP) {
+		return false
+	}
+	if !a.TCP.Equal(b.TCP) {
+		return false
+	}
+	if !a.UNIX.Equal(b.UNIX) {
+		return false
+	}
+	if !a.SpiceVMC.Equal(b.SpiceVMC) {
+		return false
+	}
+	if !a.SpicePort.Equal(b.SpicePort) {
+		return false
+	}
+	if !a.NMDM.Equal(b.NMDM) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevSource) canonicalize() {
+	if in.Null != nil {
+		in.Null.canonicalize()
+	}
+	if in.VC != nil {
+		in.VC.canonicalize()
+	}
+	if in.Pty != nil {
+		in.Pty.canonicalize()
+	}
+	if in.Dev != nil {
+		in.Dev.canonicalize()
+	}
+	if in.File != nil {
+		in.File.canonicalize()
+	}
+	if in.Pipe != nil {
+		in.Pipe.canonicalize()
+	}
+	if in.StdIO != nil {
+		in.StdIO.canonicalize()
+	}
+	if in.UDP != nil {
+		in.UDP.canonicalize()
+	}
+	if in.TCP != nil {
+		in.TCP.canonicalize()
+	}
+	if in.UNIX != nil {
+		in.UNIX.canonicalize()
+	}
+	if in.SpiceVMC != nil {
+		in.SpiceVMC.canonicalize()
+	}
+	if in.SpicePort != nil {
+		in.SpicePort.canonicalize()
+	}
+	if in.NMDM != nil {
+		in.NMDM.canonicalize()
+	}
+}
+
+func (in *DomainChardevSourceDev) DeepCopy() *DomainChardevSourceDev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceDev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceDev) DeepCopyInto(out *DomainChardevSourceDev) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainChardevSourceDev) Equal(b *DomainChardevSourceDev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainChardevSourceDev) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainChardevSourceFile) DeepCopy() *DomainChardevSourceFile {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceFile) DeepCopyInto(out *DomainChardevSourceFile) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainChardevSourceFile) Equal(b *DomainChardevSourceFile) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if a.Append != b.Append {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainChardevSourceFile) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainChardevSourceNMDM) DeepCopy() *DomainChardevSourceNMDM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceNMDM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceNMDM) DeepCopyInto(out *DomainChardevSourceNMDM) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceNMDM) Equal(b *DomainChardevSourceNMDM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Master != b.Master {
+		return false
+	}
+	if a.Slave != b.Slave {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevSourceNMDM) canonicalize() {
+}
+
+func (in *DomainChardevSourceNull) DeepCopy() *DomainChardevSourceNull {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceNull)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceNull) DeepCopyInto(out *DomainChardevSourceNull) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceNull) Equal(b *DomainChardevSourceNull) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainChardevSourceNull) canonicalize() {
+}
+
+func (in *DomainChardevSourcePipe) DeepCopy() *DomainChardevSourcePipe {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourcePipe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourcePipe) DeepCopyInto(out *DomainChardevSourcePipe) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainChardevSourcePipe) Equal(b *DomainChardevSourcePipe) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainChardevSourcePipe) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainChardevSourcePty) DeepCopy() *DomainChardevSourcePty {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourcePty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourcePty) DeepCopyInto(out *DomainChardevSourcePty) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainChardevSourcePty) Equal(b *DomainChardevSourcePty) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainChardevSourcePty) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainChardevSourceReconnect) DeepCopy() *DomainChardevSourceReconnect {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceReconnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceReconnect) DeepCopyInto(out *DomainChardevSourceReconnect) {
+	*out = *in
+	if in.Timeout != nil {
+		v := *in.Timeout
+		out.Timeout = &v
+	}
+}
+
+func (a *DomainChardevSourceReconnect) Equal(b *DomainChardevSourceReconnect) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	if (a.Timeout == nil) != (b.Timeout == nil) || (a.Timeout != nil && *a.Timeout != *b.Timeout) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevSourceReconnect) canonicalize() {
+}
+
+func (in *DomainChardevSourceSpicePort) DeepCopy() *DomainChardevSourceSpicePort {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceSpicePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceSpicePort) DeepCopyInto(out *DomainChardevSourceSpicePort) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceSpicePort) Equal(b *DomainChardevSourceSpicePort) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Channel != b.Channel {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevSourceSpicePort) canonicalize() {
+}
+
+func (in *DomainChardevSourceSpiceVMC) DeepCopy() *DomainChardevSourceSpiceVMC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceSpiceVMC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceSpiceVMC) DeepCopyInto(out *DomainChardevSourceSpiceVMC) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceSpiceVMC) Equal(b *DomainChardevSourceSpiceVMC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainChardevSourceSpiceVMC) canonicalize() {
+}
+
+func (in *DomainChardevSourceStdIO) DeepCopy() *DomainChardevSourceStdIO {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceStdIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceStdIO) DeepCopyInto(out *DomainChardevSourceStdIO) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceStdIO) Equal(b *DomainChardevSourceStdIO) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainChardevSourceStdIO) canonicalize() {
+}
+
+func (in *DomainChardevSourceTCP) DeepCopy() *DomainChardevSourceTCP {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceTCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceTCP) DeepCopyInto(out *DomainChardevSourceTCP) {
+	*out = *in
+	if in.Reconnect != nil {
+		out.Reconnect = in.Reconnect.DeepCopy()
+	}
+}
+
+func (a *DomainChardevSourceTCP) Equal(b *DomainChardevSourceTCP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Host != b.Host {
+		return false
+	}
+	if a.Service != b.Service {
+		return false
+	}
+	if a.TLS != b.TLS {
+		return false
+	}
+	if !a.Reconnect.Equal(b.Reconnect) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevSourceTCP) canonicalize() {
+	if in.Reconnect != nil {
+		in.Reconnect.canonicalize()
+	}
+}
+
+func (in *DomainChardevSourceUDP) DeepCopy() *DomainChardevSourceUDP {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceUDP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceUDP) DeepCopyInto(out *DomainChardevSourceUDP) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceUDP) Equal(b *DomainChardevSourceUDP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.BindHost != b.BindHost {
+		return false
+	}
+	if a.BindService != b.BindService {
+		return false
+	}
+	if a.ConnectHost != b.ConnectHost {
+		return false
+	}
+	if a.ConnectService != b.ConnectService {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevSourceUDP) canonicalize() {
+}
+
+func (in *DomainChardevSourceUNIX) DeepCopy() *DomainChardevSourceUNIX {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceUNIX)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceUNIX) DeepCopyInto(out *DomainChardevSourceUNIX) {
+	*out = *in
+	if in.Reconnect != nil {
+		out.Reconnect = in.Reconnect.DeepCopy()
+	}
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainChardevSourceUNIX) Equal(b *DomainChardevSourceUNIX) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if !a.Reconnect.Equal(b.Reconnect) {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainChardevSourceUNIX) canonicalize() {
+	if in.Reconnect != nil {
+		in.Reconnect.canonicalize()
+	}
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainChardevSourceVC) DeepCopy() *DomainChardevSourceVC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevSourceVC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevSourceVC) DeepCopyInto(out *DomainChardevSourceVC) {
+	*out = *in
+}
+
+func (a *DomainChardevSourceVC) Equal(b *DomainChardevSourceVC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainChardevSourceVC) canonicalize() {
+}
+
+func (in *DomainChardevTarget) DeepCopy() *DomainChardevTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainChardevTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainChardevTarget) DeepCopyInto(out *DomainChardevTarget) {
+	*out = *in
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+}
+
+func (a *DomainChardevTarget) Equal(b *DomainChardevTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.State != b.State {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainChardevTarget) canonicalize() {
+}
+
+func (in *DomainClock) DeepCopy() *DomainClock {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainClock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainClock) DeepCopyInto(out *DomainClock) {
+	*out = *in
+	if in.Timer != nil {
+		out.Timer = make([]DomainTimer, len(in.Timer))
+		for i := range in.Timer {
+			in.Timer[i].DeepCopyInto(&out.Timer[i])
+		}
+	}
+}
+
+func (a *DomainClock) Equal(b *DomainClock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Offset != b.Offset {
+		return false
+	}
+	if a.Basis != b.Basis {
+		return false
+	}
+	if a.Adjustment != b.Adjustment {
+		return false
+	}
+	if a.TimeZone != b.TimeZone {
+		return false
+	}
+	if len(a.Timer) != len(b.Timer) {
+		return false
+	}
+	for i := range a.Timer {
+		if !a.Timer[i].Equal(&b.Timer[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainClock) canonicalize() {
+	for i := range in.Timer {
+		in.Timer[i].canonicalize()
+	}
+	if len(in.Timer) == 0 {
+		in.Timer = nil
+	}
+}
+
+func (in *DomainConsole) DeepCopy() *DomainConsole {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainConsole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainConsole) DeepCopyInto(out *DomainConsole) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Log != nil {
+		out.Log = in.Log.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainConsole) Equal(b *DomainConsole) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.TTY != b.TTY {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Log.Equal(b.Log) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainConsole) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Log != nil {
+		in.Log.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainConsole) Canonicalize() *DomainConsole {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainConsole) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainConsole) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainConsole) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainConsole) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainConsole) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainConsole) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainConsole) schemaRef() (string, string) {
+	return "domain.rng", "console"
+}
+
+func (in *DomainConsoleTarget) DeepCopy() *DomainConsoleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainConsoleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainConsoleTarget) DeepCopyInto(out *DomainConsoleTarget) {
+	*out = *in
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+}
+
+func (a *DomainConsoleTarget) Equal(b *DomainConsoleTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainConsoleTarget) canonicalize() {
+}
+
+func (in *DomainController) DeepCopy() *DomainController {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainController) DeepCopyInto(out *DomainController) {
+	*out = *in
+	if in.Index != nil {
+		v := *in.Index
+		out.Index = &v
+	}
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.PCI != nil {
+		out.PCI = in.PCI.DeepCopy()
+	}
+	if in.USB != nil {
+		out.USB = in.USB.DeepCopy()
+	}
+	if in.VirtIOSerial != nil {
+		out.VirtIOSerial = in.VirtIOSerial.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainController) Equal(b *DomainController) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if (a.Index == nil) != (b.Index == nil) || (a.Index != nil && *a.Index != *b.Index) {
+		return false
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.PCI.Equal(b.PCI) {
+		return false
+	}
+	if !a.USB.Equal(b.USB) {
+		return false
+	}
+	if !a.VirtIOSerial.Equal(b.VirtIOSerial) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainController) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.PCI != nil {
+		in.PCI.canonicalize()
+	}
+	if in.USB != nil {
+		in.USB.canonicalize()
+	}
+	if in.VirtIOSerial != nil {
+		in.VirtIOSerial.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainController) Canonicalize() *DomainController {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainController) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainController) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainController) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainController) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainController) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainController) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainController) schemaRef() (string, string) {
+	return "domain.rng", "controller"
+}
+
+func (in *DomainControllerDriver) DeepCopy() *DomainControllerDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerDriver) DeepCopyInto(out *DomainControllerDriver) {
+	*out = *in
+	if in.Queues != nil {
+		v := *in.Queues
+		out.Queues = &v
+	}
+	if in.CmdPerLUN != nil {
+		v := *in.CmdPerLUN
+		out.CmdPerLUN = &v
+	}
+	if in.MaxSectors != nil {
+		v := *in.MaxSectors
+		out.MaxSectors = &v
+	}
+}
+
+func (a *DomainControllerDriver) Equal(b *DomainControllerDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Queues == nil) != (b.Queues == nil) || (a.Queues != nil && *a.Queues != *b.Queues) {
+		return false
+	}
+	if (a.CmdPerLUN == nil) != (b.CmdPerLUN == nil) || (a.CmdPerLUN != nil && *a.CmdPerLUN != *b.CmdPerLUN) {
+		return false
+	}
+	if (a.MaxSectors == nil) != (b.MaxSectors == nil) || (a.MaxSectors != nil && *a.MaxSectors != *b.MaxSectors) {
+		return false
+	}
+	if a.IOEventFD != b.IOEventFD {
+		return false
+	}
+	if a.IOThread != b.IOThread {
+		return false
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerDriver) canonicalize() {
+}
+
+func (in *DomainControllerPCI) DeepCopy() *DomainControllerPCI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerPCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerPCI) DeepCopyInto(out *DomainControllerPCI) {
+	*out = *in
+	if in.Model != nil {
+		out.Model = in.Model.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Hole64 != nil {
+		out.Hole64 = in.Hole64.DeepCopy()
+	}
+}
+
+func (a *DomainControllerPCI) Equal(b *DomainControllerPCI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Model.Equal(b.Model) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Hole64.Equal(b.Hole64) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerPCI) canonicalize() {
+	if in.Model != nil {
+		in.Model.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Hole64 != nil {
+		in.Hole64.canonicalize()
+	}
+}
+
+func (in *DomainControllerPCI) empty() bool {
+	return in.Model == nil &&
+		in.Target == nil &&
+		in.Hole64 == nil
+}
+
+func (in *DomainControllerPCIHole64) DeepCopy() *DomainControllerPCIHole64 {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerPCIHole64)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerPCIHole64) DeepCopyInto(out *DomainControllerPCIHole64) {
+	*out = *in
+}
+
+func (a *DomainControllerPCIHole64) Equal(b *DomainControllerPCIHole64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerPCIHole64) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Size = uint64(canonicalScaled(uint64(in.Size), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainControllerPCIModel) DeepCopy() *DomainControllerPCIModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerPCIModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerPCIModel) DeepCopyInto(out *DomainControllerPCIModel) {
+	*out = *in
+}
+
+func (a *DomainControllerPCIModel) Equal(b *DomainControllerPCIModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerPCIModel) canonicalize() {
+}
+
+func (in *DomainControllerPCITarget) DeepCopy() *DomainControllerPCITarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerPCITarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerPCITarget) DeepCopyInto(out *DomainControllerPCITarget) {
+	*out = *in
+	if in.ChassisNr != nil {
+		v := *in.ChassisNr
+		out.ChassisNr = &v
+	}
+	if in.Chassis != nil {
+		v := *in.Chassis
+		out.Chassis = &v
+	}
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+	if in.BusNr != nil {
+		v := *in.BusNr
+		out.BusNr = &v
+	}
+	if in.Index != nil {
+		v := *in.Index
+		out.Index = &v
+	}
+	if in.NUMANode != nil {
+		v := *in.NUMANode
+		out.NUMANode = &v
+	}
+}
+
+func (a *DomainControllerPCITarget) Equal(b *DomainControllerPCITarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.ChassisNr == nil) != (b.ChassisNr == nil) || (a.ChassisNr != nil && *a.ChassisNr != *b.ChassisNr) {
+		return false
+	}
+	if (a.Chassis == nil) != (b.Chassis == nil) || (a.Chassis != nil && *a.Chassis != *b.Chassis) {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	if (a.BusNr == nil) != (b.BusNr == nil) || (a.BusNr != nil && *a.BusNr != *b.BusNr) {
+		return false
+	}
+	if (a.Index == nil) != (b.Index == nil) || (a.Index != nil && *a.Index != *b.Index) {
+		return false
+	}
+	if (a.NUMANode == nil) != (b.NUMANode == nil) || (a.NUMANode != nil && *a.NUMANode != *b.NUMANode) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerPCITarget) canonicalize() {
+}
+
+func (in *DomainControllerUSB) DeepCopy() *DomainControllerUSB {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerUSB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerUSB) DeepCopyInto(out *DomainControllerUSB) {
+	*out = *in
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+	if in.Master != nil {
+		out.Master = in.Master.DeepCopy()
+	}
+}
+
+func (a *DomainControllerUSB) Equal(b *DomainControllerUSB) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	if !a.Master.Equal(b.Master) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerUSB) canonicalize() {
+	if in.Master != nil {
+		in.Master.canonicalize()
+	}
+}
+
+func (in *DomainControllerUSBMaster) DeepCopy() *DomainControllerUSBMaster {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerUSBMaster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerUSBMaster) DeepCopyInto(out *DomainControllerUSBMaster) {
+	*out = *in
+}
+
+func (a *DomainControllerUSBMaster) Equal(b *DomainControllerUSBMaster) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.StartPort != b.StartPort {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerUSBMaster) canonicalize() {
+}
+
+func (in *DomainControllerVirtIOSerial) DeepCopy() *DomainControllerVirtIOSerial {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainControllerVirtIOSerial)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainControllerVirtIOSerial) DeepCopyInto(out *DomainControllerVirtIOSerial) {
+	*out = *in
+	if in.Ports != nil {
+		v := *in.Ports
+		out.Ports = &v
+	}
+	if in.Vectors != nil {
+		v := *in.Vectors
+		out.Vectors = &v
+	}
+}
+
+func (a *DomainControllerVirtIOSerial) Equal(b *DomainControllerVirtIOSerial) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Ports == nil) != (b.Ports == nil) || (a.Ports != nil && *a.Ports != *b.Ports) {
+		return false
+	}
+	if (a.Vectors == nil) != (b.Vectors == nil) || (a.Vectors != nil && *a.Vectors != *b.Vectors) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainControllerVirtIOSerial) canonicalize() {
+}
+
+func (in *DomainCurrentMemory) DeepCopy() *DomainCurrentMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCurrentMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainCurrentMemory) DeepCopyInto(out *DomainCurrentMemory) {
+	*out = *in
+}
+
+func (a *DomainCurrentMemory) Equal(b *DomainCurrentMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainCurrentMemory) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainDeviceBoot) DeepCopy() *DomainDeviceBoot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDeviceBoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDeviceBoot) DeepCopyInto(out *DomainDeviceBoot) {
+	*out = *in
+}
+
+func (a *DomainDeviceBoot) Equal(b *DomainDeviceBoot) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Order != b.Order {
+		return false
+	}
+	if a.LoadParm != b.LoadParm {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDeviceBoot) canonicalize() {
+}
+
+func (in *DomainDeviceList) DeepCopy() *DomainDeviceList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDeviceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDeviceList) DeepCopyInto(out *DomainDeviceList) {
+	*out = *in
+	if in.Disks != nil {
+		out.Disks = make([]DomainDisk, len(in.Disks))
+		for i := range in.Disks {
+			in.Disks[i].DeepCopyInto(&out.Disks[i])
+		}
+	}
+	if in.Controllers != nil {
+		out.Controllers = make([]DomainController, len(in.Controllers))
+		for i := range in.Controllers {
+			in.Controllers[i].DeepCopyInto(&out.Controllers[i])
+		}
+	}
+	if in.Leases != nil {
+		out.Leases = make([]DomainLease, len(in.Leases))
+		for i := range in.Leases {
+			in.Leases[i].DeepCopyInto(&out.Leases[i])
+		}
+	}
+	if in.Filesystems != nil {
+		out.Filesystems = make([]DomainFilesystem, len(in.Filesystems))
+		for i := range in.Filesystems {
+			in.Filesystems[i].DeepCopyInto(&out.Filesystems[i])
+		}
+	}
+	if in.Interfaces != nil {
+		out.Interfaces = make([]DomainInterface, len(in.Interfaces))
+		for i := range in.Interfaces {
+			in.Interfaces[i].DeepCopyInto(&out.Interfaces[i])
+		}
+	}
+	if in.Smartcards != nil {
+		out.Smartcards = make([]DomainSmartcard, len(in.Smartcards))
+		for i := range in.Smartcards {
+			in.Smartcards[i].DeepCopyInto(&out.Smartcards[i])
+		}
+	}
+	if in.Serials != nil {
+		out.Serials = make([]DomainSerial, len(in.Serials))
+		for i := range in.Serials {
+			in.Serials[i].DeepCopyInto(&out.Serials[i])
+		}
+	}
+	if in.Parallels != nil {
+		out.Parallels = make([]DomainParallel, len(in.Parallels))
+		for i := range in.Parallels {
+			in.Parallels[i].DeepCopyInto(&out.Parallels[i])
+		}
+	}
+	if in.Consoles != nil {
+		out.Consoles = make([]DomainConsole, len(in.Consoles))
+		for i := range in.Consoles {
+			in.Consoles[i].DeepCopyInto(&out.Consoles[i])
+		}
+	}
+	if in.Channels != nil {
+		out.Channels = make([]DomainChannel, len(in.Channels))
+		for i := range in.Channels {
+			in.Channels[i].DeepCopyInto(&out.Channels[i])
+		}
+	}
+	if in.Inputs != nil {
+		out.Inputs = make([]DomainInput, len(in.Inputs))
+		for i := range in.Inputs {
+			in.Inputs[i].DeepCopyInto(&out.Inputs[i])
+		}
+	}
+	if in.TPMs != nil {
+		out.TPMs = make([]DomainTPM, len(in.TPMs))
+		for i := range in.TPMs {
+			in.TPMs[i].DeepCopyInto(&out.TPMs[i])
+		}
+	}
+	if in.Graphics != nil {
+		out.Graphics = make([]DomainGraphic, len(in.Graphics))
+		for i := range in.Graphics {
+			in.Graphics[i].DeepCopyInto(&out.Graphics[i])
+		}
+	}
+	if in.Sounds != nil {
+		out.Sounds = make([]DomainSound, len(in.Sounds))
+		for i := range in.Sounds {
+			in.Sounds[i].DeepCopyInto(&out.Sounds[i])
+		}
+	}
+	if in.Videos != nil {
+		out.Videos = make([]DomainVideo, len(in.Videos))
+		for i := range in.Videos {
+			in.Videos[i].DeepCopyInto(&out.Videos[i])
+		}
+	}
+	if in.Hostdevs != nil {
+		out.Hostdevs = make([]DomainHostdev, len(in.Hostdevs))
+		for i := range in.Hostdevs {
+			in.Hostdevs[i].DeepCopyInto(&out.Hostdevs[i])
+		}
+	}
+	if in.RedirDevs != nil {
+		out.RedirDevs = make([]DomainRedirDev, len(in.RedirDevs))
+		for i := range in.RedirDevs {
+			in.RedirDevs[i].DeepCopyInto(&out.RedirDevs[i])
+		}
+	}
+	if in.RedirFilters != nil {
+		out.RedirFilters = make([]DomainRedirFilter, len(in.RedirFilters))
+		for i := range in.RedirFilters {
+			in.RedirFilters[i].DeepCopyInto(&out.RedirFilters[i])
+		}
+	}
+	if in.Hubs != nil {
+		out.Hubs = make([]DomainHub, len(in.Hubs))
+		for i := range in.Hubs {
+			in.Hubs[i].DeepCopyInto(&out.Hubs[i])
+		}
+	}
+	if in.Watchdog != nil {
+		out.Watchdog = in.Watchdog.DeepCopy()
+	}
+	if in.MemBalloon != nil {
+		out.MemBalloon = in.MemBalloon.DeepCopy()
+	}
+	if in.RNGs != nil {
+		out.RNGs = make([]DomainRNG, len(in.RNGs))
+		for i := range in.RNGs {
+			in.RNGs[i].DeepCopyInto(&out.RNGs[i])
+		}
+	}
+	if in.NVRAM != nil {
+		out.NVRAM = in.NVRAM.DeepCopy()
+	}
+	if in.Panics != nil {
+		out.Panics = make([]DomainPanic, len(in.Panics))
+		for i := range in.Panics {
+			in.Panics[i].DeepCopyInto(&out.Panics[i])
+		}
+	}
+	if in.Shmems != nil {
+		out.Shmems = make([]DomainShmem, len(in.Shmems))
+		for i := range in.Shmems {
+			in.Shmems[i].DeepCopyInto(&out.Shmems[i])
+		}
+	}
+	if in.Memorydevs != nil {
+		out.Memorydevs = make([]DomainMemorydev, len(in.Memorydevs))
+		for i := range in.Memorydevs {
+			in.Memorydevs[i].DeepCopyInto(&out.Memorydevs[i])
+		}
+	}
+	if in.IOMMU != nil {
+		out.IOMMU = in.IOMMU.DeepCopy()
+	}
+	if in.VSock != nil {
+		out.VSock = in.VSock.DeepCopy()
+	}
+}
+
+func (a *DomainDeviceList) Equal(b *DomainDeviceList) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Emulator != b.Emulator {
+		return false
+	}
+	if len(a.Disks) != len(b.Disks) {
+		return false
+	}
+	for i := range a.Disks {
+		if !a.Disks[i].Equal(&b.Disks[i]) {
+			return false
+		}
+	}
+	if len(a.Controllers) != len(b.Controllers) {
+		return false
+	}
+	for i := range a.Controllers {
+		if !a.Controllers[i].Equal(&b.Controllers[i]) {
+			return false
+		}
+	}
+	if len(a.Leases) != len(b.Leases) {
+		return false
+	}
+	for i := range a.Leases {
+		if !a.Leases[i].Equal(&b.Leases[i]) {
+			return false
+		}
+	}
+	if len(a.Filesystems) != len(b.Filesystems) {
+		return false
+	}
+	for i := range a.Filesystems {
+		if !a.Filesystems[i].Equal(&b.Filesystems[i]) {
+			return false
+		}
+	}
+	if len(a.Interfaces) != len(b.Interfaces) {
+		return false
+	}
+	for i := range a.Interfaces {
+		if !a.Interfaces[i].Equal(&b.Interfaces[i]) {
+			return false
+		}
+	}
+	if len(a.Smartcards) != len(b.Smartcards) {
+		return false
+	}
+	for i := range a.Smartcards {
+		if !a.Smartcards[i].Equal(&b.Smartcards[i]) {
+			return false
+		}
+	}
+	if len(a.Serials) != len(b.Serials) {
+		return false
+	}
+	for i := range a.Serials {
+		if !a.Serials[i].Equal(&b.Serials[i]) {
+			return false
+		}
+	}
+	if len(a.Parallels) != len(b.Parallels) {
+		return false
+	}
+	for i := range a.Parallels {
+		if !a.Parallels[i].Equal(&b.Parallels[i]) {
+			return false
+		}
+	}
+	if len(a.Consoles) != len(b.Consoles) {
+		return false
+	}
+	for i := range a.Consoles {
+		if !a.Consoles[i].Equal(&b.Consoles[i]) {
+			return false
+		}
+	}
+	if len(a.Channels) != len(b.Channels) {
+		return false
+	}
+	for i := range a.Channels {
+		if !a.Channels[i].Equal(&b.Channels[i]) {
+			return false
+		}
+	}
+	if len(a.Inputs) != len(b.Inputs) {
+		return false
+	}
+	for i := range a.Inputs {
+		if !a.Inputs[i].Equal(&b.Inputs[i]) {
+			return false
+		}
+	}
+	if len(a.TPMs) != len(b.TPMs) {
+		return false
+	}
+	for i := range a.TPMs {
+		if !a.TPMs[i].Equal(&b.TPMs[i]) {
+			return false
+		}
+	}
+	if len(a.Graphics) != len(b.Graphics) {
+		return false
+	}
+	for i := range a.Graphics {
+		if !a.Graphics[i].Equal(&b.Graphics[i]) {
+			return false
+		}
+	}
+	if len(a.Sounds) != len(b.Sounds) {
+		return false
+	}
+	for i := range a.Sounds {
+		if !a.Sounds[i].Equal(&b.Sounds[i]) {
+			return false
+		}
+	}
+	if len(a.Videos) != len(b.Videos) {
+		return false
+	}
+	for i := range a.Videos {
+		if !a.Videos[i].Equal(&b.Videos[i]) {
+			return false
+		}
+	}
+	if len(a.Hostdevs) != len(b.Hostdevs) {
+		return false
+	}
+	for i := range a.Hostdevs {
+		if !a.Hostdevs[i].Equal(&b.Hostdevs[i]) {
+			return false
+		}
+	}
+	if len(a.RedirDevs) != len(b.RedirDevs) {
+		return false
+	}
+	for i := range a.RedirDevs {
+		if !a.RedirDevs[i].Equal(&b.RedirDevs[i]) {
+			return false
+		}
+	}
+	if len(a.RedirFilters) != len(b.RedirFilters) {
+		return false
+	}
+	for i := range a.RedirFilters {
+		if !a.RedirFilters[i].Equal(&b.RedirFilters[i]) {
+			return false
+		}
+	}
+	if len(a.Hubs) != len(b.Hubs) {
+		return false
+	}
+	for i := range a.Hubs {
+		if !a.Hubs[i].Equal(&b.Hubs[i]) {
+			return false
+		}
+	}
+	if !a.Watchdog.Equal(b.Watchdog) {
+		return false
+	}
+	if !a.MemBalloon.Equal(b.MemBalloon) {
+		return false
+	}
+	if len(a.RNGs) != len(b.RNGs) {
+		return false
+	}
+	for i := range a.RNGs {
+		if !a.RNGs[i].Equal(&b.RNGs[i]) {
+			return false
+		}
+	}
+	if !a.NVRAM.Equal(b.NVRAM) {
+		return false
+	}
+	if len(a.Panics) != len(b.Panics) {
+		return false
+	}
+	for i := range a.Panics {
+		if !a.Panics[i].Equal(&b.Panics[i]) {
+			return false
+		}
+	}
+	if len(a.Shmems) != len(b.Shmems) {
+		return false
+	}
+	for i := range a.Shmems {
+		if !a.Shmems[i].Equal(&b.Shmems[i]) {
+			return false
+		}
+	}
+	if len(a.Memorydevs) != len(b.Memorydevs) {
+		return false
+	}
+	for i := range a.Memorydevs {
+		if !a.Memorydevs[i].Equal(&b.Memorydevs[i]) {
+			return false
+		}
+	}
+	if !a.IOMMU.Equal(b.IOMMU) {
+		return false
+	}
+	if !a.VSock.Equal(b.VSock) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDeviceList) canonicalize() {
+	for i := range in.Disks {
+		in.Disks[i].canonicalize()
+	}
+	if len(in.Disks) == 0 {
+		in.Disks = nil
+	}
+	for i := range in.Controllers {
+		in.Controllers[i].canonicalize()
+	}
+	if len(in.Controllers) == 0 {
+		in.Controllers = nil
+	}
+	for i := range in.Leases {
+		in.Leases[i].canonicalize()
+	}
+	if len(in.Leases) == 0 {
+		in.Leases = nil
+	}
+	for i := range in.Filesystems {
+		in.Filesystems[i].canonicalize()
+	}
+	if len(in.Filesystems) == 0 {
+		in.Filesystems = nil
+	}
+	for i := range in.Interfaces {
+		in.Interfaces[i].canonicalize()
+	}
+	if len(in.Interfaces) == 0 {
+		in.Interfaces = nil
+	}
+	for i := range in.Smartcards {
+		in.Smartcards[i].canonicalize()
+	}
+	if len(in.Smartcards) == 0 {
+		in.Smartcards = nil
+	}
+	for i := range in.Serials {
+		in.Serials[i].canonicalize()
+	}
+	if len(in.Serials) == 0 {
+		in.Serials = nil
+	}
+	for i := range in.Parallels {
+		in.Parallels[i].canonicalize()
+	}
+	if len(in.Parallels) == 0 {
+		in.Parallels = nil
+	}
+	for i := range in.Consoles {
+		in.Consoles[i].canonicalize()
+	}
+	if len(in.Consoles) == 0 {
+		in.Consoles = nil
+	}
+	for i := range in.Channels {
+		in.Channels[i].canonicalize()
+	}
+	if len(in.Channels) == 0 {
+		in.Channels = nil
+	}
+	for i := range in.Inputs {
+		in.Inputs[i].canonicalize()
+	}
+	if len(in.Inputs) == 0 {
+		in.Inputs = nil
+	}
+	for i := range in.TPMs {
+		in.TPMs[i].canonicalize()
+	}
+	if len(in.TPMs) == 0 {
+		in.TPMs = nil
+	}
+	for i := range in.Graphics {
+		in.Graphics[i].canonicalize()
+	}
+	if len(in.Graphics) == 0 {
+		in.Graphics = nil
+	}
+	for i := range in.Sounds {
+		in.Sounds[i].canonicalize()
+	}
+	if len(in.Sounds) == 0 {
+		in.Sounds = nil
+	}
+	for i := range in.Videos {
+		in.Videos[i].canonicalize()
+	}
+	if len(in.Videos) == 0 {
+		in.Videos = nil
+	}
+	for i := range in.Hostdevs {
+		in.Hostdevs[i].canonicalize()
+	}
+	if len(in.Hostdevs) == 0 {
+		in.Hostdevs = nil
+	}
+	for i := range in.RedirDevs {
+		in.RedirDevs[i].canonicalize()
+	}
+	if len(in.RedirDevs) == 0 {
+		in.RedirDevs = nil
+	}
+	for i := range in.RedirFilters {
+		in.RedirFilters[i].canonicalize()
+	}
+	if len(in.RedirFilters) == 0 {
+		in.RedirFilters = nil
+	}
+	for i := range in.Hubs {
+		in.Hubs[i].canonicalize()
+	}
+	if len(in.Hubs) == 0 {
+		in.Hubs = nil
+	}
+	if in.Watchdog != nil {
+		in.Watchdog.canonicalize()
+	}
+	if in.MemBalloon != nil {
+		in.MemBalloon.canonicalize()
+	}
+	for i := range in.RNGs {
+		in.RNGs[i].canonicalize()
+	}
+	if len(in.RNGs) == 0 {
+		in.RNGs = nil
+	}
+	if in.NVRAM != nil {
+		in.NVRAM.canonicalize()
+	}
+	for i := range in.Panics {
+		in.Panics[i].canonicalize()
+	}
+	if len(in.Panics) == 0 {
+		in.Panics = nil
+	}
+	for i := range in.Shmems {
+		in.Shmems[i].canonicalize()
+	}
+	if len(in.Shmems) == 0 {
+		in.Shmems = nil
+	}
+	for i := range in.Memorydevs {
+		in.Memorydevs[i].canonicalize()
+	}
+	if len(in.Memorydevs) == 0 {
+		in.Memorydevs = nil
+	}
+	if in.IOMMU != nil {
+		in.IOMMU.canonicalize()
+	}
+	if in.VSock != nil {
+		in.VSock.canonicalize()
+	}
+	in.canonicalizeOrder()
+}
+
+func (in *DomainDeviceSecLabel) DeepCopy() *DomainDeviceSecLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDeviceSecLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDeviceSecLabel) DeepCopyInto(out *DomainDeviceSecLabel) {
+	*out = *in
+}
+
+func (a *DomainDeviceSecLabel) Equal(b *DomainDeviceSecLabel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.LabelSkip != b.LabelSkip {
+		return false
+	}
+	if a.Relabel != b.Relabel {
+		return false
+	}
+	if a.Label != b.Label {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDeviceSecLabel) canonicalize() {
+}
+
+func (in *DomainDisk) DeepCopy() *DomainDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDisk) DeepCopyInto(out *DomainDisk) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Auth != nil {
+		out.Auth = in.Auth.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.BackingStore != nil {
+		out.BackingStore = in.BackingStore.DeepCopy()
+	}
+	if in.Geometry != nil {
+		out.Geometry = in.Geometry.DeepCopy()
+	}
+	if in.BlockIO != nil {
+		out.BlockIO = in.BlockIO.DeepCopy()
+	}
+	if in.Mirror != nil {
+		out.Mirror = in.Mirror.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.IOTune != nil {
+		out.IOTune = in.IOTune.DeepCopy()
+	}
+	if in.ReadOnly != nil {
+		out.ReadOnly = in.ReadOnly.DeepCopy()
+	}
+	if in.Shareable != nil {
+		out.Shareable = in.Shareable.DeepCopy()
+	}
+	if in.Transient != nil {
+		out.Transient = in.Transient.DeepCopy()
+	}
+	if in.Encryption != nil {
+		out.Encryption = in.Encryption.DeepCopy()
+	}
+	if in.Boot != nil {
+		out.Boot = in.Boot.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainDisk) Equal(b *DomainDisk) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalStringDefault(a.Device, b.Device, "disk") {
+		return false
+	}
+	if a.RawIO != b.RawIO {
+		return false
+	}
+	if a.SGIO != b.SGIO {
+		return false
+	}
+	if a.Snapshot != b.Snapshot {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Auth.Equal(b.Auth) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.BackingStore.Equal(b.BackingStore) {
+		return false
+	}
+	if !a.Geometry.Equal(b.Geometry) {
+		return false
+	}
+	if !a.BlockIO.Equal(b.BlockIO) {
+		return false
+	}
+	if !a.Mirror.Equal(b.Mirror) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.IOTune.Equal(b.IOTune) {
+		return false
+	}
+	if !a.ReadOnly.Equal(b.ReadOnly) {
+		return false
+	}
+	if !a.Shareable.Equal(b.Shareable) {
+		return false
+	}
+	if !a.Transient.Equal(b.Transient) {
+		return false
+	}
+	if a.Serial != b.Serial {
+		return false
+	}
+	if a.WWN != b.WWN {
+		return false
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if a.Product != b.Product {
+		return false
+	}
+	if !a.Encryption.Equal(b.Encryption) {
+		return false
+	}
+	if !a.Boot.Equal(b.Boot) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDisk) canonicalize() {
+	if in.Device == "" {
+		in.Device = "disk"
+	}
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Auth != nil {
+		in.Auth.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.BackingStore != nil {
+		in.BackingStore.canonicalize()
+	}
+	if in.Geometry != nil {
+		in.Geometry.canonicalize()
+	}
+	if in.BlockIO != nil {
+		in.BlockIO.canonicalize()
+	}
+	if in.Mirror != nil {
+		in.Mirror.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.IOTune != nil {
+		in.IOTune.canonicalize()
+	}
+	if in.ReadOnly != nil {
+		in.ReadOnly.canonicalize()
+	}
+	if in.Shareable != nil {
+		in.Shareable.canonicalize()
+	}
+	if in.Transient != nil {
+		in.Transient.canonicalize()
+	}
+	if in.Encryption != nil {
+		in.Encryption.canonicalize()
+	}
+	if in.Boot != nil {
+		in.Boot.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainDisk) Canonicalize() *DomainDisk {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainDisk) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainDisk) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainDisk) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainDisk) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainDisk) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainDisk) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainDisk) schemaRef() (string, string) {
+	return "domain.rng", "disk"
+}
+
+func (in *DomainDiskAuth) DeepCopy() *DomainDiskAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskAuth) DeepCopyInto(out *DomainDiskAuth) {
+	*out = *in
+	if in.Secret != nil {
+		out.Secret = in.Secret.DeepCopy()
+	}
+}
+
+func (a *DomainDiskAuth) Equal(b *DomainDiskAuth) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Username != b.Username {
+		return false
+	}
+	if !a.Secret.Equal(b.Secret) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskAuth) canonicalize() {
+	if in.Secret != nil {
+		in.Secret.canonicalize()
+	}
+}
+
+func (in *DomainDiskBackingStore) DeepCopy() *DomainDiskBackingStore {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskBackingStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskBackingStore) DeepCopyInto(out *DomainDiskBackingStore) {
+	*out = *in
+	if in.Format != nil {
+		out.Format = in.Format.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.BackingStore != nil {
+		out.BackingStore = in.BackingStore.DeepCopy()
+	}
+}
+
+func (a *DomainDiskBackingStore) Equal(b *DomainDiskBackingStore) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Index != b.Index {
+		return false
+	}
+	if !a.Format.Equal(b.Format) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.BackingStore.Equal(b.BackingStore) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskBackingStore) canonicalize() {
+	if in.Format != nil {
+		in.Format.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.BackingStore != nil {
+		in.BackingStore.canonicalize()
+	}
+}
+
+func (in *DomainDiskBlockIO) DeepCopy() *DomainDiskBlockIO {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskBlockIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskBlockIO) DeepCopyInto(out *DomainDiskBlockIO) {
+	*out = *in
+}
+
+func (a *DomainDiskBlockIO) Equal(b *DomainDiskBlockIO) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.LogicalBlockSize != b.LogicalBlockSize {
+		return false
+	}
+	if a.PhysicalBlockSize != b.PhysicalBlockSize {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskBlockIO) canonicalize() {
+}
+
+func (in *DomainDiskDriver) DeepCopy() *DomainDiskDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskDriver) DeepCopyInto(out *DomainDiskDriver) {
+	*out = *in
+	if in.IOThread != nil {
+		v := *in.IOThread
+		out.IOThread = &v
+	}
+	if in.Queues != nil {
+		v := *in.Queues
+		out.Queues = &v
+	}
+}
+
+func (a *DomainDiskDriver) Equal(b *DomainDiskDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Cache != b.Cache {
+		return false
+	}
+	if a.ErrorPolicy != b.ErrorPolicy {
+		return false
+	}
+	if a.RErrorPolicy != b.RErrorPolicy {
+		return false
+	}
+	if a.IO != b.IO {
+		return false
+	}
+	if a.IOEventFD != b.IOEventFD {
+		return false
+	}
+	if a.EventIDX != b.EventIDX {
+		return false
+	}
+	if a.CopyOnRead != b.CopyOnRead {
+		return false
+	}
+	if a.Discard != b.Discard {
+		return false
+	}
+	if (a.IOThread == nil) != (b.IOThread == nil) || (a.IOThread != nil && *a.IOThread != *b.IOThread) {
+		return false
+	}
+	if a.DetectZeros != b.DetectZeros {
+		return false
+	}
+	if (a.Queues == nil) != (b.Queues == nil) || (a.Queues != nil && *a.Queues != *b.Queues) {
+		return false
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskDriver) canonicalize() {
+}
+
+func (in *DomainDiskEncryption) DeepCopy() *DomainDiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskEncryption) DeepCopyInto(out *DomainDiskEncryption) {
+	*out = *in
+	if in.Secret != nil {
+		out.Secret = in.Secret.DeepCopy()
+	}
+}
+
+func (a *DomainDiskEncryption) Equal(b *DomainDiskEncryption) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Format != b.Format {
+		return false
+	}
+	if !a.Secret.Equal(b.Secret) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskEncryption) canonicalize() {
+	if in.Secret != nil {
+		in.Secret.canonicalize()
+	}
+}
+
+func (in *DomainDiskFormat) DeepCopy() *DomainDiskFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskFormat) DeepCopyInto(out *DomainDiskFormat) {
+	*out = *in
+}
+
+func (a *DomainDiskFormat) Equal(b *DomainDiskFormat) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskFormat) canonicalize() {
+}
+
+func (in *DomainDiskGeometry) DeepCopy() *DomainDiskGeometry {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskGeometry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskGeometry) DeepCopyInto(out *DomainDiskGeometry) {
+	*out = *in
+}
+
+func (a *DomainDiskGeometry) Equal(b *DomainDiskGeometry) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Cylinders != b.Cylinders {
+		return false
+	}
+	if a.Headers != b.Headers {
+		return false
+	}
+	if a.Sectors != b.Sectors {
+		return false
+	}
+	if a.Trans != b.Trans {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskGeometry) canonicalize() {
+}
+
+func (in *DomainDiskIOTune) DeepCopy() *DomainDiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskIOTune) DeepCopyInto(out *DomainDiskIOTune) {
+	*out = *in
+}
+
+func (a *DomainDiskIOTune) Equal(b *DomainDiskIOTune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.TotalBytesSec != b.TotalBytesSec {
+		return false
+	}
+	if a.ReadBytesSec != b.ReadBytesSec {
+		return false
+	}
+	if a.WriteBytesSec != b.WriteBytesSec {
+		return false
+	}
+	if a.TotalIopsSec != b.TotalIopsSec {
+		return false
+	}
+	if a.ReadIopsSec != b.ReadIopsSec {
+		return false
+	}
+	if a.WriteIopsSec != b.WriteIopsSec {
+		return false
+	}
+	if a.TotalBytesSecMax != b.TotalBytesSecMax {
+		return false
+	}
+	if a.ReadBytesSecMax != b.ReadBytesSecMax {
+		return false
+	}
+	if a.WriteBytesSecMax != b.WriteBytesSecMax {
+		return false
+	}
+	if a.TotalIopsSecMax != b.TotalIopsSecMax {
+		return false
+	}
+	if a.ReadIopsSecMax != b.ReadIopsSecMax {
+		return false
+	}
+	if a.WriteIopsSecMax != b.WriteIopsSecMax {
+		return false
+	}
+	if a.TotalBytesSecMaxLength != b.TotalBytesSecMaxLength {
+		return false
+	}
+	if a.ReadBytesSecMaxLength != b.ReadBytesSecMaxLength {
+		return false
+	}
+	if a.WriteBytesSecMaxLength != b.WriteBytesSecMaxLength {
+		return false
+	}
+	if a.TotalIopsSecMaxLength != b.TotalIopsSecMaxLength {
+		return false
+	}
+	if a.ReadIopsSecMaxLength != b.ReadIopsSecMaxLength {
+		return false
+	}
+	if a.WriteIopsSecMaxLength != b.WriteIopsSecMaxLength {
+		return false
+	}
+	if a.SizeIopsSec != b.SizeIopsSec {
+		return false
+	}
+	if a.GroupName != b.GroupName {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskIOTune) canonicalize() {
+}
+
+func (in *DomainDiskMirror) DeepCopy() *DomainDiskMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskMirror) DeepCopyInto(out *DomainDiskMirror) {
+	*out = *in
+	if in.Format != nil {
+		out.Format = in.Format.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainDiskMirror) Equal(b *DomainDiskMirror) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Job != b.Job {
+		return false
+	}
+	if a.Ready != b.Ready {
+		return false
+	}
+	if !a.Format.Equal(b.Format) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskMirror) canonicalize() {
+	if in.Format != nil {
+		in.Format.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (in *DomainDiskReadOnly) DeepCopy() *DomainDiskReadOnly {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskReadOnly)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskReadOnly) DeepCopyInto(out *DomainDiskReadOnly) {
+	*out = *in
+}
+
+func (a *DomainDiskReadOnly) Equal(b *DomainDiskReadOnly) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainDiskReadOnly) canonicalize() {
+}
+
+func (in *DomainDiskReservations) DeepCopy() *DomainDiskReservations {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskReservations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskReservations) DeepCopyInto(out *DomainDiskReservations) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainDiskReservations) Equal(b *DomainDiskReservations) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	if a.Managed != b.Managed {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskReservations) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (in *DomainDiskReservationsSource) DeepCopy() *DomainDiskReservationsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskReservationsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskReservationsSource) DeepCopyInto(out *DomainDiskReservationsSource) {
+	(*DomainChardevSource)(in).DeepCopyInto((*DomainChardevSource)(out))
+}
+
+func (a *DomainDiskReservationsSource) Equal(b *DomainDiskReservationsSource) bool {
+	return (*DomainChardevSource)(a).Equal((*DomainChardevSource)(b))
+}
+
+func (in *DomainDiskReservationsSource) canonicalize() {
+	(*DomainChardevSource)(in).canonicalize()
+}
+
+func (in *DomainDiskSecret) DeepCopy() *DomainDiskSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSecret) DeepCopyInto(out *DomainDiskSecret) {
+	*out = *in
+}
+
+func (a *DomainDiskSecret) Equal(b *DomainDiskSecret) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Usage != b.Usage {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSecret) canonicalize() {
+}
+
+func (in *DomainDiskShareable) DeepCopy() *DomainDiskShareable {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskShareable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskShareable) DeepCopyInto(out *DomainDiskShareable) {
+	*out = *in
+}
+
+func (a *DomainDiskShareable) Equal(b *DomainDiskShareable) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainDiskShareable) canonicalize() {
+}
+
+func (in *DomainDiskSource) DeepCopy() *DomainDiskSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSource) DeepCopyInto(out *DomainDiskSource) {
+	*out = *in
+	if in.File != nil {
+		out.File = in.File.DeepCopy()
+	}
+	if in.Block != nil {
+		out.Block = in.Block.DeepCopy()
+	}
+	if in.Dir != nil {
+		out.Dir = in.Dir.DeepCopy()
+	}
+	if in.Network != nil {
+		out.Network = in.Network.DeepCopy()
+	}
+	if in.Volume != nil {
+		out.Volume = in.Volume.DeepCopy()
+	}
+	if in.Encryption != nil {
+		out.Encryption = in.Encryption.DeepCopy()
+	}
+	if in.Reservations != nil {
+		out.Reservations = in.Reservations.DeepCopy()
+	}
+}
+
+func (a *DomainDiskSource) Equal(b *DomainDiskSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.File.Equal(b.File) {
+		return false
+	}
+	if !a.Block.Equal(b.Block) {
+		return false
+	}
+	if !a.Dir.Equal(b.Dir) {
+		return false
+	}
+	if !a.Network.Equal(b.Network) {
+		return false
+	}
+	if !a.Volume.Equal(b.Volume) {
+		return false
+	}
+	if a.StartupPolicy != b.StartupPolicy {
+		return false
+	}
+	if !a.Encryption.Equal(b.Encryption) {
+		return false
+	}
+	if !a.Reservations.Equal(b.Reservations) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSource) canonicalize() {
+	if in.File != nil {
+		in.File.canonicalize()
+	}
+	if in.Block != nil {
+		in.Block.canonicalize()
+	}
+	if in.Dir != nil {
+		in.Dir.canonicalize()
+	}
+	if in.Network != nil {
+		in.Network.canonicalize()
+	}
+	if in.Volume != nil {
+		in.Volume.canonicalize()
+	}
+	if in.Encryption != nil {
+		in.Encryption.canonicalize()
+	}
+	if in.Reservations != nil {
+		in.Reservations.canonicalize()
+	}
+}
+
+func (in *DomainDiskSourceBlock) DeepCopy() *DomainDiskSourceBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceBlock) DeepCopyInto(out *DomainDiskSourceBlock) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainDiskSourceBlock) Equal(b *DomainDiskSourceBlock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainDiskSourceBlock) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainDiskSourceDir) DeepCopy() *DomainDiskSourceDir {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceDir)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceDir) DeepCopyInto(out *DomainDiskSourceDir) {
+	*out = *in
+}
+
+func (a *DomainDiskSourceDir) Equal(b *DomainDiskSourceDir) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dir != b.Dir {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceDir) canonicalize() {
+}
+
+func (in *DomainDiskSourceFile) DeepCopy() *DomainDiskSourceFile {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceFile) DeepCopyInto(out *DomainDiskSourceFile) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainDiskSourceFile) Equal(b *DomainDiskSourceFile) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.File != b.File {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainDiskSourceFile) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainDiskSourceHost) DeepCopy() *DomainDiskSourceHost {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceHost) DeepCopyInto(out *DomainDiskSourceHost) {
+	*out = *in
+}
+
+func (a *DomainDiskSourceHost) Equal(b *DomainDiskSourceHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Transport != b.Transport {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if a.Socket != b.Socket {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceHost) canonicalize() {
+}
+
+func (in *DomainDiskSourceNetwork) DeepCopy() *DomainDiskSourceNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceNetwork) DeepCopyInto(out *DomainDiskSourceNetwork) {
+	*out = *in
+	if in.Hosts != nil {
+		out.Hosts = make([]DomainDiskSourceHost, len(in.Hosts))
+		for i := range in.Hosts {
+			in.Hosts[i].DeepCopyInto(&out.Hosts[i])
+		}
+	}
+	if in.Initiator != nil {
+		out.Initiator = in.Initiator.DeepCopy()
+	}
+	if in.Snapshot != nil {
+		out.Snapshot = in.Snapshot.DeepCopy()
+	}
+	if in.Config != nil {
+		out.Config = in.Config.DeepCopy()
+	}
+	if in.Auth != nil {
+		out.Auth = in.Auth.DeepCopy()
+	}
+}
+
+func (a *DomainDiskSourceNetwork) Equal(b *DomainDiskSourceNetwork) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Protocol != b.Protocol {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.TLS != b.TLS {
+		return false
+	}
+	if len(a.Hosts) != len(b.Hosts) {
+		return false
+	}
+	for i := range a.Hosts {
+		if !a.Hosts[i].Equal(&b.Hosts[i]) {
+			return false
+		}
+	}
+	if !a.Initiator.Equal(b.Initiator) {
+		return false
+	}
+	if !a.Snapshot.Equal(b.Snapshot) {
+		return false
+	}
+	if !a.Config.Equal(b.Config) {
+		return false
+	}
+	if !a.Auth.Equal(b.Auth) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceNetwork) canonicalize() {
+	for i := range in.Hosts {
+		in.Hosts[i].canonicalize()
+	}
+	if len(in.Hosts) == 0 {
+		in.Hosts = nil
+	}
+	if in.Initiator != nil {
+		in.Initiator.canonicalize()
+		if in.Initiator.empty() {
+			in.Initiator = nil
+		}
+	}
+	if in.Snapshot != nil {
+		in.Snapshot.canonicalize()
+	}
+	if in.Config != nil {
+		in.Config.canonicalize()
+	}
+	if in.Auth != nil {
+		in.Auth.canonicalize()
+	}
+}
+
+func (in *DomainDiskSourceNetworkConfig) DeepCopy() *DomainDiskSourceNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceNetworkConfig) DeepCopyInto(out *DomainDiskSourceNetworkConfig) {
+	*out = *in
+}
+
+func (a *DomainDiskSourceNetworkConfig) Equal(b *DomainDiskSourceNetworkConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.File != b.File {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceNetworkConfig) canonicalize() {
+}
+
+func (in *DomainDiskSourceNetworkIQN) DeepCopy() *DomainDiskSourceNetworkIQN {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceNetworkIQN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceNetworkIQN) DeepCopyInto(out *DomainDiskSourceNetworkIQN) {
+	*out = *in
+}
+
+func (a *DomainDiskSourceNetworkIQN) Equal(b *DomainDiskSourceNetworkIQN) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceNetworkIQN) canonicalize() {
+}
+
+func (in *DomainDiskSourceNetworkInitiator) DeepCopy() *DomainDiskSourceNetworkInitiator {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceNetworkInitiator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceNetworkInitiator) DeepCopyInto(out *DomainDiskSourceNetworkInitiator) {
+	*out = *in
+	if in.IQN != nil {
+		out.IQN = in.IQN.DeepCopy()
+	}
+}
+
+func (a *DomainDiskSourceNetworkInitiator) Equal(b *DomainDiskSourceNetworkInitiator) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.IQN.Equal(b.IQN) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceNetworkInitiator) canonicalize() {
+	if in.IQN != nil {
+		in.IQN.canonicalize()
+	}
+}
+
+func (in *DomainDiskSourceNetworkInitiator) empty() bool {
+	return in.IQN == nil
+}
+
+func (in *DomainDiskSourceNetworkSnapshot) DeepCopy() *DomainDiskSourceNetworkSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceNetworkSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceNetworkSnapshot) DeepCopyInto(out *DomainDiskSourceNetworkSnapshot) {
+	*out = *in
+}
+
+func (a *DomainDiskSourceNetworkSnapshot) Equal(b *DomainDiskSourceNetworkSnapshot) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskSourceNetworkSnapshot) canonicalize() {
+}
+
+func (in *DomainDiskSourceVolume) DeepCopy() *DomainDiskSourceVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskSourceVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskSourceVolume) DeepCopyInto(out *DomainDiskSourceVolume) {
+	*out = *in
+	if in.SecLabel != nil {
+		out.SecLabel = make([]DomainDeviceSecLabel, len(in.SecLabel))
+		for i := range in.SecLabel {
+			in.SecLabel[i].DeepCopyInto(&out.SecLabel[i])
+		}
+	}
+}
+
+func (a *DomainDiskSourceVolume) Equal(b *DomainDiskSourceVolume) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Pool != b.Pool {
+		return false
+	}
+	if a.Volume != b.Volume {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if len(a.SecLabel) != len(b.SecLabel) {
+		return false
+	}
+	for i := range a.SecLabel {
+		if !a.SecLabel[i].Equal(&b.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainDiskSourceVolume) canonicalize() {
+	for i := range in.SecLabel {
+		in.SecLabel[i].canonicalize()
+	}
+	if len(in.SecLabel) == 0 {
+		in.SecLabel = nil
+	}
+}
+
+func (in *DomainDiskTarget) DeepCopy() *DomainDiskTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskTarget) DeepCopyInto(out *DomainDiskTarget) {
+	*out = *in
+}
+
+func (a *DomainDiskTarget) Equal(b *DomainDiskTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	if a.Bus != b.Bus {
+		return false
+	}
+	if a.Tray != b.Tray {
+		return false
+	}
+	if a.Removable != b.Removable {
+		return false
+	}
+	return true
+}
+
+func (in *DomainDiskTarget) canonicalize() {
+}
+
+func (in *DomainDiskTransient) DeepCopy() *DomainDiskTransient {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskTransient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainDiskTransient) DeepCopyInto(out *DomainDiskTransient) {
+	*out = *in
+}
+
+func (a *DomainDiskTransient) Equal(b *DomainDiskTransient) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainDiskTransient) canonicalize() {
+}
+
+func (in *DomainFeature) DeepCopy() *DomainFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeature) DeepCopyInto(out *DomainFeature) {
+	*out = *in
+}
+
+func (a *DomainFeature) Equal(b *DomainFeature) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainFeature) canonicalize() {
+}
+
+func (in *DomainFeatureAPIC) DeepCopy() *DomainFeatureAPIC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureAPIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureAPIC) DeepCopyInto(out *DomainFeatureAPIC) {
+	*out = *in
+}
+
+func (a *DomainFeatureAPIC) Equal(b *DomainFeatureAPIC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.EOI != b.EOI {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureAPIC) canonicalize() {
+}
+
+func (in *DomainFeatureCapabilities) DeepCopy() *DomainFeatureCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureCapabilities) DeepCopyInto(out *DomainFeatureCapabilities) {
+	*out = *in
+	if in.AuditControl != nil {
+		out.AuditControl = in.AuditControl.DeepCopy()
+	}
+	if in.AuditWrite != nil {
+		out.AuditWrite = in.AuditWrite.DeepCopy()
+	}
+	if in.BlockSuspend != nil {
+		out.BlockSuspend = in.BlockSuspend.DeepCopy()
+	}
+	if in.Chown != nil {
+		out.Chown = in.Chown.DeepCopy()
+	}
+	if in.DACOverride != nil {
+		out.DACOverride = in.DACOverride.DeepCopy()
+	}
+	if in.DACReadSearch != nil {
+		out.DACReadSearch = in.DACReadSearch.DeepCopy()
+	}
+	if in.FOwner != nil {
+		out.FOwner = in.FOwner.DeepCopy()
+	}
+	if in.FSetID != nil {
+		out.FSetID = in.FSetID.DeepCopy()
+	}
+	if in.IPCLock != nil {
+		out.IPCLock = in.IPCLock.DeepCopy()
+	}
+	if in.IPCOwner != nil {
+		out.IPCOwner = in.IPCOwner.DeepCopy()
+	}
+	if in.Kill != nil {
+		out.Kill = in.Kill.DeepCopy()
+	}
+	if in.Lease != nil {
+		out.Lease = in.Lease.DeepCopy()
+	}
+	if in.LinuxImmutable != nil {
+		out.LinuxImmutable = in.LinuxImmutable.DeepCopy()
+	}
+	if in.MACAdmin != nil {
+		out.MACAdmin = in.MACAdmin.DeepCopy()
+	}
+	if in.MACOverride != nil {
+		out.MACOverride = in.MACOverride.DeepCopy()
+	}
+	if in.MkNod != nil {
+		out.MkNod = in.MkNod.DeepCopy()
+	}
+	if in.NetAdmin != nil {
+		out.NetAdmin = in.NetAdmin.DeepCopy()
+	}
+	if in.NetBindService != nil {
+		out.NetBindService = in.NetBindService.DeepCopy()
+	}
+	if in.NetBroadcast != nil {
+		out.NetBroadcast = in.NetBroadcast.DeepCopy()
+	}
+	if in.NetRaw != nil {
+		out.NetRaw = in.NetRaw.DeepCopy()
+	}
+	if in.SetGID != nil {
+		out.SetGID = in.SetGID.DeepCopy()
+	}
+	if in.SetFCap != nil {
+		out.SetFCap = in.SetFCap.DeepCopy()
+	}
+	if in.SetPCap != nil {
+		out.SetPCap = in.SetPCap.DeepCopy()
+	}
+	if in.SetUID != nil {
+		out.SetUID = in.SetUID.DeepCopy()
+	}
+	if in.SysAdmin != nil {
+		out.SysAdmin = in.SysAdmin.DeepCopy()
+	}
+	if in.SysBoot != nil {
+		out.SysBoot = in.SysBoot.DeepCopy()
+	}
+	if in.SysChRoot != nil {
+		out.SysChRoot = in.SysChRoot.DeepCopy()
+	}
+	if in.SysModule != nil {
+		out.SysModule = in.SysModule.DeepCopy()
+	}
+	if in.SysNice != nil {
+		out.SysNice = in.SysNice.DeepCopy()
+	}
+	if in.SysPAcct != nil {
+		out.SysPAcct = in.SysPAcct.DeepCopy()
+	}
+	if in.SysPTrace != nil {
+		out.SysPTrace = in.SysPTrace.DeepCopy()
+	}
+	if in.SysRawIO != nil {
+		out.SysRawIO = in.SysRawIO.DeepCopy()
+	}
+	if in.SysResource != nil {
+		out.SysResource = in.SysResource.DeepCopy()
+	}
+	if in.SysTime != nil {
+		out.SysTime = in.SysTime.DeepCopy()
+	}
+	if in.SysTTYCnofig != nil {
+		out.SysTTYCnofig = in.SysTTYCnofig.DeepCopy()
+	}
+	if in.SysLog != nil {
+		out.SysLog = in.SysLog.DeepCopy()
+	}
+	if in.WakeAlarm != nil {
+		out.WakeAlarm = in.WakeAlarm.DeepCopy()
+	}
+}
+
+func (a *DomainFeatureCapabilities) Equal(b *DomainFeatureCapabilities) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Policy != b.Policy {
+		return false
+	}
+	if !a.AuditControl.Equal(b.AuditControl) {
+		return false
+	}
+	if !a.AuditWrite.Equal(b.AuditWrite) {
+		return false
+	}
+	if !a.BlockSuspend.Equal(b.BlockSuspend) {
+		return false
+	}
+	if !a.Chown.Equal(b.Chown) {
+		return false
+	}
+	if !a.DACOverride.Equal(b.DACOverride) {
+		return false
+	}
+	if !a.DACReadSearch.Equal(b.DACReadSearch) {
+		return false
+	}
+	if !a.FOwner.Equal(b.FOwner) {
+		return false
+	}
+	if !a.FSetID.Equal(b.FSetID) {
+		return false
+	}
+	if !a.IPCLock.Equal(b.IPCLock) {
+		return false
+	}
+	if !a.IPCOwner.Equal(b.IPCOwner) {
+		return false
+	}
+	if !a.Kill.Equal(b.Kill) {
+		return false
+	}
+	if !a.Lease.Equal(b.Lease) {
+		return false
+	}
+	if !a.LinuxImmutable.Equal(b.LinuxImmutable) {
+		return false
+	}
+	if !a.MACAdmin.Equal(b.MACAdmin) {
+		return false
+	}
+	if !a.MACOverride.Equal(b.MACOverride) {
+		return false
+	}
+	if !a.MkNod.Equal(b.MkNod) {
+		return false
+	}
+	if !a.NetAdmin.Equal(b.NetAdmin) {
+		return false
+	}
+	if !a.NetBindService.Equal(b.NetBindService) {
+		return false
+	}
+	if !a.NetBroadcast.Equal(b.NetBroadcast) {
+		return false
+	}
+	if !a.NetRaw.Equal(b.NetRaw) {
+		return false
+	}
+	if !a.SetGID.Equal(b.SetGID) {
+		return false
+	}
+	if !a.SetFCap.Equal(b.SetFCap) {
+		return false
+	}
+	if !a.SetPCap.Equal(b.SetPCap) {
+		return false
+	}
+	if !a.SetUID.Equal(b.SetUID) {
+		return false
+	}
+	if !a.SysAdmin.Equal(b.SysAdmin) {
+		return false
+	}
+	if !a.SysBoot.Equal(b.SysBoot) {
+		return false
+	}
+	if !a.SysChRoot.Equal(b.SysChRoot) {
+		return false
+	}
+	if !a.SysModule.Equal(b.SysModule) {
+		return false
+	}
+	if !a.SysNice.Equal(b.SysNice) {
+		return false
+	}
+	if !a.SysPAcct.Equal(b.SysPAcct) {
+		return false
+	}
+	if !a.SysPTrace.Equal(b.SysPTrace) {
+		return false
+	}
+	if !a.SysRawIO.Equal(b.SysRawIO) {
+		return false
+	}
+	if !a.SysResource.Equal(b.SysResource) {
+		return false
+	}
+	if !a.SysTime.Equal(b.SysTime) {
+		return false
+	}
+	if !a.SysTTYCnofig.Equal(b.SysTTYCnofig) {
+		return false
+	}
+	if !a.SysLog.Equal(b.SysLog) {
+		return false
+	}
+	if !a.WakeAlarm.Equal(b.WakeAlarm) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureCapabilities) canonicalize() {
+	if in.AuditControl != nil {
+		in.AuditControl.canonicalize()
+	}
+	if in.AuditWrite != nil {
+		in.AuditWrite.canonicalize()
+	}
+	if in.BlockSuspend != nil {
+		in.BlockSuspend.canonicalize()
+	}
+	if in.Chown != nil {
+		in.Chown.canonicalize()
+	}
+	if in.DACOverride != nil {
+		in.DACOverride.canonicalize()
+	}
+	if in.DACReadSearch != nil {
+		in.DACReadSearch.canonicalize()
+	}
+	if in.FOwner != nil {
+		in.FOwner.canonicalize()
+	}
+	if in.FSetID != nil {
+		in.FSetID.canonicalize()
+	}
+	if in.IPCLock != nil {
+		in.IPCLock.canonicalize()
+	}
+	if in.IPCOwner != nil {
+		in.IPCOwner.canonicalize()
+	}
+	if in.Kill != nil {
+		in.Kill.canonicalize()
+	}
+	if in.Lease != nil {
+		in.Lease.canonicalize()
+	}
+	if in.LinuxImmutable != nil {
+		in.LinuxImmutable.canonicalize()
+	}
+	if in.MACAdmin != nil {
+		in.MACAdmin.canonicalize()
+	}
+	if in.MACOverride != nil {
+		in.MACOverride.canonicalize()
+	}
+	if in.MkNod != nil {
+		in.MkNod.canonicalize()
+	}
+	if in.NetAdmin != nil {
+		in.NetAdmin.canonicalize()
+	}
+	if in.NetBindService != nil {
+		in.NetBindService.canonicalize()
+	}
+	if in.NetBroadcast != nil {
+		in.NetBroadcast.canonicalize()
+	}
+	if in.NetRaw != nil {
+		in.NetRaw.canonicalize()
+	}
+	if in.SetGID != nil {
+		in.SetGID.canonicalize()
+	}
+	if in.SetFCap != nil {
+		in.SetFCap.canonicalize()
+	}
+	if in.SetPCap != nil {
+		in.SetPCap.canonicalize()
+	}
+	if in.SetUID != nil {
+		in.SetUID.canonicalize()
+	}
+	if in.SysAdmin != nil {
+		in.SysAdmin.canonicalize()
+	}
+	if in.SysBoot != nil {
+		in.SysBoot.canonicalize()
+	}
+	if in.SysChRoot != nil {
+		in.SysChRoot.canonicalize()
+	}
+	if in.SysModule != nil {
+		in.SysModule.canonicalize()
+	}
+	if in.SysNice != nil {
+		in.SysNice.canonicalize()
+	}
+	if in.SysPAcct != nil {
+		in.SysPAcct.canonicalize()
+	}
+	if in.SysPTrace != nil {
+		in.SysPTrace.canonicalize()
+	}
+	if in.SysRawIO != nil {
+		in.SysRawIO.canonicalize()
+	}
+	if in.SysResource != nil {
+		in.SysResource.canonicalize()
+	}
+	if in.SysTime != nil {
+		in.SysTime.canonicalize()
+	}
+	if in.SysTTYCnofig != nil {
+		in.SysTTYCnofig.canonicalize()
+	}
+	if in.SysLog != nil {
+		in.SysLog.canonicalize()
+	}
+	if in.WakeAlarm != nil {
+		in.WakeAlarm.canonicalize()
+	}
+}
+
+func (in *DomainFeatureCapability) DeepCopy() *DomainFeatureCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureCapability) DeepCopyInto(out *DomainFeatureCapability) {
+	*out = *in
+}
+
+func (a *DomainFeatureCapability) Equal(b *DomainFeatureCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.State != b.State {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureCapability) canonicalize() {
+}
+
+func (in *DomainFeatureGIC) DeepCopy() *DomainFeatureGIC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureGIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureGIC) DeepCopyInto(out *DomainFeatureGIC) {
+	*out = *in
+}
+
+func (a *DomainFeatureGIC) Equal(b *DomainFeatureGIC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Version != b.Version {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureGIC) canonicalize() {
+}
+
+func (in *DomainFeatureHPT) DeepCopy() *DomainFeatureHPT {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureHPT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureHPT) DeepCopyInto(out *DomainFeatureHPT) {
+	*out = *in
+	if in.MaxPageSize != nil {
+		out.MaxPageSize = in.MaxPageSize.DeepCopy()
+	}
+}
+
+func (a *DomainFeatureHPT) Equal(b *DomainFeatureHPT) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Resizing != b.Resizing {
+		return false
+	}
+	if !a.MaxPageSize.Equal(b.MaxPageSize) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureHPT) canonicalize() {
+	if in.MaxPageSize != nil {
+		in.MaxPageSize.canonicalize()
+	}
+}
+
+func (in *DomainFeatureHPTPageSize) DeepCopy() *DomainFeatureHPTPageSize {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureHPTPageSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureHPTPageSize) DeepCopyInto(out *DomainFeatureHPTPageSize) {
+	*out = *in
+}
+
+func (a *DomainFeatureHPTPageSize) Equal(b *DomainFeatureHPTPageSize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaledString(a.Value, a.Unit, b.Value, b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureHPTPageSize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = canonicalScaledString(in.Value, scale, 1024)
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainFeatureHyperV) DeepCopy() *DomainFeatureHyperV {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureHyperV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureHyperV) DeepCopyInto(out *DomainFeatureHyperV) {
+	*out = *in
+	in.DomainFeature.DeepCopyInto(&out.DomainFeature)
+	if in.Relaxed != nil {
+		out.Relaxed = in.Relaxed.DeepCopy()
+	}
+	if in.VAPIC != nil {
+		out.VAPIC = in.VAPIC.DeepCopy()
+	}
+	if in.Spinlocks != nil {
+		out.Spinlocks = in.Spinlocks.DeepCopy()
+	}
+	if in.VPIndex != nil {
+		out.VPIndex = in.VPIndex.DeepCopy()
+	}
+	if in.Runtime != nil {
+		out.Runtime = in.Runtime.DeepCopy()
+	}
+	if in.Synic != nil {
+		out.Synic = in.Synic.DeepCopy()
+	}
+	if in.STimer != nil {
+		out.STimer = in.STimer.DeepCopy()
+	}
+	if in.Reset != nil {
+		out.Reset = in.Reset.DeepCopy()
+	}
+	if in.VendorId != nil {
+		out.VendorId = in.VendorId.DeepCopy()
+	}
+}
+
+func (a *DomainFeatureHyperV) Equal(b *DomainFeatureHyperV) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.DomainFeature.Equal(&b.DomainFeature) {
+		return false
+	}
+	if !a.Relaxed.Equal(b.Relaxed) {
+		return false
+	}
+	if !a.VAPIC.Equal(b.VAPIC) {
+		return false
+	}
+	if !a.Spinlocks.Equal(b.Spinlocks) {
+		return false
+	}
+	if !a.VPIndex.Equal(b.VPIndex) {
+		return false
+	}
+	if !a.Runtime.Equal(b.Runtime) {
+		return false
+	}
+	if !a.Synic.Equal(b.Synic) {
+		return false
+	}
+	if !a.STimer.Equal(b.STimer) {
+		return false
+	}
+	if !a.Reset.Equal(b.Reset) {
+		return false
+	}
+	if !a.VendorId.Equal(b.VendorId) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureHyperV) canonicalize() {
+	in.DomainFeature.canonicalize()
+	if in.Relaxed != nil {
+		in.Relaxed.canonicalize()
+	}
+	if in.VAPIC != nil {
+		in.VAPIC.canonicalize()
+	}
+	if in.Spinlocks != nil {
+		in.Spinlocks.canonicalize()
+	}
+	if in.VPIndex != nil {
+		in.VPIndex.canonicalize()
+	}
+	if in.Runtime != nil {
+		in.Runtime.canonicalize()
+	}
+	if in.Synic != nil {
+		in.Synic.canonicalize()
+	}
+	if in.STimer != nil {
+		in.STimer.canonicalize()
+	}
+	if in.Reset != nil {
+		in.Reset.canonicalize()
+	}
+	if in.VendorId != nil {
+		in.VendorId.canonicalize()
+	}
+}
+
+func (in *DomainFeatureHyperVSpinlocks) DeepCopy() *DomainFeatureHyperVSpinlocks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureHyperVSpinlocks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureHyperVSpinlocks) DeepCopyInto(out *DomainFeatureHyperVSpinlocks) {
+	*out = *in
+	in.DomainFeatureState.DeepCopyInto(&out.DomainFeatureState)
+}
+
+func (a *DomainFeatureHyperVSpinlocks) Equal(b *DomainFeatureHyperVSpinlocks) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.DomainFeatureState.Equal(&b.DomainFeatureState) {
+		return false
+	}
+	if a.Retries != b.Retries {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureHyperVSpinlocks) canonicalize() {
+	in.DomainFeatureState.canonicalize()
+}
+
+func (in *DomainFeatureHyperVVendorId) DeepCopy() *DomainFeatureHyperVVendorId {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureHyperVVendorId)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureHyperVVendorId) DeepCopyInto(out *DomainFeatureHyperVVendorId) {
+	*out = *in
+	in.DomainFeatureState.DeepCopyInto(&out.DomainFeatureState)
+}
+
+func (a *DomainFeatureHyperVVendorId) Equal(b *DomainFeatureHyperVVendorId) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.DomainFeatureState.Equal(&b.DomainFeatureState) {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureHyperVVendorId) canonicalize() {
+	in.DomainFeatureState.canonicalize()
+}
+
+func (in *DomainFeatureIOAPIC) DeepCopy() *DomainFeatureIOAPIC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureIOAPIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureIOAPIC) DeepCopyInto(out *DomainFeatureIOAPIC) {
+	*out = *in
+}
+
+func (a *DomainFeatureIOAPIC) Equal(b *DomainFeatureIOAPIC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Driver != b.Driver {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureIOAPIC) canonicalize() {
+}
+
+func (in *DomainFeatureKVM) DeepCopy() *DomainFeatureKVM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureKVM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureKVM) DeepCopyInto(out *DomainFeatureKVM) {
+	*out = *in
+	if in.Hidden != nil {
+		out.Hidden = in.Hidden.DeepCopy()
+	}
+}
+
+func (a *DomainFeatureKVM) Equal(b *DomainFeatureKVM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Hidden.Equal(b.Hidden) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureKVM) canonicalize() {
+	if in.Hidden != nil {
+		in.Hidden.canonicalize()
+	}
+}
+
+func (in *DomainFeatureKVM) empty() bool {
+	return in.Hidden == nil
+}
+
+func (in *DomainFeatureList) DeepCopy() *DomainFeatureList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureList) DeepCopyInto(out *DomainFeatureList) {
+	*out = *in
+	if in.PAE != nil {
+		out.PAE = in.PAE.DeepCopy()
+	}
+	if in.ACPI != nil {
+		out.ACPI = in.ACPI.DeepCopy()
+	}
+	if in.APIC != nil {
+		out.APIC = in.APIC.DeepCopy()
+	}
+	if in.HAP != nil {
+		out.HAP = in.HAP.DeepCopy()
+	}
+	if in.Viridian != nil {
+		out.Viridian = in.Viridian.DeepCopy()
+	}
+	if in.PrivNet != nil {
+		out.PrivNet = in.PrivNet.DeepCopy()
+	}
+	if in.HyperV != nil {
+		out.HyperV = in.HyperV.DeepCopy()
+	}
+	if in.KVM != nil {
+		out.KVM = in.KVM.DeepCopy()
+	}
+	if in.PVSpinlock != nil {
+		out.PVSpinlock = in.PVSpinlock.DeepCopy()
+	}
+	if in.PMU != nil {
+		out.PMU = in.PMU.DeepCopy()
+	}
+	if in.VMPort != nil {
+		out.VMPort = in.VMPort.DeepCopy()
+	}
+	if in.GIC != nil {
+		out.GIC = in.GIC.DeepCopy()
+	}
+	if in.SMM != nil {
+		out.SMM = in.SMM.DeepCopy()
+	}
+	if in.IOAPIC != nil {
+		out.IOAPIC = in.IOAPIC.DeepCopy()
+	}
+	if in.HPT != nil {
+		out.HPT = in.HPT.DeepCopy()
+	}
+	if in.HTM != nil {
+		out.HTM = in.HTM.DeepCopy()
+	}
+	if in.Capabilities != nil {
+		out.Capabilities = in.Capabilities.DeepCopy()
+	}
+	if in.VMCoreInfo != nil {
+		out.VMCoreInfo = in.VMCoreInfo.DeepCopy()
+	}
+}
+
+func (a *DomainFeatureList) Equal(b *DomainFeatureList) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.PAE.Equal(b.PAE) {
+		return false
+	}
+	if !a.ACPI.Equal(b.ACPI) {
+		return false
+	}
+	if !a.APIC.Equal(b.APIC) {
+		return false
+	}
+	if !a.HAP.Equal(b.HAP) {
+		return false
+	}
+	if !a.Viridian.Equal(b.Viridian) {
+		return false
+	}
+	if !a.PrivNet.Equal(b.PrivNet) {
+		return false
+	}
+	if !a.HyperV.Equal(b.HyperV) {
+		return false
+	}
+	if !a.KVM.Equal(b.KVM) {
+		return false
+	}
+	if !a.PVSpinlock.Equal(b.PVSpinlock) {
+		return false
+	}
+	if !a.PMU.Equal(b.PMU) {
+		return false
+	}
+	if !a.VMPort.Equal(b.VMPort) {
+		return false
+	}
+	if !a.GIC.Equal(b.GIC) {
+		return false
+	}
+	if !a.SMM.Equal(b.SMM) {
+		return false
+	}
+	if !a.IOAPIC.Equal(b.IOAPIC) {
+		return false
+	}
+	if !a.HPT.Equal(b.HPT) {
+		return false
+	}
+	if !a.HTM.Equal(b.HTM) {
+		return false
+	}
+	if !a.Capabilities.Equal(b.Capabilities) {
+		return false
+	}
+	if !a.VMCoreInfo.Equal(b.VMCoreInfo) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureList) canonicalize() {
+	if in.PAE != nil {
+		in.PAE.canonicalize()
+	}
+	if in.ACPI != nil {
+		in.ACPI.canonicalize()
+	}
+	if in.APIC != nil {
+		in.APIC.canonicalize()
+	}
+	if in.HAP != nil {
+		in.HAP.canonicalize()
+	}
+	if in.Viridian != nil {
+		in.Viridian.canonicalize()
+	}
+	if in.PrivNet != nil {
+		in.PrivNet.canonicalize()
+	}
+	if in.HyperV != nil {
+		in.HyperV.canonicalize()
+	}
+	if in.KVM != nil {
+		in.KVM.canonicalize()
+		if in.KVM.empty() {
+			in.KVM = nil
+		}
+	}
+	if in.PVSpinlock != nil {
+		in.PVSpinlock.canonicalize()
+	}
+	if in.PMU != nil {
+		in.PMU.canonicalize()
+	}
+	if in.VMPort != nil {
+		in.VMPort.canonicalize()
+	}
+	if in.GIC != nil {
+		in.GIC.canonicalize()
+	}
+	if in.SMM != nil {
+		in.SMM.canonicalize()
+	}
+	if in.IOAPIC != nil {
+		in.IOAPIC.canonicalize()
+	}
+	if in.HPT != nil {
+		in.HPT.canonicalize()
+	}
+	if in.HTM != nil {
+		in.HTM.canonicalize()
+	}
+	if in.Capabilities != nil {
+		in.Capabilities.canonicalize()
+	}
+	if in.VMCoreInfo != nil {
+		in.VMCoreInfo.canonicalize()
+	}
+}
+
+func (in *DomainFeatureList) empty() bool {
+	return in.PAE == nil &&
+		in.ACPI == nil &&
+		in.APIC == nil &&
+		in.HAP == nil &&
+		in.Viridian == nil &&
+		in.PrivNet == nil &&
+		in.HyperV == nil &&
+		in.KVM == nil &&
+		in.PVSpinlock == nil &&
+		in.PMU == nil &&
+		in.VMPort == nil &&
+		in.GIC == nil &&
+		in.SMM == nil &&
+		in.IOAPIC == nil &&
+		in.HPT == nil &&
+		in.HTM == nil &&
+		in.Capabilities == nil &&
+		in.VMCoreInfo == nil
+}
+
+func (in *DomainFeatureSMM) DeepCopy() *DomainFeatureSMM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureSMM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureSMM) DeepCopyInto(out *DomainFeatureSMM) {
+	*out = *in
+	if in.TSeg != nil {
+		out.TSeg = in.TSeg.DeepCopy()
+	}
+}
+
+func (a *DomainFeatureSMM) Equal(b *DomainFeatureSMM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.State != b.State {
+		return false
+	}
+	if !a.TSeg.Equal(b.TSeg) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureSMM) canonicalize() {
+	if in.TSeg != nil {
+		in.TSeg.canonicalize()
+	}
+}
+
+func (in *DomainFeatureSMMTSeg) DeepCopy() *DomainFeatureSMMTSeg {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureSMMTSeg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureSMMTSeg) DeepCopyInto(out *DomainFeatureSMMTSeg) {
+	*out = *in
+}
+
+func (a *DomainFeatureSMMTSeg) Equal(b *DomainFeatureSMMTSeg) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "MiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureSMMTSeg) canonicalize() {
+	if scale, err := unitScale(in.Unit, "MiB"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainFeatureState) DeepCopy() *DomainFeatureState {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFeatureState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFeatureState) DeepCopyInto(out *DomainFeatureState) {
+	*out = *in
+}
+
+func (a *DomainFeatureState) Equal(b *DomainFeatureState) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.State != b.State {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFeatureState) canonicalize() {
+}
+
+func (in *DomainFilesystem) DeepCopy() *DomainFilesystem {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystem) DeepCopyInto(out *DomainFilesystem) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.ReadOnly != nil {
+		out.ReadOnly = in.ReadOnly.DeepCopy()
+	}
+	if in.SpaceHardLimit != nil {
+		out.SpaceHardLimit = in.SpaceHardLimit.DeepCopy()
+	}
+	if in.SpaceSoftLimit != nil {
+		out.SpaceSoftLimit = in.SpaceSoftLimit.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainFilesystem) Equal(b *DomainFilesystem) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.AccessMode != b.AccessMode {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.ReadOnly.Equal(b.ReadOnly) {
+		return false
+	}
+	if !a.SpaceHardLimit.Equal(b.SpaceHardLimit) {
+		return false
+	}
+	if !a.SpaceSoftLimit.Equal(b.SpaceSoftLimit) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystem) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.ReadOnly != nil {
+		in.ReadOnly.canonicalize()
+	}
+	if in.SpaceHardLimit != nil {
+		in.SpaceHardLimit.canonicalize()
+	}
+	if in.SpaceSoftLimit != nil {
+		in.SpaceSoftLimit.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainFilesystem) Canonicalize() *DomainFilesystem {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainFilesystem) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainFilesystem) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainFilesystem) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainFilesystem) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainFilesystem) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainFilesystem) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainFilesystem) schemaRef() (string, string) {
+	return "domain.rng", "filesystem"
+}
+
+func (in *DomainFilesystemDriver) DeepCopy() *DomainFilesystemDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemDriver) DeepCopyInto(out *DomainFilesystemDriver) {
+	*out = *in
+}
+
+func (a *DomainFilesystemDriver) Equal(b *DomainFilesystemDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Format != b.Format {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.WRPolicy != b.WRPolicy {
+		return false
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemDriver) canonicalize() {
+}
+
+func (in *DomainFilesystemReadOnly) DeepCopy() *DomainFilesystemReadOnly {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemReadOnly)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemReadOnly) DeepCopyInto(out *DomainFilesystemReadOnly) {
+	*out = *in
+}
+
+func (a *DomainFilesystemReadOnly) Equal(b *DomainFilesystemReadOnly) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainFilesystemReadOnly) canonicalize() {
+}
+
+func (in *DomainFilesystemSource) DeepCopy() *DomainFilesystemSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSource) DeepCopyInto(out *DomainFilesystemSource) {
+	*out = *in
+	if in.Mount != nil {
+		out.Mount = in.Mount.DeepCopy()
+	}
+	if in.Block != nil {
+		out.Block = in.Block.DeepCopy()
+	}
+	if in.File != nil {
+		out.File = in.File.DeepCopy()
+	}
+	if in.Template != nil {
+		out.Template = in.Template.DeepCopy()
+	}
+	if in.RAM != nil {
+		out.RAM = in.RAM.DeepCopy()
+	}
+	if in.Bind != nil {
+		out.Bind = in.Bind.DeepCopy()
+	}
+	if in.Volume != nil {
+		out.Volume = in.Volume.DeepCopy()
+	}
+}
+
+func (a *DomainFilesystemSource) Equal(b *DomainFilesystemSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Mount.Equal(b.Mount) {
+		return false
+	}
+	if !a.Block.Equal(b.Block) {
+		return false
+	}
+	if !a.File.Equal(b.File) {
+		return false
+	}
+	if !a.Template.Equal(b.Template) {
+		return false
+	}
+	if !a.RAM.Equal(b.RAM) {
+		return false
+	}
+	if !a.Bind.Equal(b.Bind) {
+		return false
+	}
+	if !a.Volume.Equal(b.Volume) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSource) canonicalize() {
+	if in.Mount != nil {
+		in.Mount.canonicalize()
+	}
+	if in.Block != nil {
+		in.Block.canonicalize()
+	}
+	if in.File != nil {
+		in.File.canonicalize()
+	}
+	if in.Template != nil {
+		in.Template.canonicalize()
+	}
+	if in.RAM != nil {
+		in.RAM.canonicalize()
+	}
+	if in.Bind != nil {
+		in.Bind.canonicalize()
+	}
+	if in.Volume != nil {
+		in.Volume.canonicalize()
+	}
+}
+
+func (in *DomainFilesystemSourceBind) DeepCopy() *DomainFilesystemSourceBind {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceBind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceBind) DeepCopyInto(out *DomainFilesystemSourceBind) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceBind) Equal(b *DomainFilesystemSourceBind) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dir != b.Dir {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceBind) canonicalize() {
+}
+
+func (in *DomainFilesystemSourceBlock) DeepCopy() *DomainFilesystemSourceBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceBlock) DeepCopyInto(out *DomainFilesystemSourceBlock) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceBlock) Equal(b *DomainFilesystemSourceBlock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceBlock) canonicalize() {
+}
+
+func (in *DomainFilesystemSourceFile) DeepCopy() *DomainFilesystemSourceFile {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceFile) DeepCopyInto(out *DomainFilesystemSourceFile) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceFile) Equal(b *DomainFilesystemSourceFile) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.File != b.File {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceFile) canonicalize() {
+}
+
+func (in *DomainFilesystemSourceMount) DeepCopy() *DomainFilesystemSourceMount {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceMount) DeepCopyInto(out *DomainFilesystemSourceMount) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceMount) Equal(b *DomainFilesystemSourceMount) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dir != b.Dir {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceMount) canonicalize() {
+}
+
+func (in *DomainFilesystemSourceRAM) DeepCopy() *DomainFilesystemSourceRAM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceRAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceRAM) DeepCopyInto(out *DomainFilesystemSourceRAM) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceRAM) Equal(b *DomainFilesystemSourceRAM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Usage != b.Usage {
+		return false
+	}
+	if a.Units != b.Units {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceRAM) canonicalize() {
+}
+
+func (in *DomainFilesystemSourceTemplate) DeepCopy() *DomainFilesystemSourceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceTemplate) DeepCopyInto(out *DomainFilesystemSourceTemplate) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceTemplate) Equal(b *DomainFilesystemSourceTemplate) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceTemplate) canonicalize() {
+}
+
+func (in *DomainFilesystemSourceVolume) DeepCopy() *DomainFilesystemSourceVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSourceVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSourceVolume) DeepCopyInto(out *DomainFilesystemSourceVolume) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSourceVolume) Equal(b *DomainFilesystemSourceVolume) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Pool != b.Pool {
+		return false
+	}
+	if a.Volume != b.Volume {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSourceVolume) canonicalize() {
+}
+
+func (in *DomainFilesystemSpaceHardLimit) DeepCopy() *DomainFilesystemSpaceHardLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSpaceHardLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSpaceHardLimit) DeepCopyInto(out *DomainFilesystemSpaceHardLimit) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSpaceHardLimit) Equal(b *DomainFilesystemSpaceHardLimit) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "B") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSpaceHardLimit) canonicalize() {
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *DomainFilesystemSpaceSoftLimit) DeepCopy() *DomainFilesystemSpaceSoftLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemSpaceSoftLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemSpaceSoftLimit) DeepCopyInto(out *DomainFilesystemSpaceSoftLimit) {
+	*out = *in
+}
+
+func (a *DomainFilesystemSpaceSoftLimit) Equal(b *DomainFilesystemSpaceSoftLimit) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "B") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemSpaceSoftLimit) canonicalize() {
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *DomainFilesystemTarget) DeepCopy() *DomainFilesystemTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainFilesystemTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainFilesystemTarget) DeepCopyInto(out *DomainFilesystemTarget) {
+	*out = *in
+}
+
+func (a *DomainFilesystemTarget) Equal(b *DomainFilesystemTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dir != b.Dir {
+		return false
+	}
+	return true
+}
+
+func (in *DomainFilesystemTarget) canonicalize() {
+}
+
+func (in *DomainGenID) DeepCopy() *DomainGenID {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGenID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGenID) DeepCopyInto(out *DomainGenID) {
+	*out = *in
+}
+
+func (a *DomainGenID) Equal(b *DomainGenID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGenID) canonicalize() {
+}
+
+func (in *DomainGraphic) DeepCopy() *DomainGraphic {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphic) DeepCopyInto(out *DomainGraphic) {
+	*out = *in
+	if in.SDL != nil {
+		out.SDL = in.SDL.DeepCopy()
+	}
+	if in.VNC != nil {
+		out.VNC = in.VNC.DeepCopy()
+	}
+	if in.RDP != nil {
+		out.RDP = in.RDP.DeepCopy()
+	}
+	if in.Desktop != nil {
+		out.Desktop = in.Desktop.DeepCopy()
+	}
+	if in.Spice != nil {
+		out.Spice = in.Spice.DeepCopy()
+	}
+	if in.EGLHeadless != nil {
+		out.EGLHeadless = in.EGLHeadless.DeepCopy()
+	}
+}
+
+func (a *DomainGraphic) Equal(b *DomainGraphic) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SDL.Equal(b.SDL) {
+		return false
+	}
+	if !a.VNC.Equal(b.VNC) {
+		return false
+	}
+	if !a.RDP.Equal(b.RDP) {
+		return false
+	}
+	if !a.Desktop.Equal(b.Desktop) {
+		return false
+	}
+	if !a.Spice.Equal(b.Spice) {
+		return false
+	}
+	if !a.EGLHeadless.Equal(b.EGLHeadless) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphic) canonicalize() {
+	if in.SDL != nil {
+		in.SDL.canonicalize()
+	}
+	if in.VNC != nil {
+		in.VNC.canonicalize()
+	}
+	if in.RDP != nil {
+		in.RDP.canonicalize()
+	}
+	if in.Desktop != nil {
+		in.Desktop.canonicalize()
+	}
+	if in.Spice != nil {
+		in.Spice.canonicalize()
+	}
+	if in.EGLHeadless != nil {
+		in.EGLHeadless.canonicalize()
+	}
+}
+
+func (d *DomainGraphic) Canonicalize() *DomainGraphic {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainGraphic) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainGraphic) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainGraphic) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainGraphic) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainGraphic) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainGraphic) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainGraphic) schemaRef() (string, string) {
+	return "domain.rng", "graphic"
+}
+
+func (in *DomainGraphicChannel) DeepCopy() *DomainGraphicChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicChannel) DeepCopyInto(out *DomainGraphicChannel) {
+	*out = *in
+}
+
+func (a *DomainGraphicChannel) Equal(b *DomainGraphicChannel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicChannel) canonicalize() {
+}
+
+func (in *DomainGraphicDesktop) DeepCopy() *DomainGraphicDesktop {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicDesktop)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicDesktop) DeepCopyInto(out *DomainGraphicDesktop) {
+	*out = *in
+}
+
+func (a *DomainGraphicDesktop) Equal(b *DomainGraphicDesktop) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Display != b.Display {
+		return false
+	}
+	if a.FullScreen != b.FullScreen {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicDesktop) canonicalize() {
+}
+
+func (in *DomainGraphicEGLHeadless) DeepCopy() *DomainGraphicEGLHeadless {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicEGLHeadless)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicEGLHeadless) DeepCopyInto(out *DomainGraphicEGLHeadless) {
+	*out = *in
+}
+
+func (a *DomainGraphicEGLHeadless) Equal(b *DomainGraphicEGLHeadless) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainGraphicEGLHeadless) canonicalize() {
+}
+
+func (in *DomainGraphicFileTransfer) DeepCopy() *DomainGraphicFileTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicFileTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicFileTransfer) DeepCopyInto(out *DomainGraphicFileTransfer) {
+	*out = *in
+}
+
+func (a *DomainGraphicFileTransfer) Equal(b *DomainGraphicFileTransfer) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enable != b.Enable {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicFileTransfer) canonicalize() {
+}
+
+func (in *DomainGraphicListener) DeepCopy() *DomainGraphicListener {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicListener) DeepCopyInto(out *DomainGraphicListener) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+	if in.Network != nil {
+		out.Network = in.Network.DeepCopy()
+	}
+	if in.Socket != nil {
+		out.Socket = in.Socket.DeepCopy()
+	}
+}
+
+func (a *DomainGraphicListener) Equal(b *DomainGraphicListener) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	if !a.Network.Equal(b.Network) {
+		return false
+	}
+	if !a.Socket.Equal(b.Socket) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicListener) canonicalize() {
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+	if in.Network != nil {
+		in.Network.canonicalize()
+	}
+	if in.Socket != nil {
+		in.Socket.canonicalize()
+	}
+}
+
+func (in *DomainGraphicListenerAddress) DeepCopy() *DomainGraphicListenerAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicListenerAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicListenerAddress) DeepCopyInto(out *DomainGraphicListenerAddress) {
+	*out = *in
+}
+
+func (a *DomainGraphicListenerAddress) Equal(b *DomainGraphicListenerAddress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicListenerAddress) canonicalize() {
+}
+
+func (in *DomainGraphicListenerNetwork) DeepCopy() *DomainGraphicListenerNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicListenerNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicListenerNetwork) DeepCopyInto(out *DomainGraphicListenerNetwork) {
+	*out = *in
+}
+
+func (a *DomainGraphicListenerNetwork) Equal(b *DomainGraphicListenerNetwork) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Network != b.Network {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicListenerNetwork) canonicalize() {
+}
+
+func (in *DomainGraphicListenerSocket) DeepCopy() *DomainGraphicListenerSocket {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicListenerSocket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicListenerSocket) DeepCopyInto(out *DomainGraphicListenerSocket) {
+	*out = *in
+}
+
+func (a *DomainGraphicListenerSocket) Equal(b *DomainGraphicListenerSocket) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Socket != b.Socket {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicListenerSocket) canonicalize() {
+}
+
+func (in *DomainGraphicRDP) DeepCopy() *DomainGraphicRDP {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicRDP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicRDP) DeepCopyInto(out *DomainGraphicRDP) {
+	*out = *in
+	if in.Listeners != nil {
+		out.Listeners = make([]DomainGraphicListener, len(in.Listeners))
+		for i := range in.Listeners {
+			in.Listeners[i].DeepCopyInto(&out.Listeners[i])
+		}
+	}
+}
+
+func (a *DomainGraphicRDP) Equal(b *DomainGraphicRDP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if a.AutoPort != b.AutoPort {
+		return false
+	}
+	if a.ReplaceUser != b.ReplaceUser {
+		return false
+	}
+	if a.MultiUser != b.MultiUser {
+		return false
+	}
+	if a.Listen != b.Listen {
+		return false
+	}
+	if len(a.Listeners) != len(b.Listeners) {
+		return false
+	}
+	for i := range a.Listeners {
+		if !a.Listeners[i].Equal(&b.Listeners[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainGraphicRDP) canonicalize() {
+	for i := range in.Listeners {
+		in.Listeners[i].canonicalize()
+	}
+	if len(in.Listeners) == 0 {
+		in.Listeners = nil
+	}
+}
+
+func (in *DomainGraphicSDL) DeepCopy() *DomainGraphicSDL {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSDL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSDL) DeepCopyInto(out *DomainGraphicSDL) {
+	*out = *in
+	if in.GL != nil {
+		out.GL = in.GL.DeepCopy()
+	}
+}
+
+func (a *DomainGraphicSDL) Equal(b *DomainGraphicSDL) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Display != b.Display {
+		return false
+	}
+	if a.XAuth != b.XAuth {
+		return false
+	}
+	if a.FullScreen != b.FullScreen {
+		return false
+	}
+	if !a.GL.Equal(b.GL) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSDL) canonicalize() {
+	if in.GL != nil {
+		in.GL.canonicalize()
+	}
+}
+
+func (in *DomainGraphicSpice) DeepCopy() *DomainGraphicSpice {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpice) DeepCopyInto(out *DomainGraphicSpice) {
+	*out = *in
+	if in.Listeners != nil {
+		out.Listeners = make([]DomainGraphicListener, len(in.Listeners))
+		for i := range in.Listeners {
+			in.Listeners[i].DeepCopyInto(&out.Listeners[i])
+		}
+	}
+	if in.Channel != nil {
+		out.Channel = make([]DomainGraphicSpiceChannel, len(in.Channel))
+		for i := range in.Channel {
+			in.Channel[i].DeepCopyInto(&out.Channel[i])
+		}
+	}
+	if in.Image != nil {
+		out.Image = in.Image.DeepCopy()
+	}
+	if in.JPEG != nil {
+		out.JPEG = in.JPEG.DeepCopy()
+	}
+	if in.ZLib != nil {
+		out.ZLib = in.ZLib.DeepCopy()
+	}
+	if in.Playback != nil {
+		out.Playback = in.Playback.DeepCopy()
+	}
+	if in.Streaming != nil {
+		out.Streaming = in.Streaming.DeepCopy()
+	}
+	if in.Mouse != nil {
+		out.Mouse = in.Mouse.DeepCopy()
+	}
+	if in.ClipBoard != nil {
+		out.ClipBoard = in.ClipBoard.DeepCopy()
+	}
+	if in.FileTransfer != nil {
+		out.FileTransfer = in.FileTransfer.DeepCopy()
+	}
+	if in.GL != nil {
+		out.GL = in.GL.DeepCopy()
+	}
+}
+
+func (a *DomainGraphicSpice) Equal(b *DomainGraphicSpice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if a.TLSPort != b.TLSPort {
+		return false
+	}
+	if a.AutoPort != b.AutoPort {
+		return false
+	}
+	if a.Listen != b.Listen {
+		return false
+	}
+	if a.Keymap != b.Keymap {
+		return false
+	}
+	if a.DefaultMode != b.DefaultMode {
+		return false
+	}
+	if a.Passwd != b.Passwd {
+		return false
+	}
+	if a.PasswdValidTo != b.PasswdValidTo {
+		return false
+	}
+	if a.Connected != b.Connected {
+		return false
+	}
+	if len(a.Listeners) != len(b.Listeners) {
+		return false
+	}
+	for i := range a.Listeners {
+		if !a.Listeners[i].Equal(&b.Listeners[i]) {
+			return false
+		}
+	}
+	if len(a.Channel) != len(b.Channel) {
+		return false
+	}
+	for i := range a.Channel {
+		if !a.Channel[i].Equal(&b.Channel[i]) {
+			return false
+		}
+	}
+	if !a.Image.Equal(b.Image) {
+		return false
+	}
+	if !a.JPEG.Equal(b.JPEG) {
+		return false
+	}
+	if !a.ZLib.Equal(b.ZLib) {
+		return false
+	}
+	if !a.Playback.Equal(b.Playback) {
+		return false
+	}
+	if !a.Streaming.Equal(b.Streaming) {
+		return false
+	}
+	if !a.Mouse.Equal(b.Mouse) {
+		return false
+	}
+	if !a.ClipBoard.Equal(b.ClipBoard) {
+		return false
+	}
+	if !a.FileTransfer.Equal(b.FileTransfer) {
+		return false
+	}
+	if !a.GL.Equal(b.GL) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpice) canonicalize() {
+	for i := range in.Listeners {
+		in.Listeners[i].canonicalize()
+	}
+	if len(in.Listeners) == 0 {
+		in.Listeners = nil
+	}
+	for i := range in.Channel {
+		in.Channel[i].canonicalize()
+	}
+	if len(in.Channel) == 0 {
+		in.Channel = nil
+	}
+	if in.Image != nil {
+		in.Image.canonicalize()
+	}
+	if in.JPEG != nil {
+		in.JPEG.canonicalize()
+	}
+	if in.ZLib != nil {
+		in.ZLib.canonicalize()
+	}
+	if in.Playback != nil {
+		in.Playback.canonicalize()
+	}
+	if in.Streaming != nil {
+		in.Streaming.canonicalize()
+	}
+	if in.Mouse != nil {
+		in.Mouse.canonicalize()
+	}
+	if in.ClipBoard != nil {
+		in.ClipBoard.canonicalize()
+	}
+	if in.FileTransfer != nil {
+		in.FileTransfer.canonicalize()
+	}
+	if in.GL != nil {
+		in.GL.canonicalize()
+	}
+}
+
+func (in *DomainGraphicSpiceChannel) DeepCopy() *DomainGraphicSpiceChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceChannel) DeepCopyInto(out *DomainGraphicSpiceChannel) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceChannel) Equal(b *DomainGraphicSpiceChannel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceChannel) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceClipBoard) DeepCopy() *DomainGraphicSpiceClipBoard {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceClipBoard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceClipBoard) DeepCopyInto(out *DomainGraphicSpiceClipBoard) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceClipBoard) Equal(b *DomainGraphicSpiceClipBoard) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.CopyPaste != b.CopyPaste {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceClipBoard) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceFileTransfer) DeepCopy() *DomainGraphicSpiceFileTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceFileTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceFileTransfer) DeepCopyInto(out *DomainGraphicSpiceFileTransfer) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceFileTransfer) Equal(b *DomainGraphicSpiceFileTransfer) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enable != b.Enable {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceFileTransfer) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceGL) DeepCopy() *DomainGraphicSpiceGL {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceGL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceGL) DeepCopyInto(out *DomainGraphicSpiceGL) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceGL) Equal(b *DomainGraphicSpiceGL) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enable != b.Enable {
+		return false
+	}
+	if a.RenderNode != b.RenderNode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceGL) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceImage) DeepCopy() *DomainGraphicSpiceImage {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceImage) DeepCopyInto(out *DomainGraphicSpiceImage) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceImage) Equal(b *DomainGraphicSpiceImage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Compression != b.Compression {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceImage) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceJPEG) DeepCopy() *DomainGraphicSpiceJPEG {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceJPEG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceJPEG) DeepCopyInto(out *DomainGraphicSpiceJPEG) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceJPEG) Equal(b *DomainGraphicSpiceJPEG) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Compression != b.Compression {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceJPEG) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceMouse) DeepCopy() *DomainGraphicSpiceMouse {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceMouse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceMouse) DeepCopyInto(out *DomainGraphicSpiceMouse) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceMouse) Equal(b *DomainGraphicSpiceMouse) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceMouse) canonicalize() {
+}
+
+func (in *DomainGraphicSpicePlayback) DeepCopy() *DomainGraphicSpicePlayback {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpicePlayback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpicePlayback) DeepCopyInto(out *DomainGraphicSpicePlayback) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpicePlayback) Equal(b *DomainGraphicSpicePlayback) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Compression != b.Compression {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpicePlayback) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceStreaming) DeepCopy() *DomainGraphicSpiceStreaming {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceStreaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceStreaming) DeepCopyInto(out *DomainGraphicSpiceStreaming) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceStreaming) Equal(b *DomainGraphicSpiceStreaming) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceStreaming) canonicalize() {
+}
+
+func (in *DomainGraphicSpiceZLib) DeepCopy() *DomainGraphicSpiceZLib {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicSpiceZLib)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicSpiceZLib) DeepCopyInto(out *DomainGraphicSpiceZLib) {
+	*out = *in
+}
+
+func (a *DomainGraphicSpiceZLib) Equal(b *DomainGraphicSpiceZLib) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Compression != b.Compression {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicSpiceZLib) canonicalize() {
+}
+
+func (in *DomainGraphicVNC) DeepCopy() *DomainGraphicVNC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicVNC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicVNC) DeepCopyInto(out *DomainGraphicVNC) {
+	*out = *in
+	if in.Listeners != nil {
+		out.Listeners = make([]DomainGraphicListener, len(in.Listeners))
+		for i := range in.Listeners {
+			in.Listeners[i].DeepCopyInto(&out.Listeners[i])
+		}
+	}
+}
+
+func (a *DomainGraphicVNC) Equal(b *DomainGraphicVNC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Socket != b.Socket {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if a.AutoPort != b.AutoPort {
+		return false
+	}
+	if a.WebSocket != b.WebSocket {
+		return false
+	}
+	if a.Keymap != b.Keymap {
+		return false
+	}
+	if a.SharePolicy != b.SharePolicy {
+		return false
+	}
+	if a.Passwd != b.Passwd {
+		return false
+	}
+	if a.PasswdValidTo != b.PasswdValidTo {
+		return false
+	}
+	if a.Connected != b.Connected {
+		return false
+	}
+	if a.Listen != b.Listen {
+		return false
+	}
+	if len(a.Listeners) != len(b.Listeners) {
+		return false
+	}
+	for i := range a.Listeners {
+		if !a.Listeners[i].Equal(&b.Listeners[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainGraphicVNC) canonicalize() {
+	for i := range in.Listeners {
+		in.Listeners[i].canonicalize()
+	}
+	if len(in.Listeners) == 0 {
+		in.Listeners = nil
+	}
+}
+
+func (in *DomainGraphicsSDLGL) DeepCopy() *DomainGraphicsSDLGL {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainGraphicsSDLGL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainGraphicsSDLGL) DeepCopyInto(out *DomainGraphicsSDLGL) {
+	*out = *in
+}
+
+func (a *DomainGraphicsSDLGL) Equal(b *DomainGraphicsSDLGL) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enable != b.Enable {
+		return false
+	}
+	return true
+}
+
+func (in *DomainGraphicsSDLGL) canonicalize() {
+}
+
+func (in *DomainHostdev) DeepCopy() *DomainHostdev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdev) DeepCopyInto(out *DomainHostdev) {
+	*out = *in
+	if in.SubsysUSB != nil {
+		out.SubsysUSB = in.SubsysUSB.DeepCopy()
+	}
+	if in.SubsysSCSI != nil {
+		out.SubsysSCSI = in.SubsysSCSI.DeepCopy()
+	}
+	if in.SubsysSCSIHost != nil {
+		out.SubsysSCSIHost = in.SubsysSCSIHost.DeepCopy()
+	}
+	if in.SubsysPCI != nil {
+		out.SubsysPCI = in.SubsysPCI.DeepCopy()
+	}
+	if in.SubsysMDev != nil {
+		out.SubsysMDev = in.SubsysMDev.DeepCopy()
+	}
+	if in.CapsStorage != nil {
+		out.CapsStorage = in.CapsStorage.DeepCopy()
+	}
+	if in.CapsMisc != nil {
+		out.CapsMisc = in.CapsMisc.DeepCopy()
+	}
+	if in.CapsNet != nil {
+		out.CapsNet = in.CapsNet.DeepCopy()
+	}
+	if in.Boot != nil {
+		out.Boot = in.Boot.DeepCopy()
+	}
+	if in.ROM != nil {
+		out.ROM = in.ROM.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainHostdev) Equal(b *DomainHostdev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalStringDefault(a.Managed, b.Managed, "no") {
+		return false
+	}
+	if !a.SubsysUSB.Equal(b.SubsysUSB) {
+		return false
+	}
+	if !a.SubsysSCSI.Equal(b.SubsysSCSI) {
+		return false
+	}
+	if !a.SubsysSCSIHost.Equal(b.SubsysSCSIHost) {
+		return false
+	}
+	if !a.SubsysPCI.Equal(b.SubsysPCI) {
+		return false
+	}
+	if !a.SubsysMDev.Equal(b.SubsysMDev) {
+		return false
+	}
+	if !a.CapsStorage.Equal(b.CapsStorage) {
+		return false
+	}
+	if !a.CapsMisc.Equal(b.CapsMisc) {
+		return false
+	}
+	if !a.CapsNet.Equal(b.CapsNet) {
+		return false
+	}
+	if !a.Boot.Equal(b.Boot) {
+		return false
+	}
+	if !a.ROM.Equal(b.ROM) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdev) canonicalize() {
+	if in.Managed == "" {
+		in.Managed = "no"
+	}
+	if in.SubsysUSB != nil {
+		in.SubsysUSB.canonicalize()
+	}
+	if in.SubsysSCSI != nil {
+		in.SubsysSCSI.canonicalize()
+	}
+	if in.SubsysSCSIHost != nil {
+		in.SubsysSCSIHost.canonicalize()
+	}
+	if in.SubsysPCI != nil {
+		in.SubsysPCI.canonicalize()
+	}
+	if in.SubsysMDev != nil {
+		in.SubsysMDev.canonicalize()
+	}
+	if in.CapsStorage != nil {
+		in.CapsStorage.canonicalize()
+	}
+	if in.CapsMisc != nil {
+		in.CapsMisc.canonicalize()
+	}
+	if in.CapsNet != nil {
+		in.CapsNet.canonicalize()
+	}
+	if in.Boot != nil {
+		in.Boot.canonicalize()
+	}
+	if in.ROM != nil {
+		in.ROM.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainHostdev) Canonicalize() *DomainHostdev {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainHostdev) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainHostdev) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainHostdev) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainHostdev) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainHostdev) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainHostdev) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainHostdev) schemaRef() (string, string) {
+	return "domain.rng", "hostdev"
+}
+
+func (in *DomainHostdevCapsMisc) DeepCopy() *DomainHostdevCapsMisc {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevCapsMisc)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevCapsMisc) DeepCopyInto(out *DomainHostdevCapsMisc) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevCapsMisc) Equal(b *DomainHostdevCapsMisc) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevCapsMisc) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (in *DomainHostdevCapsMisc) empty() bool {
+	return in.Source == nil
+}
+
+func (in *DomainHostdevCapsMiscSource) DeepCopy() *DomainHostdevCapsMiscSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevCapsMiscSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevCapsMiscSource) DeepCopyInto(out *DomainHostdevCapsMiscSource) {
+	*out = *in
+}
+
+func (a *DomainHostdevCapsMiscSource) Equal(b *DomainHostdevCapsMiscSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Char != b.Char {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevCapsMiscSource) canonicalize() {
+}
+
+func (in *DomainHostdevCapsNet) DeepCopy() *DomainHostdevCapsNet {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevCapsNet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevCapsNet) DeepCopyInto(out *DomainHostdevCapsNet) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.IP != nil {
+		out.IP = make([]DomainIP, len(in.IP))
+		for i := range in.IP {
+			in.IP[i].DeepCopyInto(&out.IP[i])
+		}
+	}
+	if in.Route != nil {
+		out.Route = make([]DomainRoute, len(in.Route))
+		for i := range in.Route {
+			in.Route[i].DeepCopyInto(&out.Route[i])
+		}
+	}
+}
+
+func (a *DomainHostdevCapsNet) Equal(b *DomainHostdevCapsNet) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if len(a.IP) != len(b.IP) {
+		return false
+	}
+	for i := range a.IP {
+		if !a.IP[i].Equal(&b.IP[i]) {
+			return false
+		}
+	}
+	if len(a.Route) != len(b.Route) {
+		return false
+	}
+	for i := range a.Route {
+		if !a.Route[i].Equal(&b.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainHostdevCapsNet) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	for i := range in.IP {
+		in.IP[i].canonicalize()
+	}
+	if len(in.IP) == 0 {
+		in.IP = nil
+	}
+	for i := range in.Route {
+		in.Route[i].canonicalize()
+	}
+	if len(in.Route) == 0 {
+		in.Route = nil
+	}
+}
+
+func (in *DomainHostdevCapsNet) empty() bool {
+	return in.Source == nil &&
+		len(in.IP) == 0 &&
+		len(in.Route) == 0
+}
+
+func (in *DomainHostdevCapsNetSource) DeepCopy() *DomainHostdevCapsNetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevCapsNetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevCapsNetSource) DeepCopyInto(out *DomainHostdevCapsNetSource) {
+	*out = *in
+}
+
+func (a *DomainHostdevCapsNetSource) Equal(b *DomainHostdevCapsNetSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Interface != b.Interface {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevCapsNetSource) canonicalize() {
+}
+
+func (in *DomainHostdevCapsStorage) DeepCopy() *DomainHostdevCapsStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevCapsStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevCapsStorage) DeepCopyInto(out *DomainHostdevCapsStorage) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevCapsStorage) Equal(b *DomainHostdevCapsStorage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevCapsStorage) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (in *DomainHostdevCapsStorage) empty() bool {
+	return in.Source == nil
+}
+
+func (in *DomainHostdevCapsStorageSource) DeepCopy() *DomainHostdevCapsStorageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevCapsStorageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevCapsStorageSource) DeepCopyInto(out *DomainHostdevCapsStorageSource) {
+	*out = *in
+}
+
+func (a *DomainHostdevCapsStorageSource) Equal(b *DomainHostdevCapsStorageSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Block != b.Block {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevCapsStorageSource) canonicalize() {
+}
+
+func (in *DomainHostdevSubsysMDev) DeepCopy() *DomainHostdevSubsysMDev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysMDev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysMDev) DeepCopyInto(out *DomainHostdevSubsysMDev) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysMDev) Equal(b *DomainHostdevSubsysMDev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.Display != b.Display {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysMDev) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+		if in.Source.empty() {
+			in.Source = nil
+		}
+	}
+}
+
+func (in *DomainHostdevSubsysMDevSource) DeepCopy() *DomainHostdevSubsysMDevSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysMDevSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysMDevSource) DeepCopyInto(out *DomainHostdevSubsysMDevSource) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysMDevSource) Equal(b *DomainHostdevSubsysMDevSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysMDevSource) canonicalize() {
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysMDevSource) empty() bool {
+	return in.Address == nil
+}
+
+func (in *DomainHostdevSubsysPCI) DeepCopy() *DomainHostdevSubsysPCI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysPCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysPCI) DeepCopyInto(out *DomainHostdevSubsysPCI) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysPCI) Equal(b *DomainHostdevSubsysPCI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysPCI) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+		if in.Source.empty() {
+			in.Source = nil
+		}
+	}
+}
+
+func (in *DomainHostdevSubsysPCI) empty() bool {
+	return in.Driver == nil &&
+		in.Source == nil
+}
+
+func (in *DomainHostdevSubsysPCIDriver) DeepCopy() *DomainHostdevSubsysPCIDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysPCIDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysPCIDriver) DeepCopyInto(out *DomainHostdevSubsysPCIDriver) {
+	*out = *in
+}
+
+func (a *DomainHostdevSubsysPCIDriver) Equal(b *DomainHostdevSubsysPCIDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysPCIDriver) canonicalize() {
+}
+
+func (in *DomainHostdevSubsysPCISource) DeepCopy() *DomainHostdevSubsysPCISource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysPCISource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysPCISource) DeepCopyInto(out *DomainHostdevSubsysPCISource) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysPCISource) Equal(b *DomainHostdevSubsysPCISource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysPCISource) canonicalize() {
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysPCISource) empty() bool {
+	return in.Address == nil
+}
+
+func (in *DomainHostdevSubsysSCSI) DeepCopy() *DomainHostdevSubsysSCSI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSI) DeepCopyInto(out *DomainHostdevSubsysSCSI) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.ReadOnly != nil {
+		out.ReadOnly = in.ReadOnly.DeepCopy()
+	}
+	if in.Shareable != nil {
+		out.Shareable = in.Shareable.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysSCSI) Equal(b *DomainHostdevSubsysSCSI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.SGIO != b.SGIO {
+		return false
+	}
+	if a.RawIO != b.RawIO {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.ReadOnly.Equal(b.ReadOnly) {
+		return false
+	}
+	if !a.Shareable.Equal(b.Shareable) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSI) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.ReadOnly != nil {
+		in.ReadOnly.canonicalize()
+	}
+	if in.Shareable != nil {
+		in.Shareable.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysSCSIAdapter) DeepCopy() *DomainHostdevSubsysSCSIAdapter {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSIAdapter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSIAdapter) DeepCopyInto(out *DomainHostdevSubsysSCSIAdapter) {
+	*out = *in
+}
+
+func (a *DomainHostdevSubsysSCSIAdapter) Equal(b *DomainHostdevSubsysSCSIAdapter) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSIAdapter) canonicalize() {
+}
+
+func (in *DomainHostdevSubsysSCSIHost) DeepCopy() *DomainHostdevSubsysSCSIHost {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSIHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSIHost) DeepCopyInto(out *DomainHostdevSubsysSCSIHost) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysSCSIHost) Equal(b *DomainHostdevSubsysSCSIHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSIHost) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysSCSIHost) empty() bool {
+	return in.Source == nil
+}
+
+func (in *DomainHostdevSubsysSCSIHostSource) DeepCopy() *DomainHostdevSubsysSCSIHostSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSIHostSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSIHostSource) DeepCopyInto(out *DomainHostdevSubsysSCSIHostSource) {
+	*out = *in
+}
+
+func (a *DomainHostdevSubsysSCSIHostSource) Equal(b *DomainHostdevSubsysSCSIHostSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Protocol != b.Protocol {
+		return false
+	}
+	if a.WWPN != b.WWPN {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSIHostSource) canonicalize() {
+}
+
+func (in *DomainHostdevSubsysSCSISource) DeepCopy() *DomainHostdevSubsysSCSISource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSISource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSISource) DeepCopyInto(out *DomainHostdevSubsysSCSISource) {
+	*out = *in
+	if in.Host != nil {
+		out.Host = in.Host.DeepCopy()
+	}
+	if in.ISCSI != nil {
+		out.ISCSI = in.ISCSI.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysSCSISource) Equal(b *DomainHostdevSubsysSCSISource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Host.Equal(b.Host) {
+		return false
+	}
+	if !a.ISCSI.Equal(b.ISCSI) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSISource) canonicalize() {
+	if in.Host != nil {
+		in.Host.canonicalize()
+	}
+	if in.ISCSI != nil {
+		in.ISCSI.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysSCSISourceHost) DeepCopy() *DomainHostdevSubsysSCSISourceHost {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSISourceHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSISourceHost) DeepCopyInto(out *DomainHostdevSubsysSCSISourceHost) {
+	*out = *in
+	if in.Adapter != nil {
+		out.Adapter = in.Adapter.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysSCSISourceHost) Equal(b *DomainHostdevSubsysSCSISourceHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Adapter.Equal(b.Adapter) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSISourceHost) canonicalize() {
+	if in.Adapter != nil {
+		in.Adapter.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysSCSISourceHost) empty() bool {
+	return in.Adapter == nil &&
+		in.Address == nil
+}
+
+func (in *DomainHostdevSubsysSCSISourceISCSI) DeepCopy() *DomainHostdevSubsysSCSISourceISCSI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysSCSISourceISCSI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysSCSISourceISCSI) DeepCopyInto(out *DomainHostdevSubsysSCSISourceISCSI) {
+	*out = *in
+	if in.Host != nil {
+		out.Host = make([]DomainDiskSourceHost, len(in.Host))
+		for i := range in.Host {
+			in.Host[i].DeepCopyInto(&out.Host[i])
+		}
+	}
+	if in.Auth != nil {
+		out.Auth = in.Auth.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysSCSISourceISCSI) Equal(b *DomainHostdevSubsysSCSISourceISCSI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if len(a.Host) != len(b.Host) {
+		return false
+	}
+	for i := range a.Host {
+		if !a.Host[i].Equal(&b.Host[i]) {
+			return false
+		}
+	}
+	if !a.Auth.Equal(b.Auth) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysSCSISourceISCSI) canonicalize() {
+	for i := range in.Host {
+		in.Host[i].canonicalize()
+	}
+	if len(in.Host) == 0 {
+		in.Host = nil
+	}
+	if in.Auth != nil {
+		in.Auth.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysUSB) DeepCopy() *DomainHostdevSubsysUSB {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysUSB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysUSB) DeepCopyInto(out *DomainHostdevSubsysUSB) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysUSB) Equal(b *DomainHostdevSubsysUSB) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysUSB) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+		if in.Source.empty() {
+			in.Source = nil
+		}
+	}
+}
+
+func (in *DomainHostdevSubsysUSB) empty() bool {
+	return in.Source == nil
+}
+
+func (in *DomainHostdevSubsysUSBSource) DeepCopy() *DomainHostdevSubsysUSBSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHostdevSubsysUSBSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHostdevSubsysUSBSource) DeepCopyInto(out *DomainHostdevSubsysUSBSource) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainHostdevSubsysUSBSource) Equal(b *DomainHostdevSubsysUSBSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHostdevSubsysUSBSource) canonicalize() {
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (in *DomainHostdevSubsysUSBSource) empty() bool {
+	return in.Address == nil
+}
+
+func (in *DomainHub) DeepCopy() *DomainHub {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainHub)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainHub) DeepCopyInto(out *DomainHub) {
+	*out = *in
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainHub) Equal(b *DomainHub) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainHub) canonicalize() {
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainHub) Canonicalize() *DomainHub {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainHub) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainHub) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainHub) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainHub) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainHub) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainHub) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainHub) schemaRef() (string, string) {
+	return "domain.rng", "hub"
+}
+
+func (in *DomainIDMap) DeepCopy() *DomainIDMap {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIDMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIDMap) DeepCopyInto(out *DomainIDMap) {
+	*out = *in
+	if in.UIDs != nil {
+		out.UIDs = make([]DomainIDMapRange, len(in.UIDs))
+		for i := range in.UIDs {
+			in.UIDs[i].DeepCopyInto(&out.UIDs[i])
+		}
+	}
+	if in.GIDs != nil {
+		out.GIDs = make([]DomainIDMapRange, len(in.GIDs))
+		for i := range in.GIDs {
+			in.GIDs[i].DeepCopyInto(&out.GIDs[i])
+		}
+	}
+}
+
+func (a *DomainIDMap) Equal(b *DomainIDMap) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.UIDs) != len(b.UIDs) {
+		return false
+	}
+	for i := range a.UIDs {
+		if !a.UIDs[i].Equal(&b.UIDs[i]) {
+			return false
+		}
+	}
+	if len(a.GIDs) != len(b.GIDs) {
+		return false
+	}
+	for i := range a.GIDs {
+		if !a.GIDs[i].Equal(&b.GIDs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainIDMap) canonicalize() {
+	for i := range in.UIDs {
+		in.UIDs[i].canonicalize()
+	}
+	if len(in.UIDs) == 0 {
+		in.UIDs = nil
+	}
+	for i := range in.GIDs {
+		in.GIDs[i].canonicalize()
+	}
+	if len(in.GIDs) == 0 {
+		in.GIDs = nil
+	}
+}
+
+func (in *DomainIDMap) empty() bool {
+	return len(in.UIDs) == 0 &&
+		len(in.GIDs) == 0
+}
+
+func (in *DomainIDMapRange) DeepCopy() *DomainIDMapRange {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIDMapRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIDMapRange) DeepCopyInto(out *DomainIDMapRange) {
+	*out = *in
+}
+
+func (a *DomainIDMapRange) Equal(b *DomainIDMapRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Start != b.Start {
+		return false
+	}
+	if a.Target != b.Target {
+		return false
+	}
+	if a.Count != b.Count {
+		return false
+	}
+	return true
+}
+
+func (in *DomainIDMapRange) canonicalize() {
+}
+
+func (in *DomainIOMMU) DeepCopy() *DomainIOMMU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIOMMU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIOMMU) DeepCopyInto(out *DomainIOMMU) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+}
+
+func (a *DomainIOMMU) Equal(b *DomainIOMMU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainIOMMU) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+}
+
+func (d *DomainIOMMU) Canonicalize() *DomainIOMMU {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainIOMMU) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainIOMMU) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainIOMMU) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainIOMMU) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainIOMMU) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainIOMMU) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainIOMMU) schemaRef() (string, string) {
+	return "domain.rng", "iommu"
+}
+
+func (in *DomainIOMMUDriver) DeepCopy() *DomainIOMMUDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIOMMUDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIOMMUDriver) DeepCopyInto(out *DomainIOMMUDriver) {
+	*out = *in
+}
+
+func (a *DomainIOMMUDriver) Equal(b *DomainIOMMUDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IntRemap != b.IntRemap {
+		return false
+	}
+	if a.CachingMode != b.CachingMode {
+		return false
+	}
+	if a.EIM != b.EIM {
+		return false
+	}
+	if a.IOTLB != b.IOTLB {
+		return false
+	}
+	return true
+}
+
+func (in *DomainIOMMUDriver) canonicalize() {
+}
+
+func (in *DomainIOThread) DeepCopy() *DomainIOThread {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIOThread)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIOThread) DeepCopyInto(out *DomainIOThread) {
+	*out = *in
+}
+
+func (a *DomainIOThread) Equal(b *DomainIOThread) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainIOThread) canonicalize() {
+}
+
+func (in *DomainIOThreadIDs) DeepCopy() *DomainIOThreadIDs {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIOThreadIDs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIOThreadIDs) DeepCopyInto(out *DomainIOThreadIDs) {
+	*out = *in
+	if in.IOThreads != nil {
+		out.IOThreads = make([]DomainIOThread, len(in.IOThreads))
+		for i := range in.IOThreads {
+			in.IOThreads[i].DeepCopyInto(&out.IOThreads[i])
+		}
+	}
+}
+
+func (a *DomainIOThreadIDs) Equal(b *DomainIOThreadIDs) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.IOThreads) != len(b.IOThreads) {
+		return false
+	}
+	for i := range a.IOThreads {
+		if !a.IOThreads[i].Equal(&b.IOThreads[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainIOThreadIDs) canonicalize() {
+	for i := range in.IOThreads {
+		in.IOThreads[i].canonicalize()
+	}
+	if len(in.IOThreads) == 0 {
+		in.IOThreads = nil
+	}
+}
+
+func (in *DomainIOThreadIDs) empty() bool {
+	return len(in.IOThreads) == 0
+}
+
+func (in *DomainIP) DeepCopy() *DomainIP {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainIP) DeepCopyInto(out *DomainIP) {
+	*out = *in
+	if in.Prefix != nil {
+		v := *in.Prefix
+		out.Prefix = &v
+	}
+}
+
+func (a *DomainIP) Equal(b *DomainIP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if (a.Prefix == nil) != (b.Prefix == nil) || (a.Prefix != nil && *a.Prefix != *b.Prefix) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainIP) canonicalize() {
+}
+
+func (in *DomainInput) DeepCopy() *DomainInput {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInput) DeepCopyInto(out *DomainInput) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainInput) Equal(b *DomainInput) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Bus != b.Bus {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInput) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainInput) Canonicalize() *DomainInput {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainInput) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainInput) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainInput) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainInput) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainInput) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainInput) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainInput) schemaRef() (string, string) {
+	return "domain.rng", "input"
+}
+
+func (in *DomainInputDriver) DeepCopy() *DomainInputDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInputDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInputDriver) DeepCopyInto(out *DomainInputDriver) {
+	*out = *in
+}
+
+func (a *DomainInputDriver) Equal(b *DomainInputDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInputDriver) canonicalize() {
+}
+
+func (in *DomainInputSource) DeepCopy() *DomainInputSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInputSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInputSource) DeepCopyInto(out *DomainInputSource) {
+	*out = *in
+}
+
+func (a *DomainInputSource) Equal(b *DomainInputSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.EVDev != b.EVDev {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInputSource) canonicalize() {
+}
+
+func (in *DomainInterface) DeepCopy() *DomainInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterface) DeepCopyInto(out *DomainInterface) {
+	*out = *in
+	if in.MAC != nil {
+		out.MAC = in.MAC.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Boot != nil {
+		out.Boot = in.Boot.DeepCopy()
+	}
+	if in.VLan != nil {
+		out.VLan = in.VLan.DeepCopy()
+	}
+	if in.VirtualPort != nil {
+		out.VirtualPort = in.VirtualPort.DeepCopy()
+	}
+	if in.IP != nil {
+		out.IP = make([]DomainInterfaceIP, len(in.IP))
+		for i := range in.IP {
+			in.IP[i].DeepCopyInto(&out.IP[i])
+		}
+	}
+	if in.Route != nil {
+		out.Route = make([]DomainInterfaceRoute, len(in.Route))
+		for i := range in.Route {
+			in.Route[i].DeepCopyInto(&out.Route[i])
+		}
+	}
+	if in.Script != nil {
+		out.Script = in.Script.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Guest != nil {
+		out.Guest = in.Guest.DeepCopy()
+	}
+	if in.Model != nil {
+		out.Model = in.Model.DeepCopy()
+	}
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Backend != nil {
+		out.Backend = in.Backend.DeepCopy()
+	}
+	if in.FilterRef != nil {
+		out.FilterRef = in.FilterRef.DeepCopy()
+	}
+	if in.Tune != nil {
+		out.Tune = in.Tune.DeepCopy()
+	}
+	if in.Link != nil {
+		out.Link = in.Link.DeepCopy()
+	}
+	if in.MTU != nil {
+		out.MTU = in.MTU.DeepCopy()
+	}
+	if in.Bandwidth != nil {
+		out.Bandwidth = in.Bandwidth.DeepCopy()
+	}
+	if in.Coalesce != nil {
+		out.Coalesce = in.Coalesce.DeepCopy()
+	}
+	if in.ROM != nil {
+		out.ROM = in.ROM.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainInterface) Equal(b *DomainInterface) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Managed != b.Managed {
+		return false
+	}
+	if a.TrustGuestRXFilters != b.TrustGuestRXFilters {
+		return false
+	}
+	if !a.MAC.Equal(b.MAC) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Boot.Equal(b.Boot) {
+		return false
+	}
+	if !a.VLan.Equal(b.VLan) {
+		return false
+	}
+	if !a.VirtualPort.Equal(b.VirtualPort) {
+		return false
+	}
+	if len(a.IP) != len(b.IP) {
+		return false
+	}
+	for i := range a.IP {
+		if !a.IP[i].Equal(&b.IP[i]) {
+			return false
+		}
+	}
+	if len(a.Route) != len(b.Route) {
+		return false
+	}
+	for i := range a.Route {
+		if !a.Route[i].Equal(&b.Route[i]) {
+			return false
+		}
+	}
+	if !a.Script.Equal(b.Script) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Guest.Equal(b.Guest) {
+		return false
+	}
+	if !a.Model.Equal(b.Model) {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Backend.Equal(b.Backend) {
+		return false
+	}
+	if !a.FilterRef.Equal(b.FilterRef) {
+		return false
+	}
+	if !a.Tune.Equal(b.Tune) {
+		return false
+	}
+	if !a.Link.Equal(b.Link) {
+		return false
+	}
+	if !a.MTU.Equal(b.MTU) {
+		return false
+	}
+	if !a.Bandwidth.Equal(b.Bandwidth) {
+		return false
+	}
+	if !a.Coalesce.Equal(b.Coalesce) {
+		return false
+	}
+	if !a.ROM.Equal(b.ROM) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterface) canonicalize() {
+	if in.MAC != nil {
+		in.MAC.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Boot != nil {
+		in.Boot.canonicalize()
+	}
+	if in.VLan != nil {
+		in.VLan.canonicalize()
+	}
+	if in.VirtualPort != nil {
+		in.VirtualPort.canonicalize()
+	}
+	for i := range in.IP {
+		in.IP[i].canonicalize()
+	}
+	if len(in.IP) == 0 {
+		in.IP = nil
+	}
+	for i := range in.Route {
+		in.Route[i].canonicalize()
+	}
+	if len(in.Route) == 0 {
+		in.Route = nil
+	}
+	if in.Script != nil {
+		in.Script.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Guest != nil {
+		in.Guest.canonicalize()
+	}
+	if in.Model != nil {
+		in.Model.canonicalize()
+	}
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Backend != nil {
+		in.Backend.canonicalize()
+	}
+	if in.FilterRef != nil {
+		in.FilterRef.canonicalize()
+	}
+	if in.Tune != nil {
+		in.Tune.canonicalize()
+	}
+	if in.Link != nil {
+		in.Link.canonicalize()
+	}
+	if in.MTU != nil {
+		in.MTU.canonicalize()
+	}
+	if in.Bandwidth != nil {
+		in.Bandwidth.canonicalize()
+		if in.Bandwidth.empty() {
+			in.Bandwidth = nil
+		}
+	}
+	if in.Coalesce != nil {
+		in.Coalesce.canonicalize()
+		if in.Coalesce.empty() {
+			in.Coalesce = nil
+		}
+	}
+	if in.ROM != nil {
+		in.ROM.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainInterface) Canonicalize() *DomainInterface {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainInterface) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainInterface) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainInterface) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainInterface) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainInterface) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainInterface) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainInterface) schemaRef() (string, string) {
+	return "domain.rng", "interface"
+}
+
+func (in *DomainInterfaceBackend) DeepCopy() *DomainInterfaceBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceBackend) DeepCopyInto(out *DomainInterfaceBackend) {
+	*out = *in
+}
+
+func (a *DomainInterfaceBackend) Equal(b *DomainInterfaceBackend) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Tap != b.Tap {
+		return false
+	}
+	if a.VHost != b.VHost {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceBackend) canonicalize() {
+}
+
+func (in *DomainInterfaceBandwidth) DeepCopy() *DomainInterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceBandwidth) DeepCopyInto(out *DomainInterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		out.Inbound = in.Inbound.DeepCopy()
+	}
+	if in.Outbound != nil {
+		out.Outbound = in.Outbound.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceBandwidth) Equal(b *DomainInterfaceBandwidth) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Inbound.Equal(b.Inbound) {
+		return false
+	}
+	if !a.Outbound.Equal(b.Outbound) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceBandwidth) canonicalize() {
+	if in.Inbound != nil {
+		in.Inbound.canonicalize()
+	}
+	if in.Outbound != nil {
+		in.Outbound.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceBandwidth) empty() bool {
+	return in.Inbound == nil &&
+		in.Outbound == nil
+}
+
+func (in *DomainInterfaceBandwidthParams) DeepCopy() *DomainInterfaceBandwidthParams {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceBandwidthParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceBandwidthParams) DeepCopyInto(out *DomainInterfaceBandwidthParams) {
+	*out = *in
+	if in.Average != nil {
+		v := *in.Average
+		out.Average = &v
+	}
+	if in.Peak != nil {
+		v := *in.Peak
+		out.Peak = &v
+	}
+	if in.Burst != nil {
+		v := *in.Burst
+		out.Burst = &v
+	}
+	if in.Floor != nil {
+		v := *in.Floor
+		out.Floor = &v
+	}
+}
+
+func (a *DomainInterfaceBandwidthParams) Equal(b *DomainInterfaceBandwidthParams) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Average == nil) != (b.Average == nil) || (a.Average != nil && *a.Average != *b.Average) {
+		return false
+	}
+	if (a.Peak == nil) != (b.Peak == nil) || (a.Peak != nil && *a.Peak != *b.Peak) {
+		return false
+	}
+	if (a.Burst == nil) != (b.Burst == nil) || (a.Burst != nil && *a.Burst != *b.Burst) {
+		return false
+	}
+	if (a.Floor == nil) != (b.Floor == nil) || (a.Floor != nil && *a.Floor != *b.Floor) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceBandwidthParams) canonicalize() {
+}
+
+func (in *DomainInterfaceCoalesce) DeepCopy() *DomainInterfaceCoalesce {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceCoalesce)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceCoalesce) DeepCopyInto(out *DomainInterfaceCoalesce) {
+	*out = *in
+	if in.RX != nil {
+		out.RX = in.RX.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceCoalesce) Equal(b *DomainInterfaceCoalesce) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.RX.Equal(b.RX) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceCoalesce) canonicalize() {
+	if in.RX != nil {
+		in.RX.canonicalize()
+		if in.RX.empty() {
+			in.RX = nil
+		}
+	}
+}
+
+func (in *DomainInterfaceCoalesce) empty() bool {
+	return in.RX == nil
+}
+
+func (in *DomainInterfaceCoalesceRX) DeepCopy() *DomainInterfaceCoalesceRX {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceCoalesceRX)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceCoalesceRX) DeepCopyInto(out *DomainInterfaceCoalesceRX) {
+	*out = *in
+	if in.Frames != nil {
+		out.Frames = in.Frames.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceCoalesceRX) Equal(b *DomainInterfaceCoalesceRX) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Frames.Equal(b.Frames) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceCoalesceRX) canonicalize() {
+	if in.Frames != nil {
+		in.Frames.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceCoalesceRX) empty() bool {
+	return in.Frames == nil
+}
+
+func (in *DomainInterfaceCoalesceRXFrames) DeepCopy() *DomainInterfaceCoalesceRXFrames {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceCoalesceRXFrames)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceCoalesceRXFrames) DeepCopyInto(out *DomainInterfaceCoalesceRXFrames) {
+	*out = *in
+	if in.Max != nil {
+		v := *in.Max
+		out.Max = &v
+	}
+}
+
+func (a *DomainInterfaceCoalesceRXFrames) Equal(b *DomainInterfaceCoalesceRXFrames) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Max == nil) != (b.Max == nil) || (a.Max != nil && *a.Max != *b.Max) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceCoalesceRXFrames) canonicalize() {
+}
+
+func (in *DomainInterfaceDriver) DeepCopy() *DomainInterfaceDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceDriver) DeepCopyInto(out *DomainInterfaceDriver) {
+	*out = *in
+	if in.Host != nil {
+		out.Host = in.Host.DeepCopy()
+	}
+	if in.Guest != nil {
+		out.Guest = in.Guest.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceDriver) Equal(b *DomainInterfaceDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.TXMode != b.TXMode {
+		return false
+	}
+	if a.IOEventFD != b.IOEventFD {
+		return false
+	}
+	if a.EventIDX != b.EventIDX {
+		return false
+	}
+	if a.Queues != b.Queues {
+		return false
+	}
+	if a.RXQueueSize != b.RXQueueSize {
+		return false
+	}
+	if a.TXQueueSize != b.TXQueueSize {
+		return false
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	if !a.Host.Equal(b.Host) {
+		return false
+	}
+	if !a.Guest.Equal(b.Guest) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceDriver) canonicalize() {
+	if in.Host != nil {
+		in.Host.canonicalize()
+	}
+	if in.Guest != nil {
+		in.Guest.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceDriverGuest) DeepCopy() *DomainInterfaceDriverGuest {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceDriverGuest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceDriverGuest) DeepCopyInto(out *DomainInterfaceDriverGuest) {
+	*out = *in
+}
+
+func (a *DomainInterfaceDriverGuest) Equal(b *DomainInterfaceDriverGuest) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.CSum != b.CSum {
+		return false
+	}
+	if a.TSO4 != b.TSO4 {
+		return false
+	}
+	if a.TSO6 != b.TSO6 {
+		return false
+	}
+	if a.ECN != b.ECN {
+		return false
+	}
+	if a.UFO != b.UFO {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceDriverGuest) canonicalize() {
+}
+
+func (in *DomainInterfaceDriverHost) DeepCopy() *DomainInterfaceDriverHost {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceDriverHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceDriverHost) DeepCopyInto(out *DomainInterfaceDriverHost) {
+	*out = *in
+}
+
+func (a *DomainInterfaceDriverHost) Equal(b *DomainInterfaceDriverHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.CSum != b.CSum {
+		return false
+	}
+	if a.GSO != b.GSO {
+		return false
+	}
+	if a.TSO4 != b.TSO4 {
+		return false
+	}
+	if a.TSO6 != b.TSO6 {
+		return false
+	}
+	if a.ECN != b.ECN {
+		return false
+	}
+	if a.UFO != b.UFO {
+		return false
+	}
+	if a.MrgRXBuf != b.MrgRXBuf {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceDriverHost) canonicalize() {
+}
+
+func (in *DomainInterfaceFilterParam) DeepCopy() *DomainInterfaceFilterParam {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceFilterParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceFilterParam) DeepCopyInto(out *DomainInterfaceFilterParam) {
+	*out = *in
+}
+
+func (a *DomainInterfaceFilterParam) Equal(b *DomainInterfaceFilterParam) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceFilterParam) canonicalize() {
+}
+
+func (in *DomainInterfaceFilterRef) DeepCopy() *DomainInterfaceFilterRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceFilterRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceFilterRef) DeepCopyInto(out *DomainInterfaceFilterRef) {
+	*out = *in
+	if in.Parameters != nil {
+		out.Parameters = make([]DomainInterfaceFilterParam, len(in.Parameters))
+		for i := range in.Parameters {
+			in.Parameters[i].DeepCopyInto(&out.Parameters[i])
+		}
+	}
+}
+
+func (a *DomainInterfaceFilterRef) Equal(b *DomainInterfaceFilterRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Filter != b.Filter {
+		return false
+	}
+	if len(a.Parameters) != len(b.Parameters) {
+		return false
+	}
+	for i := range a.Parameters {
+		if !a.Parameters[i].Equal(&b.Parameters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainInterfaceFilterRef) canonicalize() {
+	for i := range in.Parameters {
+		in.Parameters[i].canonicalize()
+	}
+	if len(in.Parameters) == 0 {
+		in.Parameters = nil
+	}
+}
+
+func (in *DomainInterfaceGuest) DeepCopy() *DomainInterfaceGuest {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceGuest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceGuest) DeepCopyInto(out *DomainInterfaceGuest) {
+	*out = *in
+}
+
+func (a *DomainInterfaceGuest) Equal(b *DomainInterfaceGuest) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	if a.Actual != b.Actual {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceGuest) canonicalize() {
+}
+
+func (in *DomainInterfaceIP) DeepCopy() *DomainInterfaceIP {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceIP) DeepCopyInto(out *DomainInterfaceIP) {
+	*out = *in
+}
+
+func (a *DomainInterfaceIP) Equal(b *DomainInterfaceIP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if a.Prefix != b.Prefix {
+		return false
+	}
+	if a.Peer != b.Peer {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceIP) canonicalize() {
+}
+
+func (in *DomainInterfaceLink) DeepCopy() *DomainInterfaceLink {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceLink) DeepCopyInto(out *DomainInterfaceLink) {
+	*out = *in
+}
+
+func (a *DomainInterfaceLink) Equal(b *DomainInterfaceLink) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalStringDefault(a.State, b.State, "up") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceLink) canonicalize() {
+	if in.State == "" {
+		in.State = "up"
+	}
+}
+
+func (in *DomainInterfaceMAC) DeepCopy() *DomainInterfaceMAC {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceMAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceMAC) DeepCopyInto(out *DomainInterfaceMAC) {
+	*out = *in
+}
+
+func (a *DomainInterfaceMAC) Equal(b *DomainInterfaceMAC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceMAC) canonicalize() {
+}
+
+func (in *DomainInterfaceMTU) DeepCopy() *DomainInterfaceMTU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceMTU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceMTU) DeepCopyInto(out *DomainInterfaceMTU) {
+	*out = *in
+}
+
+func (a *DomainInterfaceMTU) Equal(b *DomainInterfaceMTU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Size != b.Size {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceMTU) canonicalize() {
+}
+
+func (in *DomainInterfaceModel) DeepCopy() *DomainInterfaceModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceModel) DeepCopyInto(out *DomainInterfaceModel) {
+	*out = *in
+}
+
+func (a *DomainInterfaceModel) Equal(b *DomainInterfaceModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceModel) canonicalize() {
+}
+
+func (in *DomainInterfaceRoute) DeepCopy() *DomainInterfaceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceRoute) DeepCopyInto(out *DomainInterfaceRoute) {
+	*out = *in
+}
+
+func (a *DomainInterfaceRoute) Equal(b *DomainInterfaceRoute) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Netmask != b.Netmask {
+		return false
+	}
+	if a.Prefix != b.Prefix {
+		return false
+	}
+	if a.Gateway != b.Gateway {
+		return false
+	}
+	if a.Metric != b.Metric {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceRoute) canonicalize() {
+}
+
+func (in *DomainInterfaceScript) DeepCopy() *DomainInterfaceScript {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceScript)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceScript) DeepCopyInto(out *DomainInterfaceScript) {
+	*out = *in
+}
+
+func (a *DomainInterfaceScript) Equal(b *DomainInterfaceScript) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceScript) canonicalize() {
+}
+
+func (in *DomainInterfaceSource) DeepCopy() *DomainInterfaceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSource) DeepCopyInto(out *DomainInterfaceSource) {
+	*out = *in
+	if in.User != nil {
+		out.User = in.User.DeepCopy()
+	}
+	if in.Ethernet != nil {
+		out.Ethernet = in.Ethernet.DeepCopy()
+	}
+	if in.VHostUser != nil {
+		out.VHostUser = in.VHostUser.DeepCopy()
+	}
+	if in.Server != nil {
+		out.Server = in.Server.DeepCopy()
+	}
+	if in.Client != nil {
+		out.Client = in.Client.DeepCopy()
+	}
+	if in.MCast != nil {
+		out.MCast = in.MCast.DeepCopy()
+	}
+	if in.Network != nil {
+		out.Network = in.Network.DeepCopy()
+	}
+	if in.Bridge != nil {
+		out.Bridge = in.Bridge.DeepCopy()
+	}
+	if in.Internal != nil {
+		out.Internal = in.Internal.DeepCopy()
+	}
+	if in.Direct != nil {
+		out.Direct = in.Direct.DeepCopy()
+	}
+	if in.Hostdev != nil {
+		out.Hostdev = in.Hostdev.DeepCopy()
+	}
+	if in.UDP != nil {
+		out.UDP = in.UDP.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceSource) Equal(b *DomainInterfaceSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.User.Equal(b.User) {
+		return false
+	}
+	if !a.Ethernet.Equal(b.Ethernet) {
+		return false
+	}
+	if !a.VHostUser.Equal(b.VHostUser) {
+		return false
+	}
+	if !a.Server.Equal(b.Server) {
+		return false
+	}
+	if !a.Client.Equal(b.Client) {
+		return false
+	}
+	if !a.MCast.Equal(b.MCast) {
+		return false
+	}
+	if !a.Network.Equal(b.Network) {
+		return false
+	}
+	if !a.Bridge.Equal(b.Bridge) {
+		return false
+	}
+	if !a.Internal.Equal(b.Internal) {
+		return false
+	}
+	if !a.Direct.Equal(b.Direct) {
+		return false
+	}
+	if !a.Hostdev.Equal(b.Hostdev) {
+		return false
+	}
+	if !a.UDP.Equal(b.UDP) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSource) canonicalize() {
+	if in.User != nil {
+		in.User.canonicalize()
+	}
+	if in.Ethernet != nil {
+		in.Ethernet.canonicalize()
+	}
+	if in.VHostUser != nil {
+		in.VHostUser.canonicalize()
+	}
+	if in.Server != nil {
+		in.Server.canonicalize()
+	}
+	if in.Client != nil {
+		in.Client.canonicalize()
+	}
+	if in.MCast != nil {
+		in.MCast.canonicalize()
+	}
+	if in.Network != nil {
+		in.Network.canonicalize()
+	}
+	if in.Bridge != nil {
+		in.Bridge.canonicalize()
+	}
+	if in.Internal != nil {
+		in.Internal.canonicalize()
+	}
+	if in.Direct != nil {
+		in.Direct.canonicalize()
+	}
+	if in.Hostdev != nil {
+		in.Hostdev.canonicalize()
+	}
+	if in.UDP != nil {
+		in.UDP.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceSourceBridge) DeepCopy() *DomainInterfaceSourceBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceBridge) DeepCopyInto(out *DomainInterfaceSourceBridge) {
+	*out = *in
+}
+
+func (a *DomainInterfaceSourceBridge) Equal(b *DomainInterfaceSourceBridge) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Bridge != b.Bridge {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceBridge) canonicalize() {
+}
+
+func (in *DomainInterfaceSourceClient) DeepCopy() *DomainInterfaceSourceClient {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceClient) DeepCopyInto(out *DomainInterfaceSourceClient) {
+	*out = *in
+	if in.Local != nil {
+		out.Local = in.Local.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceSourceClient) Equal(b *DomainInterfaceSourceClient) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if !a.Local.Equal(b.Local) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceClient) canonicalize() {
+	if in.Local != nil {
+		in.Local.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceSourceDirect) DeepCopy() *DomainInterfaceSourceDirect {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceDirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceDirect) DeepCopyInto(out *DomainInterfaceSourceDirect) {
+	*out = *in
+}
+
+func (a *DomainInterfaceSourceDirect) Equal(b *DomainInterfaceSourceDirect) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceDirect) canonicalize() {
+}
+
+func (in *DomainInterfaceSourceEthernet) DeepCopy() *DomainInterfaceSourceEthernet {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceEthernet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceEthernet) DeepCopyInto(out *DomainInterfaceSourceEthernet) {
+	*out = *in
+	if in.IP != nil {
+		out.IP = make([]DomainInterfaceIP, len(in.IP))
+		for i := range in.IP {
+			in.IP[i].DeepCopyInto(&out.IP[i])
+		}
+	}
+	if in.Route != nil {
+		out.Route = make([]DomainInterfaceRoute, len(in.Route))
+		for i := range in.Route {
+			in.Route[i].DeepCopyInto(&out.Route[i])
+		}
+	}
+}
+
+func (a *DomainInterfaceSourceEthernet) Equal(b *DomainInterfaceSourceEthernet) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.IP) != len(b.IP) {
+		return false
+	}
+	for i := range a.IP {
+		if !a.IP[i].Equal(&b.IP[i]) {
+			return false
+		}
+	}
+	if len(a.Route) != len(b.Route) {
+		return false
+	}
+	for i := range a.Route {
+		if !a.Route[i].Equal(&b.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceEthernet) canonicalize() {
+	for i := range in.IP {
+		in.IP[i].canonicalize()
+	}
+	if len(in.IP) == 0 {
+		in.IP = nil
+	}
+	for i := range in.Route {
+		in.Route[i].canonicalize()
+	}
+	if len(in.Route) == 0 {
+		in.Route = nil
+	}
+}
+
+func (in *DomainInterfaceSourceEthernet) empty() bool {
+	return len(in.IP) == 0 &&
+		len(in.Route) == 0
+}
+
+func (in *DomainInterfaceSourceHostdev) DeepCopy() *DomainInterfaceSourceHostdev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceHostdev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceHostdev) DeepCopyInto(out *DomainInterfaceSourceHostdev) {
+	*out = *in
+	if in.PCI != nil {
+		out.PCI = in.PCI.DeepCopy()
+	}
+	if in.USB != nil {
+		out.USB = in.USB.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceSourceHostdev) Equal(b *DomainInterfaceSourceHostdev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.PCI.Equal(b.PCI) {
+		return false
+	}
+	if !a.USB.Equal(b.USB) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceHostdev) canonicalize() {
+	if in.PCI != nil {
+		in.PCI.canonicalize()
+	}
+	if in.USB != nil {
+		in.USB.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceSourceInternal) DeepCopy() *DomainInterfaceSourceInternal {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceInternal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceInternal) DeepCopyInto(out *DomainInterfaceSourceInternal) {
+	*out = *in
+}
+
+func (a *DomainInterfaceSourceInternal) Equal(b *DomainInterfaceSourceInternal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceInternal) canonicalize() {
+}
+
+func (in *DomainInterfaceSourceLocal) DeepCopy() *DomainInterfaceSourceLocal {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceLocal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceLocal) DeepCopyInto(out *DomainInterfaceSourceLocal) {
+	*out = *in
+}
+
+func (a *DomainInterfaceSourceLocal) Equal(b *DomainInterfaceSourceLocal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceLocal) canonicalize() {
+}
+
+func (in *DomainInterfaceSourceMCast) DeepCopy() *DomainInterfaceSourceMCast {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceMCast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceMCast) DeepCopyInto(out *DomainInterfaceSourceMCast) {
+	*out = *in
+	if in.Local != nil {
+		out.Local = in.Local.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceSourceMCast) Equal(b *DomainInterfaceSourceMCast) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if !a.Local.Equal(b.Local) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceMCast) canonicalize() {
+	if in.Local != nil {
+		in.Local.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceSourceNetwork) DeepCopy() *DomainInterfaceSourceNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceNetwork) DeepCopyInto(out *DomainInterfaceSourceNetwork) {
+	*out = *in
+}
+
+func (a *DomainInterfaceSourceNetwork) Equal(b *DomainInterfaceSourceNetwork) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Network != b.Network {
+		return false
+	}
+	if a.PortGroup != b.PortGroup {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceNetwork) canonicalize() {
+}
+
+func (in *DomainInterfaceSourceServer) DeepCopy() *DomainInterfaceSourceServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceServer) DeepCopyInto(out *DomainInterfaceSourceServer) {
+	*out = *in
+	if in.Local != nil {
+		out.Local = in.Local.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceSourceServer) Equal(b *DomainInterfaceSourceServer) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if !a.Local.Equal(b.Local) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceServer) canonicalize() {
+	if in.Local != nil {
+		in.Local.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceSourceUDP) DeepCopy() *DomainInterfaceSourceUDP {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceUDP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceUDP) DeepCopyInto(out *DomainInterfaceSourceUDP) {
+	*out = *in
+	if in.Local != nil {
+		out.Local = in.Local.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceSourceUDP) Equal(b *DomainInterfaceSourceUDP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if !a.Local.Equal(b.Local) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceUDP) canonicalize() {
+	if in.Local != nil {
+		in.Local.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceSourceUser) DeepCopy() *DomainInterfaceSourceUser {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceSourceUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceSourceUser) DeepCopyInto(out *DomainInterfaceSourceUser) {
+	*out = *in
+}
+
+func (a *DomainInterfaceSourceUser) Equal(b *DomainInterfaceSourceUser) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainInterfaceSourceUser) canonicalize() {
+}
+
+func (in *DomainInterfaceTarget) DeepCopy() *DomainInterfaceTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceTarget) DeepCopyInto(out *DomainInterfaceTarget) {
+	*out = *in
+}
+
+func (a *DomainInterfaceTarget) Equal(b *DomainInterfaceTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceTarget) canonicalize() {
+}
+
+func (in *DomainInterfaceTune) DeepCopy() *DomainInterfaceTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceTune) DeepCopyInto(out *DomainInterfaceTune) {
+	*out = *in
+}
+
+func (a *DomainInterfaceTune) Equal(b *DomainInterfaceTune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.SndBuf != b.SndBuf {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceTune) canonicalize() {
+}
+
+func (in *DomainInterfaceVLan) DeepCopy() *DomainInterfaceVLan {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVLan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVLan) DeepCopyInto(out *DomainInterfaceVLan) {
+	*out = *in
+	if in.Tags != nil {
+		out.Tags = make([]DomainInterfaceVLanTag, len(in.Tags))
+		for i := range in.Tags {
+			in.Tags[i].DeepCopyInto(&out.Tags[i])
+		}
+	}
+}
+
+func (a *DomainInterfaceVLan) Equal(b *DomainInterfaceVLan) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Trunk != b.Trunk {
+		return false
+	}
+	if len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for i := range a.Tags {
+		if !a.Tags[i].Equal(&b.Tags[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainInterfaceVLan) canonicalize() {
+	for i := range in.Tags {
+		in.Tags[i].canonicalize()
+	}
+	if len(in.Tags) == 0 {
+		in.Tags = nil
+	}
+}
+
+func (in *DomainInterfaceVLanTag) DeepCopy() *DomainInterfaceVLanTag {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVLanTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVLanTag) DeepCopyInto(out *DomainInterfaceVLanTag) {
+	*out = *in
+}
+
+func (a *DomainInterfaceVLanTag) Equal(b *DomainInterfaceVLanTag) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.NativeMode != b.NativeMode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVLanTag) canonicalize() {
+}
+
+func (in *DomainInterfaceVirtualPort) DeepCopy() *DomainInterfaceVirtualPort {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPort) DeepCopyInto(out *DomainInterfaceVirtualPort) {
+	*out = *in
+	if in.Params != nil {
+		out.Params = in.Params.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceVirtualPort) Equal(b *DomainInterfaceVirtualPort) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Params.Equal(b.Params) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPort) canonicalize() {
+	if in.Params != nil {
+		in.Params.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceVirtualPortParams) DeepCopy() *DomainInterfaceVirtualPortParams {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPortParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPortParams) DeepCopyInto(out *DomainInterfaceVirtualPortParams) {
+	*out = *in
+	if in.Any != nil {
+		out.Any = in.Any.DeepCopy()
+	}
+	if in.VEPA8021QBG != nil {
+		out.VEPA8021QBG = in.VEPA8021QBG.DeepCopy()
+	}
+	if in.VNTag8011QBH != nil {
+		out.VNTag8011QBH = in.VNTag8011QBH.DeepCopy()
+	}
+	if in.OpenVSwitch != nil {
+		out.OpenVSwitch = in.OpenVSwitch.DeepCopy()
+	}
+	if in.MidoNet != nil {
+		out.MidoNet = in.MidoNet.DeepCopy()
+	}
+}
+
+func (a *DomainInterfaceVirtualPortParams) Equal(b *DomainInterfaceVirtualPortParams) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Any.Equal(b.Any) {
+		return false
+	}
+	if !a.VEPA8021QBG.Equal(b.VEPA8021QBG) {
+		return false
+	}
+	if !a.VNTag8011QBH.Equal(b.VNTag8011QBH) {
+		return false
+	}
+	if !a.OpenVSwitch.Equal(b.OpenVSwitch) {
+		return false
+	}
+	if !a.MidoNet.Equal(b.MidoNet) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPortParams) canonicalize() {
+	if in.Any != nil {
+		in.Any.canonicalize()
+	}
+	if in.VEPA8021QBG != nil {
+		in.VEPA8021QBG.canonicalize()
+	}
+	if in.VNTag8011QBH != nil {
+		in.VNTag8011QBH.canonicalize()
+	}
+	if in.OpenVSwitch != nil {
+		in.OpenVSwitch.canonicalize()
+	}
+	if in.MidoNet != nil {
+		in.MidoNet.canonicalize()
+	}
+}
+
+func (in *DomainInterfaceVirtualPortParamsAny) DeepCopy() *DomainInterfaceVirtualPortParamsAny {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPortParamsAny)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPortParamsAny) DeepCopyInto(out *DomainInterfaceVirtualPortParamsAny) {
+	*out = *in
+	if in.ManagerID != nil {
+		v := *in.ManagerID
+		out.ManagerID = &v
+	}
+	if in.TypeID != nil {
+		v := *in.TypeID
+		out.TypeID = &v
+	}
+	if in.TypeIDVersion != nil {
+		v := *in.TypeIDVersion
+		out.TypeIDVersion = &v
+	}
+}
+
+func (a *DomainInterfaceVirtualPortParamsAny) Equal(b *DomainInterfaceVirtualPortParamsAny) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.ManagerID == nil) != (b.ManagerID == nil) || (a.ManagerID != nil && *a.ManagerID != *b.ManagerID) {
+		return false
+	}
+	if (a.TypeID == nil) != (b.TypeID == nil) || (a.TypeID != nil && *a.TypeID != *b.TypeID) {
+		return false
+	}
+	if (a.TypeIDVersion == nil) != (b.TypeIDVersion == nil) || (a.TypeIDVersion != nil && *a.TypeIDVersion != *b.TypeIDVersion) {
+		return false
+	}
+	if a.InstanceID != b.InstanceID {
+		return false
+	}
+	if a.ProfileID != b.ProfileID {
+		return false
+	}
+	if a.InterfaceID != b.InterfaceID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPortParamsAny) canonicalize() {
+}
+
+func (in *DomainInterfaceVirtualPortParamsMidoNet) DeepCopy() *DomainInterfaceVirtualPortParamsMidoNet {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPortParamsMidoNet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPortParamsMidoNet) DeepCopyInto(out *DomainInterfaceVirtualPortParamsMidoNet) {
+	*out = *in
+}
+
+func (a *DomainInterfaceVirtualPortParamsMidoNet) Equal(b *DomainInterfaceVirtualPortParamsMidoNet) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.InterfaceID != b.InterfaceID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPortParamsMidoNet) canonicalize() {
+}
+
+func (in *DomainInterfaceVirtualPortParamsOpenVSwitch) DeepCopy() *DomainInterfaceVirtualPortParamsOpenVSwitch {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPortParamsOpenVSwitch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPortParamsOpenVSwitch) DeepCopyInto(out *DomainInterfaceVirtualPortParamsOpenVSwitch) {
+	*out = *in
+}
+
+func (a *DomainInterfaceVirtualPortParamsOpenVSwitch) Equal(b *DomainInterfaceVirtualPortParamsOpenVSwitch) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.InterfaceID != b.InterfaceID {
+		return false
+	}
+	if a.ProfileID != b.ProfileID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPortParamsOpenVSwitch) canonicalize() {
+}
+
+func (in *DomainInterfaceVirtualPortParamsVEPA8021QBG) DeepCopy() *DomainInterfaceVirtualPortParamsVEPA8021QBG {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPortParamsVEPA8021QBG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPortParamsVEPA8021QBG) DeepCopyInto(out *DomainInterfaceVirtualPortParamsVEPA8021QBG) {
+	*out = *in
+	if in.ManagerID != nil {
+		v := *in.ManagerID
+		out.ManagerID = &v
+	}
+	if in.TypeID != nil {
+		v := *in.TypeID
+		out.TypeID = &v
+	}
+	if in.TypeIDVersion != nil {
+		v := *in.TypeIDVersion
+		out.TypeIDVersion = &v
+	}
+}
+
+func (a *DomainInterfaceVirtualPortParamsVEPA8021QBG) Equal(b *DomainInterfaceVirtualPortParamsVEPA8021QBG) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.ManagerID == nil) != (b.ManagerID == nil) || (a.ManagerID != nil && *a.ManagerID != *b.ManagerID) {
+		return false
+	}
+	if (a.TypeID == nil) != (b.TypeID == nil) || (a.TypeID != nil && *a.TypeID != *b.TypeID) {
+		return false
+	}
+	if (a.TypeIDVersion == nil) != (b.TypeIDVersion == nil) || (a.TypeIDVersion != nil && *a.TypeIDVersion != *b.TypeIDVersion) {
+		return false
+	}
+	if a.InstanceID != b.InstanceID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPortParamsVEPA8021QBG) canonicalize() {
+}
+
+func (in *DomainInterfaceVirtualPortParamsVNTag8021QBH) DeepCopy() *DomainInterfaceVirtualPortParamsVNTag8021QBH {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceVirtualPortParamsVNTag8021QBH)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainInterfaceVirtualPortParamsVNTag8021QBH) DeepCopyInto(out *DomainInterfaceVirtualPortParamsVNTag8021QBH) {
+	*out = *in
+}
+
+func (a *DomainInterfaceVirtualPortParamsVNTag8021QBH) Equal(b *DomainInterfaceVirtualPortParamsVNTag8021QBH) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ProfileID != b.ProfileID {
+		return false
+	}
+	return true
+}
+
+func (in *DomainInterfaceVirtualPortParamsVNTag8021QBH) canonicalize() {
+}
+
+func (in *DomainKeyWrap) DeepCopy() *DomainKeyWrap {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainKeyWrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainKeyWrap) DeepCopyInto(out *DomainKeyWrap) {
+	*out = *in
+	if in.Ciphers != nil {
+		out.Ciphers = make([]DomainKeyWrapCipher, len(in.Ciphers))
+		for i := range in.Ciphers {
+			in.Ciphers[i].DeepCopyInto(&out.Ciphers[i])
+		}
+	}
+}
+
+func (a *DomainKeyWrap) Equal(b *DomainKeyWrap) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Ciphers) != len(b.Ciphers) {
+		return false
+	}
+	for i := range a.Ciphers {
+		if !a.Ciphers[i].Equal(&b.Ciphers[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainKeyWrap) canonicalize() {
+	for i := range in.Ciphers {
+		in.Ciphers[i].canonicalize()
+	}
+	if len(in.Ciphers) == 0 {
+		in.Ciphers = nil
+	}
+}
+
+func (in *DomainKeyWrap) empty() bool {
+	return len(in.Ciphers) == 0
+}
+
+func (in *DomainKeyWrapCipher) DeepCopy() *DomainKeyWrapCipher {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainKeyWrapCipher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainKeyWrapCipher) DeepCopyInto(out *DomainKeyWrapCipher) {
+	*out = *in
+}
+
+func (a *DomainKeyWrapCipher) Equal(b *DomainKeyWrapCipher) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.State != b.State {
+		return false
+	}
+	return true
+}
+
+func (in *DomainKeyWrapCipher) canonicalize() {
+}
+
+func (in *DomainLXCNamespace) DeepCopy() *DomainLXCNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLXCNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLXCNamespace) DeepCopyInto(out *DomainLXCNamespace) {
+	*out = *in
+	if in.ShareNet != nil {
+		out.ShareNet = in.ShareNet.DeepCopy()
+	}
+	if in.ShareIPC != nil {
+		out.ShareIPC = in.ShareIPC.DeepCopy()
+	}
+	if in.ShareUTS != nil {
+		out.ShareUTS = in.ShareUTS.DeepCopy()
+	}
+}
+
+func (a *DomainLXCNamespace) Equal(b *DomainLXCNamespace) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.ShareNet.Equal(b.ShareNet) {
+		return false
+	}
+	if !a.ShareIPC.Equal(b.ShareIPC) {
+		return false
+	}
+	if !a.ShareUTS.Equal(b.ShareUTS) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLXCNamespace) canonicalize() {
+	if in.ShareNet != nil {
+		in.ShareNet.canonicalize()
+	}
+	if in.ShareIPC != nil {
+		in.ShareIPC.canonicalize()
+	}
+	if in.ShareUTS != nil {
+		in.ShareUTS.canonicalize()
+	}
+}
+
+func (in *DomainLXCNamespace) empty() bool {
+	return in.ShareNet == nil &&
+		in.ShareIPC == nil &&
+		in.ShareUTS == nil
+}
+
+func (in *DomainLXCNamespaceMap) DeepCopy() *DomainLXCNamespaceMap {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLXCNamespaceMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLXCNamespaceMap) DeepCopyInto(out *DomainLXCNamespaceMap) {
+	*out = *in
+}
+
+func (a *DomainLXCNamespaceMap) Equal(b *DomainLXCNamespaceMap) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLXCNamespaceMap) canonicalize() {
+}
+
+func (in *DomainLaunchSecurity) DeepCopy() *DomainLaunchSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLaunchSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLaunchSecurity) DeepCopyInto(out *DomainLaunchSecurity) {
+	*out = *in
+	if in.SEV != nil {
+		out.SEV = in.SEV.DeepCopy()
+	}
+}
+
+func (a *DomainLaunchSecurity) Equal(b *DomainLaunchSecurity) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SEV.Equal(b.SEV) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLaunchSecurity) canonicalize() {
+	if in.SEV != nil {
+		in.SEV.canonicalize()
+	}
+}
+
+func (in *DomainLaunchSecuritySEV) DeepCopy() *DomainLaunchSecuritySEV {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLaunchSecuritySEV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLaunchSecuritySEV) DeepCopyInto(out *DomainLaunchSecuritySEV) {
+	*out = *in
+	if in.CBitPos != nil {
+		v := *in.CBitPos
+		out.CBitPos = &v
+	}
+	if in.ReducedPhysBits != nil {
+		v := *in.ReducedPhysBits
+		out.ReducedPhysBits = &v
+	}
+	if in.Policy != nil {
+		v := *in.Policy
+		out.Policy = &v
+	}
+}
+
+func (a *DomainLaunchSecuritySEV) Equal(b *DomainLaunchSecuritySEV) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.CBitPos == nil) != (b.CBitPos == nil) || (a.CBitPos != nil && *a.CBitPos != *b.CBitPos) {
+		return false
+	}
+	if (a.ReducedPhysBits == nil) != (b.ReducedPhysBits == nil) || (a.ReducedPhysBits != nil && *a.ReducedPhysBits != *b.ReducedPhysBits) {
+		return false
+	}
+	if (a.Policy == nil) != (b.Policy == nil) || (a.Policy != nil && *a.Policy != *b.Policy) {
+		return false
+	}
+	if a.DHCert != b.DHCert {
+		return false
+	}
+	if a.Session != b.Session {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLaunchSecuritySEV) canonicalize() {
+}
+
+func (in *DomainLease) DeepCopy() *DomainLease {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLease) DeepCopyInto(out *DomainLease) {
+	*out = *in
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+}
+
+func (a *DomainLease) Equal(b *DomainLease) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Lockspace != b.Lockspace {
+		return false
+	}
+	if a.Key != b.Key {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLease) canonicalize() {
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+}
+
+func (d *DomainLease) Canonicalize() *DomainLease {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainLease) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainLease) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainLease) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainLease) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainLease) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainLease) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainLease) schemaRef() (string, string) {
+	return "domain.rng", "lease"
+}
+
+func (in *DomainLeaseTarget) DeepCopy() *DomainLeaseTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLeaseTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLeaseTarget) DeepCopyInto(out *DomainLeaseTarget) {
+	*out = *in
+}
+
+func (a *DomainLeaseTarget) Equal(b *DomainLeaseTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if a.Offset != b.Offset {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLeaseTarget) canonicalize() {
+}
+
+func (in *DomainLoader) DeepCopy() *DomainLoader {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainLoader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainLoader) DeepCopyInto(out *DomainLoader) {
+	*out = *in
+}
+
+func (a *DomainLoader) Equal(b *DomainLoader) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if a.Readonly != b.Readonly {
+		return false
+	}
+	if a.Secure != b.Secure {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainLoader) canonicalize() {
+}
+
+func (in *DomainMaxMemory) DeepCopy() *DomainMaxMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMaxMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMaxMemory) DeepCopyInto(out *DomainMaxMemory) {
+	*out = *in
+}
+
+func (a *DomainMaxMemory) Equal(b *DomainMaxMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "KiB") {
+		return false
+	}
+	if a.Slots != b.Slots {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMaxMemory) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainMemBalloon) DeepCopy() *DomainMemBalloon {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemBalloon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemBalloon) DeepCopyInto(out *DomainMemBalloon) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Stats != nil {
+		out.Stats = in.Stats.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainMemBalloon) Equal(b *DomainMemBalloon) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.AutoDeflate != b.AutoDeflate {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Stats.Equal(b.Stats) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemBalloon) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Stats != nil {
+		in.Stats.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainMemBalloon) Canonicalize() *DomainMemBalloon {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainMemBalloon) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainMemBalloon) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainMemBalloon) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainMemBalloon) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainMemBalloon) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainMemBalloon) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainMemBalloon) schemaRef() (string, string) {
+	return "domain.rng", "memballoon"
+}
+
+func (in *DomainMemBalloonDriver) DeepCopy() *DomainMemBalloonDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemBalloonDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemBalloonDriver) DeepCopyInto(out *DomainMemBalloonDriver) {
+	*out = *in
+}
+
+func (a *DomainMemBalloonDriver) Equal(b *DomainMemBalloonDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemBalloonDriver) canonicalize() {
+}
+
+func (in *DomainMemBalloonStats) DeepCopy() *DomainMemBalloonStats {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemBalloonStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemBalloonStats) DeepCopyInto(out *DomainMemBalloonStats) {
+	*out = *in
+}
+
+func (a *DomainMemBalloonStats) Equal(b *DomainMemBalloonStats) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Period != b.Period {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemBalloonStats) canonicalize() {
+}
+
+func (in *DomainMemory) DeepCopy() *DomainMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemory) DeepCopyInto(out *DomainMemory) {
+	*out = *in
+}
+
+func (a *DomainMemory) Equal(b *DomainMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "KiB") {
+		return false
+	}
+	if a.DumpCore != b.DumpCore {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemory) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainMemoryAccess) DeepCopy() *DomainMemoryAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryAccess) DeepCopyInto(out *DomainMemoryAccess) {
+	*out = *in
+}
+
+func (a *DomainMemoryAccess) Equal(b *DomainMemoryAccess) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemoryAccess) canonicalize() {
+}
+
+func (in *DomainMemoryAllocation) DeepCopy() *DomainMemoryAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryAllocation) DeepCopyInto(out *DomainMemoryAllocation) {
+	*out = *in
+}
+
+func (a *DomainMemoryAllocation) Equal(b *DomainMemoryAllocation) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemoryAllocation) canonicalize() {
+}
+
+func (in *DomainMemoryBacking) DeepCopy() *DomainMemoryBacking {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryBacking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryBacking) DeepCopyInto(out *DomainMemoryBacking) {
+	*out = *in
+	if in.MemoryHugePages != nil {
+		out.MemoryHugePages = in.MemoryHugePages.DeepCopy()
+	}
+	if in.MemoryNosharepages != nil {
+		out.MemoryNosharepages = in.MemoryNosharepages.DeepCopy()
+	}
+	if in.MemoryLocked != nil {
+		out.MemoryLocked = in.MemoryLocked.DeepCopy()
+	}
+	if in.MemorySource != nil {
+		out.MemorySource = in.MemorySource.DeepCopy()
+	}
+	if in.MemoryAccess != nil {
+		out.MemoryAccess = in.MemoryAccess.DeepCopy()
+	}
+	if in.MemoryAllocation != nil {
+		out.MemoryAllocation = in.MemoryAllocation.DeepCopy()
+	}
+	if in.MemoryDiscard != nil {
+		out.MemoryDiscard = in.MemoryDiscard.DeepCopy()
+	}
+}
+
+func (a *DomainMemoryBacking) Equal(b *DomainMemoryBacking) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.MemoryHugePages.Equal(b.MemoryHugePages) {
+		return false
+	}
+	if !a.MemoryNosharepages.Equal(b.MemoryNosharepages) {
+		return false
+	}
+	if !a.MemoryLocked.Equal(b.MemoryLocked) {
+		return false
+	}
+	if !a.MemorySource.Equal(b.MemorySource) {
+		return false
+	}
+	if !a.MemoryAccess.Equal(b.MemoryAccess) {
+		return false
+	}
+	if !a.MemoryAllocation.Equal(b.MemoryAllocation) {
+		return false
+	}
+	if !a.MemoryDiscard.Equal(b.MemoryDiscard) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemoryBacking) canonicalize() {
+	if in.MemoryHugePages != nil {
+		in.MemoryHugePages.canonicalize()
+		if in.MemoryHugePages.empty() {
+			in.MemoryHugePages = nil
+		}
+	}
+	if in.MemoryNosharepages != nil {
+		in.MemoryNosharepages.canonicalize()
+	}
+	if in.MemoryLocked != nil {
+		in.MemoryLocked.canonicalize()
+	}
+	if in.MemorySource != nil {
+		in.MemorySource.canonicalize()
+	}
+	if in.MemoryAccess != nil {
+		in.MemoryAccess.canonicalize()
+	}
+	if in.MemoryAllocation != nil {
+		in.MemoryAllocation.canonicalize()
+	}
+	if in.MemoryDiscard != nil {
+		in.MemoryDiscard.canonicalize()
+	}
+}
+
+func (in *DomainMemoryBacking) empty() bool {
+	return in.MemoryHugePages == nil &&
+		in.MemoryNosharepages == nil &&
+		in.MemoryLocked == nil &&
+		in.MemorySource == nil &&
+		in.MemoryAccess == nil &&
+		in.MemoryAllocation == nil &&
+		in.MemoryDiscard == nil
+}
+
+func (in *DomainMemoryDiscard) DeepCopy() *DomainMemoryDiscard {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryDiscard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryDiscard) DeepCopyInto(out *DomainMemoryDiscard) {
+	*out = *in
+}
+
+func (a *DomainMemoryDiscard) Equal(b *DomainMemoryDiscard) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainMemoryDiscard) canonicalize() {
+}
+
+func (in *DomainMemoryHugepage) DeepCopy() *DomainMemoryHugepage {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryHugepage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryHugepage) DeepCopyInto(out *DomainMemoryHugepage) {
+	*out = *in
+}
+
+func (a *DomainMemoryHugepage) Equal(b *DomainMemoryHugepage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Size), a.Unit, uint64(b.Size), b.Unit, "KiB") {
+		return false
+	}
+	if a.Nodeset != b.Nodeset {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemoryHugepage) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Size = uint(canonicalScaled(uint64(in.Size), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainMemoryHugepages) DeepCopy() *DomainMemoryHugepages {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryHugepages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryHugepages) DeepCopyInto(out *DomainMemoryHugepages) {
+	*out = *in
+	if in.Hugepages != nil {
+		out.Hugepages = make([]DomainMemoryHugepage, len(in.Hugepages))
+		for i := range in.Hugepages {
+			in.Hugepages[i].DeepCopyInto(&out.Hugepages[i])
+		}
+	}
+}
+
+func (a *DomainMemoryHugepages) Equal(b *DomainMemoryHugepages) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Hugepages) != len(b.Hugepages) {
+		return false
+	}
+	for i := range a.Hugepages {
+		if !a.Hugepages[i].Equal(&b.Hugepages[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainMemoryHugepages) canonicalize() {
+	for i := range in.Hugepages {
+		in.Hugepages[i].canonicalize()
+	}
+	if len(in.Hugepages) == 0 {
+		in.Hugepages = nil
+	}
+}
+
+func (in *DomainMemoryHugepages) empty() bool {
+	return len(in.Hugepages) == 0
+}
+
+func (in *DomainMemoryLocked) DeepCopy() *DomainMemoryLocked {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryLocked)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryLocked) DeepCopyInto(out *DomainMemoryLocked) {
+	*out = *in
+}
+
+func (a *DomainMemoryLocked) Equal(b *DomainMemoryLocked) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainMemoryLocked) canonicalize() {
+}
+
+func (in *DomainMemoryNosharepages) DeepCopy() *DomainMemoryNosharepages {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryNosharepages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryNosharepages) DeepCopyInto(out *DomainMemoryNosharepages) {
+	*out = *in
+}
+
+func (a *DomainMemoryNosharepages) Equal(b *DomainMemoryNosharepages) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainMemoryNosharepages) canonicalize() {
+}
+
+func (in *DomainMemorySource) DeepCopy() *DomainMemorySource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorySource) DeepCopyInto(out *DomainMemorySource) {
+	*out = *in
+}
+
+func (a *DomainMemorySource) Equal(b *DomainMemorySource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorySource) canonicalize() {
+}
+
+func (in *DomainMemoryTune) DeepCopy() *DomainMemoryTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryTune) DeepCopyInto(out *DomainMemoryTune) {
+	*out = *in
+	if in.HardLimit != nil {
+		out.HardLimit = in.HardLimit.DeepCopy()
+	}
+	if in.SoftLimit != nil {
+		out.SoftLimit = in.SoftLimit.DeepCopy()
+	}
+	if in.MinGuarantee != nil {
+		out.MinGuarantee = in.MinGuarantee.DeepCopy()
+	}
+	if in.SwapHardLimit != nil {
+		out.SwapHardLimit = in.SwapHardLimit.DeepCopy()
+	}
+}
+
+func (a *DomainMemoryTune) Equal(b *DomainMemoryTune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.HardLimit.Equal(b.HardLimit) {
+		return false
+	}
+	if !a.SoftLimit.Equal(b.SoftLimit) {
+		return false
+	}
+	if !a.MinGuarantee.Equal(b.MinGuarantee) {
+		return false
+	}
+	if !a.SwapHardLimit.Equal(b.SwapHardLimit) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemoryTune) canonicalize() {
+	if in.HardLimit != nil {
+		in.HardLimit.canonicalize()
+	}
+	if in.SoftLimit != nil {
+		in.SoftLimit.canonicalize()
+	}
+	if in.MinGuarantee != nil {
+		in.MinGuarantee.canonicalize()
+	}
+	if in.SwapHardLimit != nil {
+		in.SwapHardLimit.canonicalize()
+	}
+}
+
+func (in *DomainMemoryTune) empty() bool {
+	return in.HardLimit == nil &&
+		in.SoftLimit == nil &&
+		in.MinGuarantee == nil &&
+		in.SwapHardLimit == nil
+}
+
+func (in *DomainMemoryTuneLimit) DeepCopy() *DomainMemoryTuneLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryTuneLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemoryTuneLimit) DeepCopyInto(out *DomainMemoryTuneLimit) {
+	*out = *in
+}
+
+func (a *DomainMemoryTuneLimit) Equal(b *DomainMemoryTuneLimit) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemoryTuneLimit) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = uint64(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainMemorydev) DeepCopy() *DomainMemorydev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydev) DeepCopyInto(out *DomainMemorydev) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainMemorydev) Equal(b *DomainMemorydev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.Access != b.Access {
+		return false
+	}
+	if a.Discard != b.Discard {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydev) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+		if in.Target.empty() {
+			in.Target = nil
+		}
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainMemorydev) Canonicalize() *DomainMemorydev {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainMemorydev) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainMemorydev) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainMemorydev) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainMemorydev) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainMemorydev) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainMemorydev) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainMemorydev) schemaRef() (string, string) {
+	return "domain.rng", "memorydev"
+}
+
+func (in *DomainMemorydevSource) DeepCopy() *DomainMemorydevSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydevSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydevSource) DeepCopyInto(out *DomainMemorydevSource) {
+	*out = *in
+	if in.PageSize != nil {
+		out.PageSize = in.PageSize.DeepCopy()
+	}
+}
+
+func (a *DomainMemorydevSource) Equal(b *DomainMemorydevSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.NodeMask != b.NodeMask {
+		return false
+	}
+	if !a.PageSize.Equal(b.PageSize) {
+		return false
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydevSource) canonicalize() {
+	if in.PageSize != nil {
+		in.PageSize.canonicalize()
+	}
+}
+
+func (in *DomainMemorydevSourcePagesize) DeepCopy() *DomainMemorydevSourcePagesize {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydevSourcePagesize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydevSourcePagesize) DeepCopyInto(out *DomainMemorydevSourcePagesize) {
+	*out = *in
+}
+
+func (a *DomainMemorydevSourcePagesize) Equal(b *DomainMemorydevSourcePagesize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydevSourcePagesize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = uint64(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainMemorydevTarget) DeepCopy() *DomainMemorydevTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydevTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydevTarget) DeepCopyInto(out *DomainMemorydevTarget) {
+	*out = *in
+	if in.Size != nil {
+		out.Size = in.Size.DeepCopy()
+	}
+	if in.Node != nil {
+		out.Node = in.Node.DeepCopy()
+	}
+	if in.Label != nil {
+		out.Label = in.Label.DeepCopy()
+	}
+}
+
+func (a *DomainMemorydevTarget) Equal(b *DomainMemorydevTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Size.Equal(b.Size) {
+		return false
+	}
+	if !a.Node.Equal(b.Node) {
+		return false
+	}
+	if !a.Label.Equal(b.Label) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydevTarget) canonicalize() {
+	if in.Size != nil {
+		in.Size.canonicalize()
+	}
+	if in.Node != nil {
+		in.Node.canonicalize()
+	}
+	if in.Label != nil {
+		in.Label.canonicalize()
+		if in.Label.empty() {
+			in.Label = nil
+		}
+	}
+}
+
+func (in *DomainMemorydevTarget) empty() bool {
+	return in.Size == nil &&
+		in.Node == nil &&
+		in.Label == nil
+}
+
+func (in *DomainMemorydevTargetLabel) DeepCopy() *DomainMemorydevTargetLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydevTargetLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydevTargetLabel) DeepCopyInto(out *DomainMemorydevTargetLabel) {
+	*out = *in
+	if in.Size != nil {
+		out.Size = in.Size.DeepCopy()
+	}
+}
+
+func (a *DomainMemorydevTargetLabel) Equal(b *DomainMemorydevTargetLabel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Size.Equal(b.Size) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydevTargetLabel) canonicalize() {
+	if in.Size != nil {
+		in.Size.canonicalize()
+	}
+}
+
+func (in *DomainMemorydevTargetLabel) empty() bool {
+	return in.Size == nil
+}
+
+func (in *DomainMemorydevTargetNode) DeepCopy() *DomainMemorydevTargetNode {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydevTargetNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydevTargetNode) DeepCopyInto(out *DomainMemorydevTargetNode) {
+	*out = *in
+}
+
+func (a *DomainMemorydevTargetNode) Equal(b *DomainMemorydevTargetNode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydevTargetNode) canonicalize() {
+}
+
+func (in *DomainMemorydevTargetSize) DeepCopy() *DomainMemorydevTargetSize {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemorydevTargetSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMemorydevTargetSize) DeepCopyInto(out *DomainMemorydevTargetSize) {
+	*out = *in
+}
+
+func (a *DomainMemorydevTargetSize) Equal(b *DomainMemorydevTargetSize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "KiB") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMemorydevTargetSize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "KiB"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1024))
+		in.Unit = "KiB"
+	}
+}
+
+func (in *DomainMetadata) DeepCopy() *DomainMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainMetadata) DeepCopyInto(out *DomainMetadata) {
+	*out = *in
+}
+
+func (a *DomainMetadata) Equal(b *DomainMetadata) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.XML != b.XML {
+		return false
+	}
+	return true
+}
+
+func (in *DomainMetadata) canonicalize() {
+}
+
+func (in *DomainNUMATune) DeepCopy() *DomainNUMATune {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNUMATune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainNUMATune) DeepCopyInto(out *DomainNUMATune) {
+	*out = *in
+	if in.Memory != nil {
+		out.Memory = in.Memory.DeepCopy()
+	}
+	if in.MemNodes != nil {
+		out.MemNodes = make([]DomainNUMATuneMemNode, len(in.MemNodes))
+		for i := range in.MemNodes {
+			in.MemNodes[i].DeepCopyInto(&out.MemNodes[i])
+		}
+	}
+}
+
+func (a *DomainNUMATune) Equal(b *DomainNUMATune) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Memory.Equal(b.Memory) {
+		return false
+	}
+	if len(a.MemNodes) != len(b.MemNodes) {
+		return false
+	}
+	for i := range a.MemNodes {
+		if !a.MemNodes[i].Equal(&b.MemNodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainNUMATune) canonicalize() {
+	if in.Memory != nil {
+		in.Memory.canonicalize()
+	}
+	for i := range in.MemNodes {
+		in.MemNodes[i].canonicalize()
+	}
+	if len(in.MemNodes) == 0 {
+		in.MemNodes = nil
+	}
+}
+
+func (in *DomainNUMATune) empty() bool {
+	return in.Memory == nil &&
+		len(in.MemNodes) == 0
+}
+
+func (in *DomainNUMATuneMemNode) DeepCopy() *DomainNUMATuneMemNode {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNUMATuneMemNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainNUMATuneMemNode) DeepCopyInto(out *DomainNUMATuneMemNode) {
+	*out = *in
+}
+
+func (a *DomainNUMATuneMemNode) Equal(b *DomainNUMATuneMemNode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.CellID != b.CellID {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Nodeset != b.Nodeset {
+		return false
+	}
+	return true
+}
+
+func (in *DomainNUMATuneMemNode) canonicalize() {
+}
+
+func (in *DomainNUMATuneMemory) DeepCopy() *DomainNUMATuneMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNUMATuneMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainNUMATuneMemory) DeepCopyInto(out *DomainNUMATuneMemory) {
+	*out = *in
+}
+
+func (a *DomainNUMATuneMemory) Equal(b *DomainNUMATuneMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Nodeset != b.Nodeset {
+		return false
+	}
+	if a.Placement != b.Placement {
+		return false
+	}
+	return true
+}
+
+func (in *DomainNUMATuneMemory) canonicalize() {
+}
+
+func (in *DomainNVRAM) DeepCopy() *DomainNVRAM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNVRAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainNVRAM) DeepCopyInto(out *DomainNVRAM) {
+	*out = *in
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainNVRAM) Equal(b *DomainNVRAM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainNVRAM) canonicalize() {
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainNVRAM) Canonicalize() *DomainNVRAM {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainNVRAM) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainNVRAM) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainNVRAM) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainNVRAM) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainNVRAM) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainNVRAM) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainNVRAM) schemaRef() (string, string) {
+	return "domain.rng", "nvram"
+}
+
+func (in *DomainNVRam) DeepCopy() *DomainNVRam {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNVRam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainNVRam) DeepCopyInto(out *DomainNVRam) {
+	*out = *in
+}
+
+func (a *DomainNVRam) Equal(b *DomainNVRam) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.NVRam != b.NVRam {
+		return false
+	}
+	if a.Template != b.Template {
+		return false
+	}
+	return true
+}
+
+func (in *DomainNVRam) canonicalize() {
+}
+
+func (in *DomainNuma) DeepCopy() *DomainNuma {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNuma)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainNuma) DeepCopyInto(out *DomainNuma) {
+	*out = *in
+	if in.Cell != nil {
+		out.Cell = make([]DomainCell, len(in.Cell))
+		for i := range in.Cell {
+			in.Cell[i].DeepCopyInto(&out.Cell[i])
+		}
+	}
+}
+
+func (a *DomainNuma) Equal(b *DomainNuma) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Cell) != len(b.Cell) {
+		return false
+	}
+	for i := range a.Cell {
+		if !a.Cell[i].Equal(&b.Cell[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainNuma) canonicalize() {
+	for i := range in.Cell {
+		in.Cell[i].canonicalize()
+	}
+	if len(in.Cell) == 0 {
+		in.Cell = nil
+	}
+}
+
+func (in *DomainNuma) empty() bool {
+	return len(in.Cell) == 0
+}
+
+func (in *DomainOS) DeepCopy() *DomainOS {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainOS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainOS) DeepCopyInto(out *DomainOS) {
+	*out = *in
+	if in.Type != nil {
+		out.Type = in.Type.DeepCopy()
+	}
+	if in.InitArgs != nil {
+		out.InitArgs = make([]string, len(in.InitArgs))
+		copy(out.InitArgs, in.InitArgs)
+	}
+	if in.InitEnv != nil {
+		out.InitEnv = make([]DomainOSInitEnv, len(in.InitEnv))
+		for i := range in.InitEnv {
+			in.InitEnv[i].DeepCopyInto(&out.InitEnv[i])
+		}
+	}
+	if in.Loader != nil {
+		out.Loader = in.Loader.DeepCopy()
+	}
+	if in.NVRam != nil {
+		out.NVRam = in.NVRam.DeepCopy()
+	}
+	if in.ACPI != nil {
+		out.ACPI = in.ACPI.DeepCopy()
+	}
+	if in.BootDevices != nil {
+		out.BootDevices = make([]DomainBootDevice, len(in.BootDevices))
+		for i := range in.BootDevices {
+			in.BootDevices[i].DeepCopyInto(&out.BootDevices[i])
+		}
+	}
+	if in.BootMenu != nil {
+		out.BootMenu = in.BootMenu.DeepCopy()
+	}
+	if in.BIOS != nil {
+		out.BIOS = in.BIOS.DeepCopy()
+	}
+	if in.SMBios != nil {
+		out.SMBios = in.SMBios.DeepCopy()
+	}
+}
+
+func (a *DomainOS) Equal(b *DomainOS) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Type.Equal(b.Type) {
+		return false
+	}
+	if a.Init != b.Init {
+		return false
+	}
+	if len(a.InitArgs) != len(b.InitArgs) {
+		return false
+	}
+	for i := range a.InitArgs {
+		if a.InitArgs[i] != b.InitArgs[i] {
+			return false
+		}
+	}
+	if len(a.InitEnv) != len(b.InitEnv) {
+		return false
+	}
+	for i := range a.InitEnv {
+		if !a.InitEnv[i].Equal(&b.InitEnv[i]) {
+			return false
+		}
+	}
+	if a.InitDir != b.InitDir {
+		return false
+	}
+	if a.InitUser != b.InitUser {
+		return false
+	}
+	if a.InitGroup != b.InitGroup {
+		return false
+	}
+	if !a.Loader.Equal(b.Loader) {
+		return false
+	}
+	if !a.NVRam.Equal(b.NVRam) {
+		return false
+	}
+	if a.Kernel != b.Kernel {
+		return false
+	}
+	if a.Initrd != b.Initrd {
+		return false
+	}
+	if a.Cmdline != b.Cmdline {
+		return false
+	}
+	if a.DTB != b.DTB {
+		return false
+	}
+	if !a.ACPI.Equal(b.ACPI) {
+		return false
+	}
+	if len(a.BootDevices) != len(b.BootDevices) {
+		return false
+	}
+	for i := range a.BootDevices {
+		if !a.BootDevices[i].Equal(&b.BootDevices[i]) {
+			return false
+		}
+	}
+	if !a.BootMenu.Equal(b.BootMenu) {
+		return false
+	}
+	if !a.BIOS.Equal(b.BIOS) {
+		return false
+	}
+	if !a.SMBios.Equal(b.SMBios) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainOS) canonicalize() {
+	if in.Type != nil {
+		in.Type.canonicalize()
+	}
+	if len(in.InitArgs) == 0 {
+		in.InitArgs = nil
+	}
+	for i := range in.InitEnv {
+		in.InitEnv[i].canonicalize()
+	}
+	if len(in.InitEnv) == 0 {
+		in.InitEnv = nil
+	}
+	if in.Loader != nil {
+		in.Loader.canonicalize()
+	}
+	if in.NVRam != nil {
+		in.NVRam.canonicalize()
+	}
+	if in.ACPI != nil {
+		in.ACPI.canonicalize()
+		if in.ACPI.empty() {
+			in.ACPI = nil
+		}
+	}
+	for i := range in.BootDevices {
+		in.BootDevices[i].canonicalize()
+	}
+	if len(in.BootDevices) == 0 {
+		in.BootDevices = nil
+	}
+	if in.BootMenu != nil {
+		in.BootMenu.canonicalize()
+	}
+	if in.BIOS != nil {
+		in.BIOS.canonicalize()
+	}
+	if in.SMBios != nil {
+		in.SMBios.canonicalize()
+	}
+}
+
+func (in *DomainOSInitEnv) DeepCopy() *DomainOSInitEnv {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainOSInitEnv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainOSInitEnv) DeepCopyInto(out *DomainOSInitEnv) {
+	*out = *in
+}
+
+func (a *DomainOSInitEnv) Equal(b *DomainOSInitEnv) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainOSInitEnv) canonicalize() {
+}
+
+func (in *DomainOSType) DeepCopy() *DomainOSType {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainOSType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainOSType) DeepCopyInto(out *DomainOSType) {
+	*out = *in
+}
+
+func (a *DomainOSType) Equal(b *DomainOSType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Arch != b.Arch {
+		return false
+	}
+	if a.Machine != b.Machine {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainOSType) canonicalize() {
+}
+
+func (in *DomainPM) DeepCopy() *DomainPM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainPM) DeepCopyInto(out *DomainPM) {
+	*out = *in
+	if in.SuspendToMem != nil {
+		out.SuspendToMem = in.SuspendToMem.DeepCopy()
+	}
+	if in.SuspendToDisk != nil {
+		out.SuspendToDisk = in.SuspendToDisk.DeepCopy()
+	}
+}
+
+func (a *DomainPM) Equal(b *DomainPM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SuspendToMem.Equal(b.SuspendToMem) {
+		return false
+	}
+	if !a.SuspendToDisk.Equal(b.SuspendToDisk) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainPM) canonicalize() {
+	if in.SuspendToMem != nil {
+		in.SuspendToMem.canonicalize()
+	}
+	if in.SuspendToDisk != nil {
+		in.SuspendToDisk.canonicalize()
+	}
+}
+
+func (in *DomainPM) empty() bool {
+	return in.SuspendToMem == nil &&
+		in.SuspendToDisk == nil
+}
+
+func (in *DomainPMPolicy) DeepCopy() *DomainPMPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPMPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainPMPolicy) DeepCopyInto(out *DomainPMPolicy) {
+	*out = *in
+}
+
+func (a *DomainPMPolicy) Equal(b *DomainPMPolicy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	return true
+}
+
+func (in *DomainPMPolicy) canonicalize() {
+}
+
+func (in *DomainPanic) DeepCopy() *DomainPanic {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPanic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainPanic) DeepCopyInto(out *DomainPanic) {
+	*out = *in
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainPanic) Equal(b *DomainPanic) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainPanic) canonicalize() {
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainPanic) Canonicalize() *DomainPanic {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainPanic) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainPanic) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainPanic) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainPanic) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainPanic) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainPanic) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainPanic) schemaRef() (string, string) {
+	return "domain.rng", "panic"
+}
+
+func (in *DomainParallel) DeepCopy() *DomainParallel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainParallel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainParallel) DeepCopyInto(out *DomainParallel) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Log != nil {
+		out.Log = in.Log.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainParallel) Equal(b *DomainParallel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Log.Equal(b.Log) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainParallel) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Log != nil {
+		in.Log.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainParallel) Canonicalize() *DomainParallel {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainParallel) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainParallel) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainParallel) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainParallel) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainParallel) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainParallel) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainParallel) schemaRef() (string, string) {
+	return "domain.rng", "parallel"
+}
+
+func (in *DomainParallelTarget) DeepCopy() *DomainParallelTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainParallelTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainParallelTarget) DeepCopyInto(out *DomainParallelTarget) {
+	*out = *in
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+}
+
+func (a *DomainParallelTarget) Equal(b *DomainParallelTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainParallelTarget) canonicalize() {
+}
+
+func (in *DomainPerf) DeepCopy() *DomainPerf {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPerf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainPerf) DeepCopyInto(out *DomainPerf) {
+	*out = *in
+	if in.Events != nil {
+		out.Events = make([]DomainPerfEvent, len(in.Events))
+		for i := range in.Events {
+			in.Events[i].DeepCopyInto(&out.Events[i])
+		}
+	}
+}
+
+func (a *DomainPerf) Equal(b *DomainPerf) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Events) != len(b.Events) {
+		return false
+	}
+	for i := range a.Events {
+		if !a.Events[i].Equal(&b.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainPerf) canonicalize() {
+	for i := range in.Events {
+		in.Events[i].canonicalize()
+	}
+	if len(in.Events) == 0 {
+		in.Events = nil
+	}
+}
+
+func (in *DomainPerf) empty() bool {
+	return len(in.Events) == 0
+}
+
+func (in *DomainPerfEvent) DeepCopy() *DomainPerfEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPerfEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainPerfEvent) DeepCopyInto(out *DomainPerfEvent) {
+	*out = *in
+}
+
+func (a *DomainPerfEvent) Equal(b *DomainPerfEvent) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	return true
+}
+
+func (in *DomainPerfEvent) canonicalize() {
+}
+
+func (in *DomainQEMUCommandline) DeepCopy() *DomainQEMUCommandline {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainQEMUCommandline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainQEMUCommandline) DeepCopyInto(out *DomainQEMUCommandline) {
+	*out = *in
+	if in.Args != nil {
+		out.Args = make([]DomainQEMUCommandlineArg, len(in.Args))
+		for i := range in.Args {
+			in.Args[i].DeepCopyInto(&out.Args[i])
+		}
+	}
+	if in.Envs != nil {
+		out.Envs = make([]DomainQEMUCommandlineEnv, len(in.Envs))
+		for i := range in.Envs {
+			in.Envs[i].DeepCopyInto(&out.Envs[i])
+		}
+	}
+}
+
+func (a *DomainQEMUCommandline) Equal(b *DomainQEMUCommandline) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Args) != len(b.Args) {
+		return false
+	}
+	for i := range a.Args {
+		if !a.Args[i].Equal(&b.Args[i]) {
+			return false
+		}
+	}
+	if len(a.Envs) != len(b.Envs) {
+		return false
+	}
+	for i := range a.Envs {
+		if !a.Envs[i].Equal(&b.Envs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainQEMUCommandline) canonicalize() {
+	for i := range in.Args {
+		in.Args[i].canonicalize()
+	}
+	if len(in.Args) == 0 {
+		in.Args = nil
+	}
+	for i := range in.Envs {
+		in.Envs[i].canonicalize()
+	}
+	if len(in.Envs) == 0 {
+		in.Envs = nil
+	}
+}
+
+func (in *DomainQEMUCommandline) empty() bool {
+	return len(in.Args) == 0 &&
+		len(in.Envs) == 0
+}
+
+func (in *DomainQEMUCommandlineArg) DeepCopy() *DomainQEMUCommandlineArg {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainQEMUCommandlineArg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainQEMUCommandlineArg) DeepCopyInto(out *DomainQEMUCommandlineArg) {
+	*out = *in
+}
+
+func (a *DomainQEMUCommandlineArg) Equal(b *DomainQEMUCommandlineArg) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainQEMUCommandlineArg) canonicalize() {
+}
+
+func (in *DomainQEMUCommandlineEnv) DeepCopy() *DomainQEMUCommandlineEnv {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainQEMUCommandlineEnv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainQEMUCommandlineEnv) DeepCopyInto(out *DomainQEMUCommandlineEnv) {
+	*out = *in
+}
+
+func (a *DomainQEMUCommandlineEnv) Equal(b *DomainQEMUCommandlineEnv) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainQEMUCommandlineEnv) canonicalize() {
+}
+
+func (in *DomainRNG) DeepCopy() *DomainRNG {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRNG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRNG) DeepCopyInto(out *DomainRNG) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Rate != nil {
+		out.Rate = in.Rate.DeepCopy()
+	}
+	if in.Backend != nil {
+		out.Backend = in.Backend.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainRNG) Equal(b *DomainRNG) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Rate.Equal(b.Rate) {
+		return false
+	}
+	if !a.Backend.Equal(b.Backend) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRNG) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Rate != nil {
+		in.Rate.canonicalize()
+	}
+	if in.Backend != nil {
+		in.Backend.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainRNG) Canonicalize() *DomainRNG {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainRNG) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainRNG) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainRNG) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainRNG) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainRNG) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainRNG) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainRNG) schemaRef() (string, string) {
+	return "domain.rng", "rng"
+}
+
+func (in *DomainRNGBackend) DeepCopy() *DomainRNGBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRNGBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRNGBackend) DeepCopyInto(out *DomainRNGBackend) {
+	*out = *in
+	if in.Random != nil {
+		out.Random = in.Random.DeepCopy()
+	}
+	if in.EGD != nil {
+		out.EGD = in.EGD.DeepCopy()
+	}
+}
+
+func (a *DomainRNGBackend) Equal(b *DomainRNGBackend) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Random.Equal(b.Random) {
+		return false
+	}
+	if !a.EGD.Equal(b.EGD) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRNGBackend) canonicalize() {
+	if in.Random != nil {
+		in.Random.canonicalize()
+	}
+	if in.EGD != nil {
+		in.EGD.canonicalize()
+	}
+}
+
+func (in *DomainRNGBackendEGD) DeepCopy() *DomainRNGBackendEGD {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRNGBackendEGD)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRNGBackendEGD) DeepCopyInto(out *DomainRNGBackendEGD) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+}
+
+func (a *DomainRNGBackendEGD) Equal(b *DomainRNGBackendEGD) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRNGBackendEGD) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+}
+
+func (in *DomainRNGBackendEGD) empty() bool {
+	return in.Source == nil &&
+		in.Protocol == nil
+}
+
+func (in *DomainRNGBackendRandom) DeepCopy() *DomainRNGBackendRandom {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRNGBackendRandom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRNGBackendRandom) DeepCopyInto(out *DomainRNGBackendRandom) {
+	*out = *in
+}
+
+func (a *DomainRNGBackendRandom) Equal(b *DomainRNGBackendRandom) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Device != b.Device {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRNGBackendRandom) canonicalize() {
+}
+
+func (in *DomainRNGDriver) DeepCopy() *DomainRNGDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRNGDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRNGDriver) DeepCopyInto(out *DomainRNGDriver) {
+	*out = *in
+}
+
+func (a *DomainRNGDriver) Equal(b *DomainRNGDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRNGDriver) canonicalize() {
+}
+
+func (in *DomainRNGRate) DeepCopy() *DomainRNGRate {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRNGRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRNGRate) DeepCopyInto(out *DomainRNGRate) {
+	*out = *in
+}
+
+func (a *DomainRNGRate) Equal(b *DomainRNGRate) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Bytes != b.Bytes {
+		return false
+	}
+	if a.Period != b.Period {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRNGRate) canonicalize() {
+}
+
+func (in *DomainROM) DeepCopy() *DomainROM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainROM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainROM) DeepCopyInto(out *DomainROM) {
+	*out = *in
+}
+
+func (a *DomainROM) Equal(b *DomainROM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Bar != b.Bar {
+		return false
+	}
+	if a.File != b.File {
+		return false
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	return true
+}
+
+func (in *DomainROM) canonicalize() {
+}
+
+func (in *DomainRedirDev) DeepCopy() *DomainRedirDev {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRedirDev)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRedirDev) DeepCopyInto(out *DomainRedirDev) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+	if in.Boot != nil {
+		out.Boot = in.Boot.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainRedirDev) Equal(b *DomainRedirDev) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Bus != b.Bus {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	if !a.Boot.Equal(b.Boot) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRedirDev) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+	if in.Boot != nil {
+		in.Boot.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainRedirDev) Canonicalize() *DomainRedirDev {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainRedirDev) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainRedirDev) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainRedirDev) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainRedirDev) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainRedirDev) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainRedirDev) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainRedirDev) schemaRef() (string, string) {
+	return "domain.rng", "redirdev"
+}
+
+func (in *DomainRedirFilter) DeepCopy() *DomainRedirFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRedirFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRedirFilter) DeepCopyInto(out *DomainRedirFilter) {
+	*out = *in
+	if in.USB != nil {
+		out.USB = make([]DomainRedirFilterUSB, len(in.USB))
+		for i := range in.USB {
+			in.USB[i].DeepCopyInto(&out.USB[i])
+		}
+	}
+}
+
+func (a *DomainRedirFilter) Equal(b *DomainRedirFilter) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.USB) != len(b.USB) {
+		return false
+	}
+	for i := range a.USB {
+		if !a.USB[i].Equal(&b.USB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainRedirFilter) canonicalize() {
+	for i := range in.USB {
+		in.USB[i].canonicalize()
+	}
+	if len(in.USB) == 0 {
+		in.USB = nil
+	}
+}
+
+func (in *DomainRedirFilter) empty() bool {
+	return len(in.USB) == 0
+}
+
+func (d *DomainRedirFilter) Canonicalize() *DomainRedirFilter {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainRedirFilter) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainRedirFilter) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainRedirFilter) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainRedirFilter) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainRedirFilter) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainRedirFilter) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainRedirFilter) schemaRef() (string, string) {
+	return "domain.rng", "redirfilter"
+}
+
+func (in *DomainRedirFilterUSB) DeepCopy() *DomainRedirFilterUSB {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRedirFilterUSB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRedirFilterUSB) DeepCopyInto(out *DomainRedirFilterUSB) {
+	*out = *in
+	if in.Class != nil {
+		v := *in.Class
+		out.Class = &v
+	}
+	if in.Vendor != nil {
+		v := *in.Vendor
+		out.Vendor = &v
+	}
+	if in.Product != nil {
+		v := *in.Product
+		out.Product = &v
+	}
+}
+
+func (a *DomainRedirFilterUSB) Equal(b *DomainRedirFilterUSB) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Class == nil) != (b.Class == nil) || (a.Class != nil && *a.Class != *b.Class) {
+		return false
+	}
+	if (a.Vendor == nil) != (b.Vendor == nil) || (a.Vendor != nil && *a.Vendor != *b.Vendor) {
+		return false
+	}
+	if (a.Product == nil) != (b.Product == nil) || (a.Product != nil && *a.Product != *b.Product) {
+		return false
+	}
+	if a.Version != b.Version {
+		return false
+	}
+	if a.Allow != b.Allow {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRedirFilterUSB) canonicalize() {
+}
+
+func (in *DomainResource) DeepCopy() *DomainResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainResource) DeepCopyInto(out *DomainResource) {
+	*out = *in
+}
+
+func (a *DomainResource) Equal(b *DomainResource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Partition != b.Partition {
+		return false
+	}
+	return true
+}
+
+func (in *DomainResource) canonicalize() {
+}
+
+func (in *DomainRoute) DeepCopy() *DomainRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainRoute) DeepCopyInto(out *DomainRoute) {
+	*out = *in
+}
+
+func (a *DomainRoute) Equal(b *DomainRoute) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Gateway != b.Gateway {
+		return false
+	}
+	return true
+}
+
+func (in *DomainRoute) canonicalize() {
+}
+
+func (in *DomainSMBios) DeepCopy() *DomainSMBios {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSMBios)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSMBios) DeepCopyInto(out *DomainSMBios) {
+	*out = *in
+}
+
+func (a *DomainSMBios) Equal(b *DomainSMBios) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSMBios) canonicalize() {
+}
+
+func (in *DomainSecLabel) DeepCopy() *DomainSecLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSecLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSecLabel) DeepCopyInto(out *DomainSecLabel) {
+	*out = *in
+}
+
+func (a *DomainSecLabel) Equal(b *DomainSecLabel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.Relabel != b.Relabel {
+		return false
+	}
+	if a.Label != b.Label {
+		return false
+	}
+	if a.ImageLabel != b.ImageLabel {
+		return false
+	}
+	if a.BaseLabel != b.BaseLabel {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSecLabel) canonicalize() {
+}
+
+func (in *DomainSerial) DeepCopy() *DomainSerial {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSerial)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSerial) DeepCopyInto(out *DomainSerial) {
+	*out = *in
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Log != nil {
+		out.Log = in.Log.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainSerial) Equal(b *DomainSerial) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Log.Equal(b.Log) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSerial) canonicalize() {
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Log != nil {
+		in.Log.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainSerial) Canonicalize() *DomainSerial {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainSerial) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainSerial) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainSerial) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainSerial) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainSerial) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainSerial) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainSerial) schemaRef() (string, string) {
+	return "domain.rng", "serial"
+}
+
+func (in *DomainSerialTarget) DeepCopy() *DomainSerialTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSerialTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSerialTarget) DeepCopyInto(out *DomainSerialTarget) {
+	*out = *in
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+	if in.Model != nil {
+		out.Model = in.Model.DeepCopy()
+	}
+}
+
+func (a *DomainSerialTarget) Equal(b *DomainSerialTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	if !a.Model.Equal(b.Model) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSerialTarget) canonicalize() {
+	if in.Model != nil {
+		in.Model.canonicalize()
+	}
+}
+
+func (in *DomainSerialTargetModel) DeepCopy() *DomainSerialTargetModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSerialTargetModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSerialTargetModel) DeepCopyInto(out *DomainSerialTargetModel) {
+	*out = *in
+}
+
+func (a *DomainSerialTargetModel) Equal(b *DomainSerialTargetModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSerialTargetModel) canonicalize() {
+}
+
+func (in *DomainShmem) DeepCopy() *DomainShmem {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainShmem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainShmem) DeepCopyInto(out *DomainShmem) {
+	*out = *in
+	if in.Size != nil {
+		out.Size = in.Size.DeepCopy()
+	}
+	if in.Model != nil {
+		out.Model = in.Model.DeepCopy()
+	}
+	if in.Server != nil {
+		out.Server = in.Server.DeepCopy()
+	}
+	if in.MSI != nil {
+		out.MSI = in.MSI.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainShmem) Equal(b *DomainShmem) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if !a.Size.Equal(b.Size) {
+		return false
+	}
+	if !a.Model.Equal(b.Model) {
+		return false
+	}
+	if !a.Server.Equal(b.Server) {
+		return false
+	}
+	if !a.MSI.Equal(b.MSI) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainShmem) canonicalize() {
+	if in.Size != nil {
+		in.Size.canonicalize()
+	}
+	if in.Model != nil {
+		in.Model.canonicalize()
+	}
+	if in.Server != nil {
+		in.Server.canonicalize()
+	}
+	if in.MSI != nil {
+		in.MSI.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainShmem) Canonicalize() *DomainShmem {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainShmem) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainShmem) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainShmem) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainShmem) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainShmem) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainShmem) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainShmem) schemaRef() (string, string) {
+	return "domain.rng", "shmem"
+}
+
+func (in *DomainShmemMSI) DeepCopy() *DomainShmemMSI {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainShmemMSI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainShmemMSI) DeepCopyInto(out *DomainShmemMSI) {
+	*out = *in
+}
+
+func (a *DomainShmemMSI) Equal(b *DomainShmemMSI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	if a.Vectors != b.Vectors {
+		return false
+	}
+	if a.IOEventFD != b.IOEventFD {
+		return false
+	}
+	return true
+}
+
+func (in *DomainShmemMSI) canonicalize() {
+}
+
+func (in *DomainShmemModel) DeepCopy() *DomainShmemModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainShmemModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainShmemModel) DeepCopyInto(out *DomainShmemModel) {
+	*out = *in
+}
+
+func (a *DomainShmemModel) Equal(b *DomainShmemModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainShmemModel) canonicalize() {
+}
+
+func (in *DomainShmemServer) DeepCopy() *DomainShmemServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainShmemServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainShmemServer) DeepCopyInto(out *DomainShmemServer) {
+	*out = *in
+}
+
+func (a *DomainShmemServer) Equal(b *DomainShmemServer) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *DomainShmemServer) canonicalize() {
+}
+
+func (in *DomainShmemSize) DeepCopy() *DomainShmemSize {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainShmemSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainShmemSize) DeepCopyInto(out *DomainShmemSize) {
+	*out = *in
+}
+
+func (a *DomainShmemSize) Equal(b *DomainShmemSize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "B") {
+		return false
+	}
+	return true
+}
+
+func (in *DomainShmemSize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Value = uint(canonicalScaled(uint64(in.Value), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *DomainSmartcard) DeepCopy() *DomainSmartcard {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSmartcard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSmartcard) DeepCopyInto(out *DomainSmartcard) {
+	*out = *in
+	if in.Passthrough != nil {
+		out.Passthrough = in.Passthrough.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = in.Protocol.DeepCopy()
+	}
+	if in.Host != nil {
+		out.Host = in.Host.DeepCopy()
+	}
+	if in.HostCerts != nil {
+		out.HostCerts = make([]DomainSmartcardHostCert, len(in.HostCerts))
+		for i := range in.HostCerts {
+			in.HostCerts[i].DeepCopyInto(&out.HostCerts[i])
+		}
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainSmartcard) Equal(b *DomainSmartcard) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Passthrough.Equal(b.Passthrough) {
+		return false
+	}
+	if !a.Protocol.Equal(b.Protocol) {
+		return false
+	}
+	if !a.Host.Equal(b.Host) {
+		return false
+	}
+	if len(a.HostCerts) != len(b.HostCerts) {
+		return false
+	}
+	for i := range a.HostCerts {
+		if !a.HostCerts[i].Equal(&b.HostCerts[i]) {
+			return false
+		}
+	}
+	if a.Database != b.Database {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSmartcard) canonicalize() {
+	if in.Passthrough != nil {
+		in.Passthrough.canonicalize()
+	}
+	if in.Protocol != nil {
+		in.Protocol.canonicalize()
+	}
+	if in.Host != nil {
+		in.Host.canonicalize()
+	}
+	for i := range in.HostCerts {
+		in.HostCerts[i].canonicalize()
+	}
+	if len(in.HostCerts) == 0 {
+		in.HostCerts = nil
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainSmartcard) Canonicalize() *DomainSmartcard {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainSmartcard) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainSmartcard) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainSmartcard) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainSmartcard) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainSmartcard) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainSmartcard) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainSmartcard) schemaRef() (string, string) {
+	return "domain.rng", "smartcard"
+}
+
+func (in *DomainSmartcardHost) DeepCopy() *DomainSmartcardHost {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSmartcardHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSmartcardHost) DeepCopyInto(out *DomainSmartcardHost) {
+	*out = *in
+}
+
+func (a *DomainSmartcardHost) Equal(b *DomainSmartcardHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *DomainSmartcardHost) canonicalize() {
+}
+
+func (in *DomainSmartcardHostCert) DeepCopy() *DomainSmartcardHostCert {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSmartcardHostCert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSmartcardHostCert) DeepCopyInto(out *DomainSmartcardHostCert) {
+	*out = *in
+}
+
+func (a *DomainSmartcardHostCert) Equal(b *DomainSmartcardHostCert) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.File != b.File {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSmartcardHostCert) canonicalize() {
+}
+
+func (in *DomainSnapshot) DeepCopy() *DomainSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSnapshot) DeepCopyInto(out *DomainSnapshot) {
+	*out = *in
+	if in.Parent != nil {
+		out.Parent = in.Parent.DeepCopy()
+	}
+	if in.Memory != nil {
+		out.Memory = in.Memory.DeepCopy()
+	}
+	if in.Disks != nil {
+		out.Disks = in.Disks.DeepCopy()
+	}
+	if in.Domain != nil {
+		out.Domain = in.Domain.DeepCopy()
+	}
+	if in.Active != nil {
+		v := *in.Active
+		out.Active = &v
+	}
+}
+
+func (a *DomainSnapshot) Equal(b *DomainSnapshot) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if a.State != b.State {
+		return false
+	}
+	if a.CreationTime != b.CreationTime {
+		return false
+	}
+	if !a.Parent.Equal(b.Parent) {
+		return false
+	}
+	if !a.Memory.Equal(b.Memory) {
+		return false
+	}
+	if !a.Disks.Equal(b.Disks) {
+		return false
+	}
+	if !a.Domain.Equal(b.Domain) {
+		return false
+	}
+	if (a.Active == nil) != (b.Active == nil) || (a.Active != nil && *a.Active != *b.Active) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSnapshot) canonicalize() {
+	if in.Parent != nil {
+		in.Parent.canonicalize()
+	}
+	if in.Memory != nil {
+		in.Memory.canonicalize()
+	}
+	if in.Disks != nil {
+		in.Disks.canonicalize()
+		if in.Disks.empty() {
+			in.Disks = nil
+		}
+	}
+	if in.Domain != nil {
+		in.Domain.canonicalize()
+	}
+}
+
+func (d *DomainSnapshot) Canonicalize() *DomainSnapshot {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainSnapshot) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainSnapshot) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainSnapshot) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainSnapshot) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainSnapshot) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainSnapshot) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainSnapshot) schemaRef() (string, string) {
+	return "domainsnapshot.rng", ""
+}
+
+func (in *DomainSnapshotDisk) DeepCopy() *DomainSnapshotDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSnapshotDisk) DeepCopyInto(out *DomainSnapshotDisk) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *DomainSnapshotDisk) Equal(b *DomainSnapshotDisk) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Snapshot != b.Snapshot {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSnapshotDisk) canonicalize() {
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (in *DomainSnapshotDiskDriver) DeepCopy() *DomainSnapshotDiskDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDiskDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSnapshotDiskDriver) DeepCopyInto(out *DomainSnapshotDiskDriver) {
+	*out = *in
+}
+
+func (a *DomainSnapshotDiskDriver) Equal(b *DomainSnapshotDiskDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSnapshotDiskDriver) canonicalize() {
+}
+
+func (in *DomainSnapshotDisks) DeepCopy() *DomainSnapshotDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSnapshotDisks) DeepCopyInto(out *DomainSnapshotDisks) {
+	*out = *in
+	if in.Disks != nil {
+		out.Disks = make([]DomainSnapshotDisk, len(in.Disks))
+		for i := range in.Disks {
+			in.Disks[i].DeepCopyInto(&out.Disks[i])
+		}
+	}
+}
+
+func (a *DomainSnapshotDisks) Equal(b *DomainSnapshotDisks) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Disks) != len(b.Disks) {
+		return false
+	}
+	for i := range a.Disks {
+		if !a.Disks[i].Equal(&b.Disks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSnapshotDisks) canonicalize() {
+	for i := range in.Disks {
+		in.Disks[i].canonicalize()
+	}
+	if len(in.Disks) == 0 {
+		in.Disks = nil
+	}
+}
+
+func (in *DomainSnapshotDisks) empty() bool {
+	return len(in.Disks) == 0
+}
+
+func (in *DomainSnapshotMemory) DeepCopy() *DomainSnapshotMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSnapshotMemory) DeepCopyInto(out *DomainSnapshotMemory) {
+	*out = *in
+}
+
+func (a *DomainSnapshotMemory) Equal(b *DomainSnapshotMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Snapshot != b.Snapshot {
+		return false
+	}
+	if a.File != b.File {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSnapshotMemory) canonicalize() {
+}
+
+func (in *DomainSnapshotParent) DeepCopy() *DomainSnapshotParent {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotParent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSnapshotParent) DeepCopyInto(out *DomainSnapshotParent) {
+	*out = *in
+}
+
+func (a *DomainSnapshotParent) Equal(b *DomainSnapshotParent) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSnapshotParent) canonicalize() {
+}
+
+func (in *DomainSound) DeepCopy() *DomainSound {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSound)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSound) DeepCopyInto(out *DomainSound) {
+	*out = *in
+	if in.Codec != nil {
+		out.Codec = make([]DomainSoundCodec, len(in.Codec))
+		for i := range in.Codec {
+			in.Codec[i].DeepCopyInto(&out.Codec[i])
+		}
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainSound) Equal(b *DomainSound) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if len(a.Codec) != len(b.Codec) {
+		return false
+	}
+	for i := range a.Codec {
+		if !a.Codec[i].Equal(&b.Codec[i]) {
+			return false
+		}
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSound) canonicalize() {
+	for i := range in.Codec {
+		in.Codec[i].canonicalize()
+	}
+	if len(in.Codec) == 0 {
+		in.Codec = nil
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainSound) Canonicalize() *DomainSound {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainSound) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainSound) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainSound) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainSound) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainSound) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainSound) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainSound) schemaRef() (string, string) {
+	return "domain.rng", "sound"
+}
+
+func (in *DomainSoundCodec) DeepCopy() *DomainSoundCodec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSoundCodec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSoundCodec) DeepCopyInto(out *DomainSoundCodec) {
+	*out = *in
+}
+
+func (a *DomainSoundCodec) Equal(b *DomainSoundCodec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSoundCodec) canonicalize() {
+}
+
+func (in *DomainSysInfo) DeepCopy() *DomainSysInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfo) DeepCopyInto(out *DomainSysInfo) {
+	*out = *in
+	if in.BIOS != nil {
+		out.BIOS = in.BIOS.DeepCopy()
+	}
+	if in.System != nil {
+		out.System = in.System.DeepCopy()
+	}
+	if in.BaseBoard != nil {
+		out.BaseBoard = make([]DomainSysInfoBaseBoard, len(in.BaseBoard))
+		for i := range in.BaseBoard {
+			in.BaseBoard[i].DeepCopyInto(&out.BaseBoard[i])
+		}
+	}
+	if in.Chassis != nil {
+		out.Chassis = in.Chassis.DeepCopy()
+	}
+	if in.Processor != nil {
+		out.Processor = make([]DomainSysInfoProcessor, len(in.Processor))
+		for i := range in.Processor {
+			in.Processor[i].DeepCopyInto(&out.Processor[i])
+		}
+	}
+	if in.Memory != nil {
+		out.Memory = make([]DomainSysInfoMemory, len(in.Memory))
+		for i := range in.Memory {
+			in.Memory[i].DeepCopyInto(&out.Memory[i])
+		}
+	}
+	if in.OEMStrings != nil {
+		out.OEMStrings = in.OEMStrings.DeepCopy()
+	}
+}
+
+func (a *DomainSysInfo) Equal(b *DomainSysInfo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if !a.BIOS.Equal(b.BIOS) {
+		return false
+	}
+	if !a.System.Equal(b.System) {
+		return false
+	}
+	if len(a.BaseBoard) != len(b.BaseBoard) {
+		return false
+	}
+	for i := range a.BaseBoard {
+		if !a.BaseBoard[i].Equal(&b.BaseBoard[i]) {
+			return false
+		}
+	}
+	if !a.Chassis.Equal(b.Chassis) {
+		return false
+	}
+	if len(a.Processor) != len(b.Processor) {
+		return false
+	}
+	for i := range a.Processor {
+		if !a.Processor[i].Equal(&b.Processor[i]) {
+			return false
+		}
+	}
+	if len(a.Memory) != len(b.Memory) {
+		return false
+	}
+	for i := range a.Memory {
+		if !a.Memory[i].Equal(&b.Memory[i]) {
+			return false
+		}
+	}
+	if !a.OEMStrings.Equal(b.OEMStrings) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSysInfo) canonicalize() {
+	if in.BIOS != nil {
+		in.BIOS.canonicalize()
+		if in.BIOS.empty() {
+			in.BIOS = nil
+		}
+	}
+	if in.System != nil {
+		in.System.canonicalize()
+		if in.System.empty() {
+			in.System = nil
+		}
+	}
+	for i := range in.BaseBoard {
+		in.BaseBoard[i].canonicalize()
+	}
+	if len(in.BaseBoard) == 0 {
+		in.BaseBoard = nil
+	}
+	if in.Chassis != nil {
+		in.Chassis.canonicalize()
+		if in.Chassis.empty() {
+			in.Chassis = nil
+		}
+	}
+	for i := range in.Processor {
+		in.Processor[i].canonicalize()
+	}
+	if len(in.Processor) == 0 {
+		in.Processor = nil
+	}
+	for i := range in.Memory {
+		in.Memory[i].canonicalize()
+	}
+	if len(in.Memory) == 0 {
+		in.Memory = nil
+	}
+	if in.OEMStrings != nil {
+		in.OEMStrings.canonicalize()
+		if in.OEMStrings.empty() {
+			in.OEMStrings = nil
+		}
+	}
+}
+
+func (in *DomainSysInfoBIOS) DeepCopy() *DomainSysInfoBIOS {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoBIOS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoBIOS) DeepCopyInto(out *DomainSysInfoBIOS) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]DomainSysInfoEntry, len(in.Entry))
+		for i := range in.Entry {
+			in.Entry[i].DeepCopyInto(&out.Entry[i])
+		}
+	}
+}
+
+func (a *DomainSysInfoBIOS) Equal(b *DomainSysInfoBIOS) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if !a.Entry[i].Equal(&b.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoBIOS) canonicalize() {
+	for i := range in.Entry {
+		in.Entry[i].canonicalize()
+	}
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoBIOS) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainSysInfoBaseBoard) DeepCopy() *DomainSysInfoBaseBoard {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoBaseBoard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoBaseBoard) DeepCopyInto(out *DomainSysInfoBaseBoard) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]DomainSysInfoEntry, len(in.Entry))
+		for i := range in.Entry {
+			in.Entry[i].DeepCopyInto(&out.Entry[i])
+		}
+	}
+}
+
+func (a *DomainSysInfoBaseBoard) Equal(b *DomainSysInfoBaseBoard) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if !a.Entry[i].Equal(&b.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoBaseBoard) canonicalize() {
+	for i := range in.Entry {
+		in.Entry[i].canonicalize()
+	}
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoBaseBoard) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainSysInfoChassis) DeepCopy() *DomainSysInfoChassis {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoChassis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoChassis) DeepCopyInto(out *DomainSysInfoChassis) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]DomainSysInfoEntry, len(in.Entry))
+		for i := range in.Entry {
+			in.Entry[i].DeepCopyInto(&out.Entry[i])
+		}
+	}
+}
+
+func (a *DomainSysInfoChassis) Equal(b *DomainSysInfoChassis) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if !a.Entry[i].Equal(&b.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoChassis) canonicalize() {
+	for i := range in.Entry {
+		in.Entry[i].canonicalize()
+	}
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoChassis) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainSysInfoEntry) DeepCopy() *DomainSysInfoEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoEntry) DeepCopyInto(out *DomainSysInfoEntry) {
+	*out = *in
+}
+
+func (a *DomainSysInfoEntry) Equal(b *DomainSysInfoEntry) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainSysInfoEntry) canonicalize() {
+}
+
+func (in *DomainSysInfoMemory) DeepCopy() *DomainSysInfoMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoMemory) DeepCopyInto(out *DomainSysInfoMemory) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]DomainSysInfoEntry, len(in.Entry))
+		for i := range in.Entry {
+			in.Entry[i].DeepCopyInto(&out.Entry[i])
+		}
+	}
+}
+
+func (a *DomainSysInfoMemory) Equal(b *DomainSysInfoMemory) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if !a.Entry[i].Equal(&b.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoMemory) canonicalize() {
+	for i := range in.Entry {
+		in.Entry[i].canonicalize()
+	}
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoMemory) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainSysInfoOEMStrings) DeepCopy() *DomainSysInfoOEMStrings {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoOEMStrings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoOEMStrings) DeepCopyInto(out *DomainSysInfoOEMStrings) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]string, len(in.Entry))
+		copy(out.Entry, in.Entry)
+	}
+}
+
+func (a *DomainSysInfoOEMStrings) Equal(b *DomainSysInfoOEMStrings) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if a.Entry[i] != b.Entry[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoOEMStrings) canonicalize() {
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoOEMStrings) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainSysInfoProcessor) DeepCopy() *DomainSysInfoProcessor {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoProcessor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoProcessor) DeepCopyInto(out *DomainSysInfoProcessor) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]DomainSysInfoEntry, len(in.Entry))
+		for i := range in.Entry {
+			in.Entry[i].DeepCopyInto(&out.Entry[i])
+		}
+	}
+}
+
+func (a *DomainSysInfoProcessor) Equal(b *DomainSysInfoProcessor) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if !a.Entry[i].Equal(&b.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoProcessor) canonicalize() {
+	for i := range in.Entry {
+		in.Entry[i].canonicalize()
+	}
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoProcessor) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainSysInfoSystem) DeepCopy() *DomainSysInfoSystem {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSysInfoSystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainSysInfoSystem) DeepCopyInto(out *DomainSysInfoSystem) {
+	*out = *in
+	if in.Entry != nil {
+		out.Entry = make([]DomainSysInfoEntry, len(in.Entry))
+		for i := range in.Entry {
+			in.Entry[i].DeepCopyInto(&out.Entry[i])
+		}
+	}
+}
+
+func (a *DomainSysInfoSystem) Equal(b *DomainSysInfoSystem) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Entry) != len(b.Entry) {
+		return false
+	}
+	for i := range a.Entry {
+		if !a.Entry[i].Equal(&b.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainSysInfoSystem) canonicalize() {
+	for i := range in.Entry {
+		in.Entry[i].canonicalize()
+	}
+	if len(in.Entry) == 0 {
+		in.Entry = nil
+	}
+}
+
+func (in *DomainSysInfoSystem) empty() bool {
+	return len(in.Entry) == 0
+}
+
+func (in *DomainTPM) DeepCopy() *DomainTPM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTPM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTPM) DeepCopyInto(out *DomainTPM) {
+	*out = *in
+	if in.Backend != nil {
+		out.Backend = in.Backend.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainTPM) Equal(b *DomainTPM) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if !a.Backend.Equal(b.Backend) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTPM) canonicalize() {
+	if in.Backend != nil {
+		in.Backend.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainTPM) Canonicalize() *DomainTPM {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainTPM) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainTPM) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainTPM) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainTPM) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainTPM) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainTPM) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainTPM) schemaRef() (string, string) {
+	return "domain.rng", "tpm"
+}
+
+func (in *DomainTPMBackend) DeepCopy() *DomainTPMBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTPMBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTPMBackend) DeepCopyInto(out *DomainTPMBackend) {
+	*out = *in
+	if in.Passthrough != nil {
+		out.Passthrough = in.Passthrough.DeepCopy()
+	}
+	if in.Emulator != nil {
+		out.Emulator = in.Emulator.DeepCopy()
+	}
+}
+
+func (a *DomainTPMBackend) Equal(b *DomainTPMBackend) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Passthrough.Equal(b.Passthrough) {
+		return false
+	}
+	if !a.Emulator.Equal(b.Emulator) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTPMBackend) canonicalize() {
+	if in.Passthrough != nil {
+		in.Passthrough.canonicalize()
+	}
+	if in.Emulator != nil {
+		in.Emulator.canonicalize()
+	}
+}
+
+func (in *DomainTPMBackendDevice) DeepCopy() *DomainTPMBackendDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTPMBackendDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTPMBackendDevice) DeepCopyInto(out *DomainTPMBackendDevice) {
+	*out = *in
+}
+
+func (a *DomainTPMBackendDevice) Equal(b *DomainTPMBackendDevice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTPMBackendDevice) canonicalize() {
+}
+
+func (in *DomainTPMBackendEmulator) DeepCopy() *DomainTPMBackendEmulator {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTPMBackendEmulator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTPMBackendEmulator) DeepCopyInto(out *DomainTPMBackendEmulator) {
+	*out = *in
+}
+
+func (a *DomainTPMBackendEmulator) Equal(b *DomainTPMBackendEmulator) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Version != b.Version {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTPMBackendEmulator) canonicalize() {
+}
+
+func (in *DomainTPMBackendPassthrough) DeepCopy() *DomainTPMBackendPassthrough {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTPMBackendPassthrough)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTPMBackendPassthrough) DeepCopyInto(out *DomainTPMBackendPassthrough) {
+	*out = *in
+	if in.Device != nil {
+		out.Device = in.Device.DeepCopy()
+	}
+}
+
+func (a *DomainTPMBackendPassthrough) Equal(b *DomainTPMBackendPassthrough) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Device.Equal(b.Device) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTPMBackendPassthrough) canonicalize() {
+	if in.Device != nil {
+		in.Device.canonicalize()
+	}
+}
+
+func (in *DomainTPMBackendPassthrough) empty() bool {
+	return in.Device == nil
+}
+
+func (in *DomainTimer) DeepCopy() *DomainTimer {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTimer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTimer) DeepCopyInto(out *DomainTimer) {
+	*out = *in
+	if in.CatchUp != nil {
+		out.CatchUp = in.CatchUp.DeepCopy()
+	}
+}
+
+func (a *DomainTimer) Equal(b *DomainTimer) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Track != b.Track {
+		return false
+	}
+	if a.TickPolicy != b.TickPolicy {
+		return false
+	}
+	if !a.CatchUp.Equal(b.CatchUp) {
+		return false
+	}
+	if a.Frequency != b.Frequency {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Present != b.Present {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTimer) canonicalize() {
+	if in.CatchUp != nil {
+		in.CatchUp.canonicalize()
+	}
+}
+
+func (in *DomainTimerCatchUp) DeepCopy() *DomainTimerCatchUp {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTimerCatchUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainTimerCatchUp) DeepCopyInto(out *DomainTimerCatchUp) {
+	*out = *in
+}
+
+func (a *DomainTimerCatchUp) Equal(b *DomainTimerCatchUp) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Threshold != b.Threshold {
+		return false
+	}
+	if a.Slew != b.Slew {
+		return false
+	}
+	if a.Limit != b.Limit {
+		return false
+	}
+	return true
+}
+
+func (in *DomainTimerCatchUp) canonicalize() {
+}
+
+func (in *DomainVCPU) DeepCopy() *DomainVCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVCPU) DeepCopyInto(out *DomainVCPU) {
+	*out = *in
+}
+
+func (a *DomainVCPU) Equal(b *DomainVCPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Placement != b.Placement {
+		return false
+	}
+	if a.CPUSet != b.CPUSet {
+		return false
+	}
+	if a.Current != b.Current {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVCPU) canonicalize() {
+}
+
+func (in *DomainVCPUs) DeepCopy() *DomainVCPUs {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVCPUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVCPUs) DeepCopyInto(out *DomainVCPUs) {
+	*out = *in
+	if in.VCPU != nil {
+		out.VCPU = make([]DomainVCPUsVCPU, len(in.VCPU))
+		for i := range in.VCPU {
+			in.VCPU[i].DeepCopyInto(&out.VCPU[i])
+		}
+	}
+}
+
+func (a *DomainVCPUs) Equal(b *DomainVCPUs) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.VCPU) != len(b.VCPU) {
+		return false
+	}
+	for i := range a.VCPU {
+		if !a.VCPU[i].Equal(&b.VCPU[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *DomainVCPUs) canonicalize() {
+	for i := range in.VCPU {
+		in.VCPU[i].canonicalize()
+	}
+	if len(in.VCPU) == 0 {
+		in.VCPU = nil
+	}
+}
+
+func (in *DomainVCPUs) empty() bool {
+	return len(in.VCPU) == 0
+}
+
+func (in *DomainVCPUsVCPU) DeepCopy() *DomainVCPUsVCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVCPUsVCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVCPUsVCPU) DeepCopyInto(out *DomainVCPUsVCPU) {
+	*out = *in
+	if in.Id != nil {
+		v := *in.Id
+		out.Id = &v
+	}
+	if in.Order != nil {
+		v := *in.Order
+		out.Order = &v
+	}
+}
+
+func (a *DomainVCPUsVCPU) Equal(b *DomainVCPUsVCPU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Id == nil) != (b.Id == nil) || (a.Id != nil && *a.Id != *b.Id) {
+		return false
+	}
+	if a.Enabled != b.Enabled {
+		return false
+	}
+	if a.Hotpluggable != b.Hotpluggable {
+		return false
+	}
+	if (a.Order == nil) != (b.Order == nil) || (a.Order != nil && *a.Order != *b.Order) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVCPUsVCPU) canonicalize() {
+}
+
+func (in *DomainVMWareDataCenterPath) DeepCopy() *DomainVMWareDataCenterPath {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVMWareDataCenterPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVMWareDataCenterPath) DeepCopyInto(out *DomainVMWareDataCenterPath) {
+	*out = *in
+}
+
+func (a *DomainVMWareDataCenterPath) Equal(b *DomainVMWareDataCenterPath) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVMWareDataCenterPath) canonicalize() {
+}
+
+func (in *DomainVSock) DeepCopy() *DomainVSock {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVSock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVSock) DeepCopyInto(out *DomainVSock) {
+	*out = *in
+	if in.CID != nil {
+		out.CID = in.CID.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainVSock) Equal(b *DomainVSock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if !a.CID.Equal(b.CID) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVSock) canonicalize() {
+	if in.CID != nil {
+		in.CID.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainVSock) Canonicalize() *DomainVSock {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainVSock) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainVSock) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainVSock) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainVSock) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainVSock) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainVSock) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainVSock) schemaRef() (string, string) {
+	return "domain.rng", "vsock"
+}
+
+func (in *DomainVSockCID) DeepCopy() *DomainVSockCID {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVSockCID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVSockCID) DeepCopyInto(out *DomainVSockCID) {
+	*out = *in
+}
+
+func (a *DomainVSockCID) Equal(b *DomainVSockCID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Auto != b.Auto {
+		return false
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVSockCID) canonicalize() {
+}
+
+func (in *DomainVideo) DeepCopy() *DomainVideo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVideo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVideo) DeepCopyInto(out *DomainVideo) {
+	*out = *in
+	in.Model.DeepCopyInto(&out.Model)
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainVideo) Equal(b *DomainVideo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Model.Equal(&b.Model) {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVideo) canonicalize() {
+	in.Model.canonicalize()
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainVideo) Canonicalize() *DomainVideo {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainVideo) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainVideo) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainVideo) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainVideo) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainVideo) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainVideo) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainVideo) schemaRef() (string, string) {
+	return "domain.rng", "video"
+}
+
+func (in *DomainVideoAccel) DeepCopy() *DomainVideoAccel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVideoAccel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVideoAccel) DeepCopyInto(out *DomainVideoAccel) {
+	*out = *in
+}
+
+func (a *DomainVideoAccel) Equal(b *DomainVideoAccel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Accel3D != b.Accel3D {
+		return false
+	}
+	if a.Accel2D != b.Accel2D {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVideoAccel) canonicalize() {
+}
+
+func (in *DomainVideoDriver) DeepCopy() *DomainVideoDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVideoDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVideoDriver) DeepCopyInto(out *DomainVideoDriver) {
+	*out = *in
+}
+
+func (a *DomainVideoDriver) Equal(b *DomainVideoDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.VGAConf != b.VGAConf {
+		return false
+	}
+	if a.IOMMU != b.IOMMU {
+		return false
+	}
+	if a.ATS != b.ATS {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVideoDriver) canonicalize() {
+}
+
+func (in *DomainVideoModel) DeepCopy() *DomainVideoModel {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainVideoModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainVideoModel) DeepCopyInto(out *DomainVideoModel) {
+	*out = *in
+	if in.Accel != nil {
+		out.Accel = in.Accel.DeepCopy()
+	}
+}
+
+func (a *DomainVideoModel) Equal(b *DomainVideoModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if !equalUintDefault(a.Heads, b.Heads, 1) {
+		return false
+	}
+	if a.Ram != b.Ram {
+		return false
+	}
+	if a.VRam != b.VRam {
+		return false
+	}
+	if a.VRam64 != b.VRam64 {
+		return false
+	}
+	if a.VGAMem != b.VGAMem {
+		return false
+	}
+	if a.Primary != b.Primary {
+		return false
+	}
+	if !a.Accel.Equal(b.Accel) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainVideoModel) canonicalize() {
+	if in.Heads == 0 {
+		in.Heads = 1
+	}
+	if in.Accel != nil {
+		in.Accel.canonicalize()
+	}
+}
+
+func (in *DomainWatchdog) DeepCopy() *DomainWatchdog {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainWatchdog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DomainWatchdog) DeepCopyInto(out *DomainWatchdog) {
+	*out = *in
+	if in.Alias != nil {
+		out.Alias = in.Alias.DeepCopy()
+	}
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *DomainWatchdog) Equal(b *DomainWatchdog) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.Action != b.Action {
+		return false
+	}
+	if !a.Alias.Equal(b.Alias) {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *DomainWatchdog) canonicalize() {
+	if in.Alias != nil {
+		in.Alias.canonicalize()
+	}
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (d *DomainWatchdog) Canonicalize() *DomainWatchdog {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *DomainWatchdog) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *DomainWatchdog) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *DomainWatchdog) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *DomainWatchdog) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *DomainWatchdog) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *DomainWatchdog) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *DomainWatchdog) schemaRef() (string, string) {
+	return "domain.rng", "watchdog"
+}
+
+func (in *Interface) DeepCopy() *Interface {
+	if in == nil {
+		return nil
+	}
+	out := new(Interface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Interface) DeepCopyInto(out *Interface) {
+	*out = *in
+	if in.Start != nil {
+		out.Start = in.Start.DeepCopy()
+	}
+	if in.MTU != nil {
+		out.MTU = in.MTU.DeepCopy()
+	}
+	if in.Protocol != nil {
+		out.Protocol = make([]InterfaceProtocol, len(in.Protocol))
+		for i := range in.Protocol {
+			in.Protocol[i].DeepCopyInto(&out.Protocol[i])
+		}
+	}
+	if in.Link != nil {
+		out.Link = in.Link.DeepCopy()
+	}
+	if in.MAC != nil {
+		out.MAC = in.MAC.DeepCopy()
+	}
+	if in.Bond != nil {
+		out.Bond = in.Bond.DeepCopy()
+	}
+	if in.Bridge != nil {
+		out.Bridge = in.Bridge.DeepCopy()
+	}
+	if in.VLAN != nil {
+		out.VLAN = in.VLAN.DeepCopy()
+	}
+}
+
+func (a *Interface) Equal(b *Interface) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if !a.Start.Equal(b.Start) {
+		return false
+	}
+	if !a.MTU.Equal(b.MTU) {
+		return false
+	}
+	if len(a.Protocol) != len(b.Protocol) {
+		return false
+	}
+	for i := range a.Protocol {
+		if !a.Protocol[i].Equal(&b.Protocol[i]) {
+			return false
+		}
+	}
+	if !a.Link.Equal(b.Link) {
+		return false
+	}
+	if !a.MAC.Equal(b.MAC) {
+		return false
+	}
+	if !a.Bond.Equal(b.Bond) {
+		return false
+	}
+	if !a.Bridge.Equal(b.Bridge) {
+		return false
+	}
+	if !a.VLAN.Equal(b.VLAN) {
+		return false
+	}
+	return true
+}
+
+func (in *Interface) canonicalize() {
+	if in.Start != nil {
+		in.Start.canonicalize()
+	}
+	if in.MTU != nil {
+		in.MTU.canonicalize()
+	}
+	for i := range in.Protocol {
+		in.Protocol[i].canonicalize()
+	}
+	if len(in.Protocol) == 0 {
+		in.Protocol = nil
+	}
+	if in.Link != nil {
+		in.Link.canonicalize()
+	}
+	if in.MAC != nil {
+		in.MAC.canonicalize()
+	}
+	if in.Bond != nil {
+		in.Bond.canonicalize()
+	}
+	if in.Bridge != nil {
+		in.Bridge.canonicalize()
+	}
+	if in.VLAN != nil {
+		in.VLAN.canonicalize()
+	}
+}
+
+func (d *Interface) Canonicalize() *Interface {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *Interface) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *Interface) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *Interface) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *Interface) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *Interface) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *Interface) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *Interface) schemaRef() (string, string) {
+	return "interface.rng", ""
+}
+
+func (in *InterfaceAutoConf) DeepCopy() *InterfaceAutoConf {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceAutoConf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceAutoConf) DeepCopyInto(out *InterfaceAutoConf) {
+	*out = *in
+}
+
+func (a *InterfaceAutoConf) Equal(b *InterfaceAutoConf) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *InterfaceAutoConf) canonicalize() {
+}
+
+func (in *InterfaceBond) DeepCopy() *InterfaceBond {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBond)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceBond) DeepCopyInto(out *InterfaceBond) {
+	*out = *in
+	if in.ARPMon != nil {
+		out.ARPMon = in.ARPMon.DeepCopy()
+	}
+	if in.MIIMon != nil {
+		out.MIIMon = in.MIIMon.DeepCopy()
+	}
+	if in.Interfaces != nil {
+		out.Interfaces = make([]Interface, len(in.Interfaces))
+		for i := range in.Interfaces {
+			in.Interfaces[i].DeepCopyInto(&out.Interfaces[i])
+		}
+	}
+}
+
+func (a *InterfaceBond) Equal(b *InterfaceBond) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if !a.ARPMon.Equal(b.ARPMon) {
+		return false
+	}
+	if !a.MIIMon.Equal(b.MIIMon) {
+		return false
+	}
+	if len(a.Interfaces) != len(b.Interfaces) {
+		return false
+	}
+	for i := range a.Interfaces {
+		if !a.Interfaces[i].Equal(&b.Interfaces[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *InterfaceBond) canonicalize() {
+	if in.ARPMon != nil {
+		in.ARPMon.canonicalize()
+	}
+	if in.MIIMon != nil {
+		in.MIIMon.canonicalize()
+	}
+	for i := range in.Interfaces {
+		in.Interfaces[i].canonicalize()
+	}
+	if len(in.Interfaces) == 0 {
+		in.Interfaces = nil
+	}
+}
+
+func (in *InterfaceBondARPMon) DeepCopy() *InterfaceBondARPMon {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBondARPMon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceBondARPMon) DeepCopyInto(out *InterfaceBondARPMon) {
+	*out = *in
+}
+
+func (a *InterfaceBondARPMon) Equal(b *InterfaceBondARPMon) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Interval != b.Interval {
+		return false
+	}
+	if a.Target != b.Target {
+		return false
+	}
+	if a.Validate != b.Validate {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceBondARPMon) canonicalize() {
+}
+
+func (in *InterfaceBondMIIMon) DeepCopy() *InterfaceBondMIIMon {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBondMIIMon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceBondMIIMon) DeepCopyInto(out *InterfaceBondMIIMon) {
+	*out = *in
+}
+
+func (a *InterfaceBondMIIMon) Equal(b *InterfaceBondMIIMon) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Freq != b.Freq {
+		return false
+	}
+	if a.UpDelay != b.UpDelay {
+		return false
+	}
+	if a.Carrier != b.Carrier {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceBondMIIMon) canonicalize() {
+}
+
+func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
+	*out = *in
+	if in.Delay != nil {
+		v := *in.Delay
+		out.Delay = &v
+	}
+	if in.Interfaces != nil {
+		out.Interfaces = make([]Interface, len(in.Interfaces))
+		for i := range in.Interfaces {
+			in.Interfaces[i].DeepCopyInto(&out.Interfaces[i])
+		}
+	}
+}
+
+func (a *InterfaceBridge) Equal(b *InterfaceBridge) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.STP != b.STP {
+		return false
+	}
+	if (a.Delay == nil) != (b.Delay == nil) || (a.Delay != nil && *a.Delay != *b.Delay) {
+		return false
+	}
+	if len(a.Interfaces) != len(b.Interfaces) {
+		return false
+	}
+	for i := range a.Interfaces {
+		if !a.Interfaces[i].Equal(&b.Interfaces[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *InterfaceBridge) canonicalize() {
+	for i := range in.Interfaces {
+		in.Interfaces[i].canonicalize()
+	}
+	if len(in.Interfaces) == 0 {
+		in.Interfaces = nil
+	}
+}
+
+func (in *InterfaceDHCP) DeepCopy() *InterfaceDHCP {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDHCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceDHCP) DeepCopyInto(out *InterfaceDHCP) {
+	*out = *in
+}
+
+func (a *InterfaceDHCP) Equal(b *InterfaceDHCP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.PeerDNS != b.PeerDNS {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceDHCP) canonicalize() {
+}
+
+func (in *InterfaceIP) DeepCopy() *InterfaceIP {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceIP) DeepCopyInto(out *InterfaceIP) {
+	*out = *in
+}
+
+func (a *InterfaceIP) Equal(b *InterfaceIP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Prefix != b.Prefix {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceIP) canonicalize() {
+}
+
+func (in *InterfaceLink) DeepCopy() *InterfaceLink {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceLink) DeepCopyInto(out *InterfaceLink) {
+	*out = *in
+}
+
+func (a *InterfaceLink) Equal(b *InterfaceLink) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Speed != b.Speed {
+		return false
+	}
+	if a.State != b.State {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceLink) canonicalize() {
+}
+
+func (in *InterfaceMAC) DeepCopy() *InterfaceMAC {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceMAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceMAC) DeepCopyInto(out *InterfaceMAC) {
+	*out = *in
+}
+
+func (a *InterfaceMAC) Equal(b *InterfaceMAC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceMAC) canonicalize() {
+}
+
+func (in *InterfaceMTU) DeepCopy() *InterfaceMTU {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceMTU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceMTU) DeepCopyInto(out *InterfaceMTU) {
+	*out = *in
+}
+
+func (a *InterfaceMTU) Equal(b *InterfaceMTU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Size != b.Size {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceMTU) canonicalize() {
+}
+
+func (in *InterfaceProtocol) DeepCopy() *InterfaceProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceProtocol) DeepCopyInto(out *InterfaceProtocol) {
+	*out = *in
+	if in.AutoConf != nil {
+		out.AutoConf = in.AutoConf.DeepCopy()
+	}
+	if in.DHCP != nil {
+		out.DHCP = in.DHCP.DeepCopy()
+	}
+	if in.IPs != nil {
+		out.IPs = make([]InterfaceIP, len(in.IPs))
+		for i := range in.IPs {
+			in.IPs[i].DeepCopyInto(&out.IPs[i])
+		}
+	}
+	if in.Route != nil {
+		out.Route = make([]InterfaceRoute, len(in.Route))
+		for i := range in.Route {
+			in.Route[i].DeepCopyInto(&out.Route[i])
+		}
+	}
+}
+
+func (a *InterfaceProtocol) Equal(b *InterfaceProtocol) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if !a.AutoConf.Equal(b.AutoConf) {
+		return false
+	}
+	if !a.DHCP.Equal(b.DHCP) {
+		return false
+	}
+	if len(a.IPs) != len(b.IPs) {
+		return false
+	}
+	for i := range a.IPs {
+		if !a.IPs[i].Equal(&b.IPs[i]) {
+			return false
+		}
+	}
+	if len(a.Route) != len(b.Route) {
+		return false
+	}
+	for i := range a.Route {
+		if !a.Route[i].Equal(&b.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *InterfaceProtocol) canonicalize() {
+	if in.AutoConf != nil {
+		in.AutoConf.canonicalize()
+	}
+	if in.DHCP != nil {
+		in.DHCP.canonicalize()
+	}
+	for i := range in.IPs {
+		in.IPs[i].canonicalize()
+	}
+	if len(in.IPs) == 0 {
+		in.IPs = nil
+	}
+	for i := range in.Route {
+		in.Route[i].canonicalize()
+	}
+	if len(in.Route) == 0 {
+		in.Route = nil
+	}
+}
+
+func (in *InterfaceRoute) DeepCopy() *InterfaceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceRoute) DeepCopyInto(out *InterfaceRoute) {
+	*out = *in
+}
+
+func (a *InterfaceRoute) Equal(b *InterfaceRoute) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Gateway != b.Gateway {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceRoute) canonicalize() {
+}
+
+func (in *InterfaceStart) DeepCopy() *InterfaceStart {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceStart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceStart) DeepCopyInto(out *InterfaceStart) {
+	*out = *in
+}
+
+func (a *InterfaceStart) Equal(b *InterfaceStart) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceStart) canonicalize() {
+}
+
+func (in *InterfaceVLAN) DeepCopy() *InterfaceVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *InterfaceVLAN) DeepCopyInto(out *InterfaceVLAN) {
+	*out = *in
+	if in.Tag != nil {
+		v := *in.Tag
+		out.Tag = &v
+	}
+	if in.Interface != nil {
+		out.Interface = in.Interface.DeepCopy()
+	}
+}
+
+func (a *InterfaceVLAN) Equal(b *InterfaceVLAN) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Tag == nil) != (b.Tag == nil) || (a.Tag != nil && *a.Tag != *b.Tag) {
+		return false
+	}
+	if !a.Interface.Equal(b.Interface) {
+		return false
+	}
+	return true
+}
+
+func (in *InterfaceVLAN) canonicalize() {
+	if in.Interface != nil {
+		in.Interface.canonicalize()
+	}
+}
+
+func (in *NWFilter) DeepCopy() *NWFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilter) DeepCopyInto(out *NWFilter) {
+	*out = *in
+	if in.Entries != nil {
+		out.Entries = make([]NWFilterEntry, len(in.Entries))
+		for i := range in.Entries {
+			in.Entries[i].DeepCopyInto(&out.Entries[i])
+		}
+	}
+}
+
+func (a *NWFilter) Equal(b *NWFilter) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if a.Chain != b.Chain {
+		return false
+	}
+	if a.Priority != b.Priority {
+		return false
+	}
+	if len(a.Entries) != len(b.Entries) {
+		return false
+	}
+	for i := range a.Entries {
+		if !a.Entries[i].Equal(&b.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NWFilter) canonicalize() {
+	for i := range in.Entries {
+		in.Entries[i].canonicalize()
+	}
+	if len(in.Entries) == 0 {
+		in.Entries = nil
+	}
+}
+
+func (d *NWFilter) Canonicalize() *NWFilter {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NWFilter) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NWFilter) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NWFilter) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NWFilter) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NWFilter) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NWFilter) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *NWFilter) schemaRef() (string, string) {
+	return "nwfilter.rng", ""
+}
+
+func (in *NWFilterEntry) DeepCopy() *NWFilterEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterEntry) DeepCopyInto(out *NWFilterEntry) {
+	*out = *in
+	if in.Rule != nil {
+		out.Rule = in.Rule.DeepCopy()
+	}
+	if in.Ref != nil {
+		out.Ref = in.Ref.DeepCopy()
+	}
+}
+
+func (a *NWFilterEntry) Equal(b *NWFilterEntry) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Rule.Equal(b.Rule) {
+		return false
+	}
+	if !a.Ref.Equal(b.Ref) {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterEntry) canonicalize() {
+	if in.Rule != nil {
+		in.Rule.canonicalize()
+	}
+	if in.Ref != nil {
+		in.Ref.canonicalize()
+	}
+}
+
+func (in *NWFilterField) DeepCopy() *NWFilterField {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterField) DeepCopyInto(out *NWFilterField) {
+	*out = *in
+	if in.Uint != nil {
+		v := *in.Uint
+		out.Uint = &v
+	}
+}
+
+func (a *NWFilterField) Equal(b *NWFilterField) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Var != b.Var {
+		return false
+	}
+	if a.Str != b.Str {
+		return false
+	}
+	if (a.Uint == nil) != (b.Uint == nil) || (a.Uint != nil && *a.Uint != *b.Uint) {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterField) canonicalize() {
+}
+
+func (in *NWFilterParameter) DeepCopy() *NWFilterParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterParameter) DeepCopyInto(out *NWFilterParameter) {
+	*out = *in
+}
+
+func (a *NWFilterParameter) Equal(b *NWFilterParameter) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterParameter) canonicalize() {
+}
+
+func (in *NWFilterRef) DeepCopy() *NWFilterRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRef) DeepCopyInto(out *NWFilterRef) {
+	*out = *in
+	if in.Parameters != nil {
+		out.Parameters = make([]NWFilterParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			in.Parameters[i].DeepCopyInto(&out.Parameters[i])
+		}
+	}
+}
+
+func (a *NWFilterRef) Equal(b *NWFilterRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Filter != b.Filter {
+		return false
+	}
+	if len(a.Parameters) != len(b.Parameters) {
+		return false
+	}
+	for i := range a.Parameters {
+		if !a.Parameters[i].Equal(&b.Parameters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NWFilterRef) canonicalize() {
+	for i := range in.Parameters {
+		in.Parameters[i].canonicalize()
+	}
+	if len(in.Parameters) == 0 {
+		in.Parameters = nil
+	}
+}
+
+func (in *NWFilterRule) DeepCopy() *NWFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRule) DeepCopyInto(out *NWFilterRule) {
+	*out = *in
+	if in.ARP != nil {
+		out.ARP = in.ARP.DeepCopy()
+	}
+	if in.RARP != nil {
+		out.RARP = in.RARP.DeepCopy()
+	}
+	if in.MAC != nil {
+		out.MAC = in.MAC.DeepCopy()
+	}
+	if in.VLAN != nil {
+		out.VLAN = in.VLAN.DeepCopy()
+	}
+	if in.STP != nil {
+		out.STP = in.STP.DeepCopy()
+	}
+	if in.IP != nil {
+		out.IP = in.IP.DeepCopy()
+	}
+	if in.IPv6 != nil {
+		out.IPv6 = in.IPv6.DeepCopy()
+	}
+	if in.TCP != nil {
+		out.TCP = in.TCP.DeepCopy()
+	}
+	if in.UDP != nil {
+		out.UDP = in.UDP.DeepCopy()
+	}
+	if in.UDPLite != nil {
+		out.UDPLite = in.UDPLite.DeepCopy()
+	}
+	if in.ESP != nil {
+		out.ESP = in.ESP.DeepCopy()
+	}
+	if in.AH != nil {
+		out.AH = in.AH.DeepCopy()
+	}
+	if in.SCTP != nil {
+		out.SCTP = in.SCTP.DeepCopy()
+	}
+	if in.ICMP != nil {
+		out.ICMP = in.ICMP.DeepCopy()
+	}
+	if in.All != nil {
+		out.All = in.All.DeepCopy()
+	}
+	if in.IGMP != nil {
+		out.IGMP = in.IGMP.DeepCopy()
+	}
+	if in.TCPIPv6 != nil {
+		out.TCPIPv6 = in.TCPIPv6.DeepCopy()
+	}
+	if in.UDPIPv6 != nil {
+		out.UDPIPv6 = in.UDPIPv6.DeepCopy()
+	}
+	if in.UDPLiteIPv6 != nil {
+		out.UDPLiteIPv6 = in.UDPLiteIPv6.DeepCopy()
+	}
+	if in.ESPIPv6 != nil {
+		out.ESPIPv6 = in.ESPIPv6.DeepCopy()
+	}
+	if in.AHIPv6 != nil {
+		out.AHIPv6 = in.AHIPv6.DeepCopy()
+	}
+	if in.SCTPIPv6 != nil {
+		out.SCTPIPv6 = in.SCTPIPv6.DeepCopy()
+	}
+	if in.ICMPv6 != nil {
+		out.ICMPv6 = in.ICMPv6.DeepCopy()
+	}
+	if in.AllIPv6 != nil {
+		out.AllIPv6 = in.AllIPv6.DeepCopy()
+	}
+}
+
+func (a *NWFilterRule) Equal(b *NWFilterRule) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Action != b.Action {
+		return false
+	}
+	if a.Direction != b.Direction {
+		return false
+	}
+	if a.Priority != b.Priority {
+		return false
+	}
+	if a.StateMatch != b.StateMatch {
+		return false
+	}
+	if !a.ARP.Equal(b.ARP) {
+		return false
+	}
+	if !a.RARP.Equal(b.RARP) {
+		return false
+	}
+	if !a.MAC.Equal(b.MAC) {
+		return false
+	}
+	if !a.VLAN.Equal(b.VLAN) {
+		return false
+	}
+	if !a.STP.Equal(b.STP) {
+		return false
+	}
+	if !a.IP.Equal(b.IP) {
+		return false
+	}
+	if !a.IPv6.Equal(b.IPv6) {
+		return false
+	}
+	if !a.TCP.Equal(b.TCP) {
+		return false
+	}
+	if !a.UDP.Equal(b.UDP) {
+		return false
+	}
+	if !a.UDPLite.Equal(b.UDPLite) {
+		return false
+	}
+	if !a.ESP.Equal(b.ESP) {
+		return false
+	}
+	if !a.AH.Equal(b.AH) {
+		return false
+	}
+	if !a.SCTP.Equal(b.SCTP) {
+		return false
+	}
+	if !a.ICMP.Equal(b.ICMP) {
+		return false
+	}
+	if !a.All.Equal(b.All) {
+		return false
+	}
+	if !a.IGMP.Equal(b.IGMP) {
+		return false
+	}
+	if !a.TCPIPv6.Equal(b.TCPIPv6) {
+		return false
+	}
+	if !a.UDPIPv6.Equal(b.UDPIPv6) {
+		return false
+	}
+	if !a.UDPLiteIPv6.Equal(b.UDPLiteIPv6) {
+		return false
+	}
+	if !a.ESPIPv6.Equal(b.ESPIPv6) {
+		return false
+	}
+	if !a.AHIPv6.Equal(b.AHIPv6) {
+		return false
+	}
+	if !a.SCTPIPv6.Equal(b.SCTPIPv6) {
+		return false
+	}
+	if !a.ICMPv6.Equal(b.ICMPv6) {
+		return false
+	}
+	if !a.AllIPv6.Equal(b.AllIPv6) {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRule) canonicalize() {
+	if in.ARP != nil {
+		in.ARP.canonicalize()
+	}
+	if in.RARP != nil {
+		in.RARP.canonicalize()
+	}
+	if in.MAC != nil {
+		in.MAC.canonicalize()
+	}
+	if in.VLAN != nil {
+		in.VLAN.canonicalize()
+	}
+	if in.STP != nil {
+		in.STP.canonicalize()
+	}
+	if in.IP != nil {
+		in.IP.canonicalize()
+	}
+	if in.IPv6 != nil {
+		in.IPv6.canonicalize()
+	}
+	if in.TCP != nil {
+		in.TCP.canonicalize()
+	}
+	if in.UDP != nil {
+		in.UDP.canonicalize()
+	}
+	if in.UDPLite != nil {
+		in.UDPLite.canonicalize()
+	}
+	if in.ESP != nil {
+		in.ESP.canonicalize()
+	}
+	if in.AH != nil {
+		in.AH.canonicalize()
+	}
+	if in.SCTP != nil {
+		in.SCTP.canonicalize()
+	}
+	if in.ICMP != nil {
+		in.ICMP.canonicalize()
+	}
+	if in.All != nil {
+		in.All.canonicalize()
+	}
+	if in.IGMP != nil {
+		in.IGMP.canonicalize()
+	}
+	if in.TCPIPv6 != nil {
+		in.TCPIPv6.canonicalize()
+	}
+	if in.UDPIPv6 != nil {
+		in.UDPIPv6.canonicalize()
+	}
+	if in.UDPLiteIPv6 != nil {
+		in.UDPLiteIPv6.canonicalize()
+	}
+	if in.ESPIPv6 != nil {
+		in.ESPIPv6.canonicalize()
+	}
+	if in.AHIPv6 != nil {
+		in.AHIPv6.canonicalize()
+	}
+	if in.SCTPIPv6 != nil {
+		in.SCTPIPv6.canonicalize()
+	}
+	if in.ICMPv6 != nil {
+		in.ICMPv6.canonicalize()
+	}
+	if in.AllIPv6 != nil {
+		in.AllIPv6.canonicalize()
+	}
+}
+
+func (in *NWFilterRuleAH) DeepCopy() *NWFilterRuleAH {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleAH)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleAH) DeepCopyInto(out *NWFilterRuleAH) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleAH) Equal(b *NWFilterRuleAH) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleAH) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleAHIPv6) DeepCopy() *NWFilterRuleAHIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleAHIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleAHIPv6) DeepCopyInto(out *NWFilterRuleAHIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleAHIPv6) Equal(b *NWFilterRuleAHIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleAHIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleARP) DeepCopy() *NWFilterRuleARP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleARP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleARP) DeepCopyInto(out *NWFilterRuleARP) {
+	*out = *in
+	in.NWFilterRuleCommonMAC.DeepCopyInto(&out.NWFilterRuleCommonMAC)
+	in.HWType.DeepCopyInto(&out.HWType)
+	in.ProtocolType.DeepCopyInto(&out.ProtocolType)
+	in.OpCode.DeepCopyInto(&out.OpCode)
+	in.ARPSrcMACAddr.DeepCopyInto(&out.ARPSrcMACAddr)
+	in.ARPDstMACAddr.DeepCopyInto(&out.ARPDstMACAddr)
+	in.ARPSrcIPAddr.DeepCopyInto(&out.ARPSrcIPAddr)
+	in.ARPSrcIPMask.DeepCopyInto(&out.ARPSrcIPMask)
+	in.ARPDstIPAddr.DeepCopyInto(&out.ARPDstIPAddr)
+	in.ARPDstIPMask.DeepCopyInto(&out.ARPDstIPMask)
+	in.Gratuitous.DeepCopyInto(&out.Gratuitous)
+}
+
+func (a *NWFilterRuleARP) Equal(b *NWFilterRuleARP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonMAC.Equal(&b.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !a.HWType.Equal(&b.HWType) {
+		return false
+	}
+	if !a.ProtocolType.Equal(&b.ProtocolType) {
+		return false
+	}
+	if !a.OpCode.Equal(&b.OpCode) {
+		return false
+	}
+	if !a.ARPSrcMACAddr.Equal(&b.ARPSrcMACAddr) {
+		return false
+	}
+	if !a.ARPDstMACAddr.Equal(&b.ARPDstMACAddr) {
+		return false
+	}
+	if !a.ARPSrcIPAddr.Equal(&b.ARPSrcIPAddr) {
+		return false
+	}
+	if !a.ARPSrcIPMask.Equal(&b.ARPSrcIPMask) {
+		return false
+	}
+	if !a.ARPDstIPAddr.Equal(&b.ARPDstIPAddr) {
+		return false
+	}
+	if !a.ARPDstIPMask.Equal(&b.ARPDstIPMask) {
+		return false
+	}
+	if !a.Gratuitous.Equal(&b.Gratuitous) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleARP) canonicalize() {
+	in.NWFilterRuleCommonMAC.canonicalize()
+	in.HWType.canonicalize()
+	in.ProtocolType.canonicalize()
+	in.OpCode.canonicalize()
+	in.ARPSrcMACAddr.canonicalize()
+	in.ARPDstMACAddr.canonicalize()
+	in.ARPSrcIPAddr.canonicalize()
+	in.ARPSrcIPMask.canonicalize()
+	in.ARPDstIPAddr.canonicalize()
+	in.ARPDstIPMask.canonicalize()
+	in.Gratuitous.canonicalize()
+}
+
+func (in *NWFilterRuleAll) DeepCopy() *NWFilterRuleAll {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleAll)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleAll) DeepCopyInto(out *NWFilterRuleAll) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleAll) Equal(b *NWFilterRuleAll) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleAll) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleAllIPv6) DeepCopy() *NWFilterRuleAllIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleAllIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleAllIPv6) DeepCopyInto(out *NWFilterRuleAllIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleAllIPv6) Equal(b *NWFilterRuleAllIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleAllIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleCommonIP) DeepCopy() *NWFilterRuleCommonIP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleCommonIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleCommonIP) DeepCopyInto(out *NWFilterRuleCommonIP) {
+	*out = *in
+	in.SrcMACAddr.DeepCopyInto(&out.SrcMACAddr)
+	in.SrcIPAddr.DeepCopyInto(&out.SrcIPAddr)
+	in.SrcIPMask.DeepCopyInto(&out.SrcIPMask)
+	in.DstIPAddr.DeepCopyInto(&out.DstIPAddr)
+	in.DstIPMask.DeepCopyInto(&out.DstIPMask)
+	in.SrcIPFrom.DeepCopyInto(&out.SrcIPFrom)
+	in.SrcIPTo.DeepCopyInto(&out.SrcIPTo)
+	in.DstIPFrom.DeepCopyInto(&out.DstIPFrom)
+	in.DstIPTo.DeepCopyInto(&out.DstIPTo)
+	in.DSCP.DeepCopyInto(&out.DSCP)
+	in.ConnLimitAbove.DeepCopyInto(&out.ConnLimitAbove)
+	in.State.DeepCopyInto(&out.State)
+	in.IPSet.DeepCopyInto(&out.IPSet)
+	in.IPSetFlags.DeepCopyInto(&out.IPSetFlags)
+}
+
+func (a *NWFilterRuleCommonIP) Equal(b *NWFilterRuleCommonIP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SrcMACAddr.Equal(&b.SrcMACAddr) {
+		return false
+	}
+	if !a.SrcIPAddr.Equal(&b.SrcIPAddr) {
+		return false
+	}
+	if !a.SrcIPMask.Equal(&b.SrcIPMask) {
+		return false
+	}
+	if !a.DstIPAddr.Equal(&b.DstIPAddr) {
+		return false
+	}
+	if !a.DstIPMask.Equal(&b.DstIPMask) {
+		return false
+	}
+	if !a.SrcIPFrom.Equal(&b.SrcIPFrom) {
+		return false
+	}
+	if !a.SrcIPTo.Equal(&b.SrcIPTo) {
+		return false
+	}
+	if !a.DstIPFrom.Equal(&b.DstIPFrom) {
+		return false
+	}
+	if !a.DstIPTo.Equal(&b.DstIPTo) {
+		return false
+	}
+	if !a.DSCP.Equal(&b.DSCP) {
+		return false
+	}
+	if !a.ConnLimitAbove.Equal(&b.ConnLimitAbove) {
+		return false
+	}
+	if !a.State.Equal(&b.State) {
+		return false
+	}
+	if !a.IPSet.Equal(&b.IPSet) {
+		return false
+	}
+	if !a.IPSetFlags.Equal(&b.IPSetFlags) {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleCommonIP) canonicalize() {
+	in.SrcMACAddr.canonicalize()
+	in.SrcIPAddr.canonicalize()
+	in.SrcIPMask.canonicalize()
+	in.DstIPAddr.canonicalize()
+	in.DstIPMask.canonicalize()
+	in.SrcIPFrom.canonicalize()
+	in.SrcIPTo.canonicalize()
+	in.DstIPFrom.canonicalize()
+	in.DstIPTo.canonicalize()
+	in.DSCP.canonicalize()
+	in.ConnLimitAbove.canonicalize()
+	in.State.canonicalize()
+	in.IPSet.canonicalize()
+	in.IPSetFlags.canonicalize()
+}
+
+func (in *NWFilterRuleCommonMAC) DeepCopy() *NWFilterRuleCommonMAC {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleCommonMAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleCommonMAC) DeepCopyInto(out *NWFilterRuleCommonMAC) {
+	*out = *in
+	in.SrcMACAddr.DeepCopyInto(&out.SrcMACAddr)
+	in.SrcMACMask.DeepCopyInto(&out.SrcMACMask)
+	in.DstMACAddr.DeepCopyInto(&out.DstMACAddr)
+	in.DstMACMask.DeepCopyInto(&out.DstMACMask)
+}
+
+func (a *NWFilterRuleCommonMAC) Equal(b *NWFilterRuleCommonMAC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SrcMACAddr.Equal(&b.SrcMACAddr) {
+		return false
+	}
+	if !a.SrcMACMask.Equal(&b.SrcMACMask) {
+		return false
+	}
+	if !a.DstMACAddr.Equal(&b.DstMACAddr) {
+		return false
+	}
+	if !a.DstMACMask.Equal(&b.DstMACMask) {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleCommonMAC) canonicalize() {
+	in.SrcMACAddr.canonicalize()
+	in.SrcMACMask.canonicalize()
+	in.DstMACAddr.canonicalize()
+	in.DstMACMask.canonicalize()
+}
+
+func (in *NWFilterRuleCommonPort) DeepCopy() *NWFilterRuleCommonPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleCommonPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleCommonPort) DeepCopyInto(out *NWFilterRuleCommonPort) {
+	*out = *in
+	in.SrcPortStart.DeepCopyInto(&out.SrcPortStart)
+	in.SrcPortEnd.DeepCopyInto(&out.SrcPortEnd)
+	in.DstPortStart.DeepCopyInto(&out.DstPortStart)
+	in.DstPortEnd.DeepCopyInto(&out.DstPortEnd)
+}
+
+func (a *NWFilterRuleCommonPort) Equal(b *NWFilterRuleCommonPort) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.SrcPortStart.Equal(&b.SrcPortStart) {
+		return false
+	}
+	if !a.SrcPortEnd.Equal(&b.SrcPortEnd) {
+		return false
+	}
+	if !a.DstPortStart.Equal(&b.DstPortStart) {
+		return false
+	}
+	if !a.DstPortEnd.Equal(&b.DstPortEnd) {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleCommonPort) canonicalize() {
+	in.SrcPortStart.canonicalize()
+	in.SrcPortEnd.canonicalize()
+	in.DstPortStart.canonicalize()
+	in.DstPortEnd.canonicalize()
+}
+
+func (in *NWFilterRuleESP) DeepCopy() *NWFilterRuleESP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleESP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleESP) DeepCopyInto(out *NWFilterRuleESP) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleESP) Equal(b *NWFilterRuleESP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleESP) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleESPIPv6) DeepCopy() *NWFilterRuleESPIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleESPIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleESPIPv6) DeepCopyInto(out *NWFilterRuleESPIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleESPIPv6) Equal(b *NWFilterRuleESPIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleESPIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleICMP) DeepCopy() *NWFilterRuleICMP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleICMP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleICMP) DeepCopyInto(out *NWFilterRuleICMP) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.Type.DeepCopyInto(&out.Type)
+	in.Code.DeepCopyInto(&out.Code)
+}
+
+func (a *NWFilterRuleICMP) Equal(b *NWFilterRuleICMP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.Type.Equal(&b.Type) {
+		return false
+	}
+	if !a.Code.Equal(&b.Code) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleICMP) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.Type.canonicalize()
+	in.Code.canonicalize()
+}
+
+func (in *NWFilterRuleICMPIPv6) DeepCopy() *NWFilterRuleICMPIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleICMPIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleICMPIPv6) DeepCopyInto(out *NWFilterRuleICMPIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.Type.DeepCopyInto(&out.Type)
+	in.Code.DeepCopyInto(&out.Code)
+}
+
+func (a *NWFilterRuleICMPIPv6) Equal(b *NWFilterRuleICMPIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.Type.Equal(&b.Type) {
+		return false
+	}
+	if !a.Code.Equal(&b.Code) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleICMPIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.Type.canonicalize()
+	in.Code.canonicalize()
+}
+
+func (in *NWFilterRuleIGMP) DeepCopy() *NWFilterRuleIGMP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleIGMP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleIGMP) DeepCopyInto(out *NWFilterRuleIGMP) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleIGMP) Equal(b *NWFilterRuleIGMP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleIGMP) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleIP) DeepCopy() *NWFilterRuleIP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleIP) DeepCopyInto(out *NWFilterRuleIP) {
+	*out = *in
+	in.NWFilterRuleCommonMAC.DeepCopyInto(&out.NWFilterRuleCommonMAC)
+	in.SrcIPAddr.DeepCopyInto(&out.SrcIPAddr)
+	in.SrcIPMask.DeepCopyInto(&out.SrcIPMask)
+	in.DstIPAddr.DeepCopyInto(&out.DstIPAddr)
+	in.DstIPMask.DeepCopyInto(&out.DstIPMask)
+	in.Protocol.DeepCopyInto(&out.Protocol)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+	in.DSCP.DeepCopyInto(&out.DSCP)
+}
+
+func (a *NWFilterRuleIP) Equal(b *NWFilterRuleIP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonMAC.Equal(&b.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !a.SrcIPAddr.Equal(&b.SrcIPAddr) {
+		return false
+	}
+	if !a.SrcIPMask.Equal(&b.SrcIPMask) {
+		return false
+	}
+	if !a.DstIPAddr.Equal(&b.DstIPAddr) {
+		return false
+	}
+	if !a.DstIPMask.Equal(&b.DstIPMask) {
+		return false
+	}
+	if !a.Protocol.Equal(&b.Protocol) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !a.DSCP.Equal(&b.DSCP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleIP) canonicalize() {
+	in.NWFilterRuleCommonMAC.canonicalize()
+	in.SrcIPAddr.canonicalize()
+	in.SrcIPMask.canonicalize()
+	in.DstIPAddr.canonicalize()
+	in.DstIPMask.canonicalize()
+	in.Protocol.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+	in.DSCP.canonicalize()
+}
+
+func (in *NWFilterRuleIPv6) DeepCopy() *NWFilterRuleIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleIPv6) DeepCopyInto(out *NWFilterRuleIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonMAC.DeepCopyInto(&out.NWFilterRuleCommonMAC)
+	in.SrcIPAddr.DeepCopyInto(&out.SrcIPAddr)
+	in.SrcIPMask.DeepCopyInto(&out.SrcIPMask)
+	in.DstIPAddr.DeepCopyInto(&out.DstIPAddr)
+	in.DstIPMask.DeepCopyInto(&out.DstIPMask)
+	in.Protocol.DeepCopyInto(&out.Protocol)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+	in.Type.DeepCopyInto(&out.Type)
+	in.TypeEnd.DeepCopyInto(&out.TypeEnd)
+	in.Code.DeepCopyInto(&out.Code)
+	in.CodeEnd.DeepCopyInto(&out.CodeEnd)
+}
+
+func (a *NWFilterRuleIPv6) Equal(b *NWFilterRuleIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonMAC.Equal(&b.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !a.SrcIPAddr.Equal(&b.SrcIPAddr) {
+		return false
+	}
+	if !a.SrcIPMask.Equal(&b.SrcIPMask) {
+		return false
+	}
+	if !a.DstIPAddr.Equal(&b.DstIPAddr) {
+		return false
+	}
+	if !a.DstIPMask.Equal(&b.DstIPMask) {
+		return false
+	}
+	if !a.Protocol.Equal(&b.Protocol) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !a.Type.Equal(&b.Type) {
+		return false
+	}
+	if !a.TypeEnd.Equal(&b.TypeEnd) {
+		return false
+	}
+	if !a.Code.Equal(&b.Code) {
+		return false
+	}
+	if !a.CodeEnd.Equal(&b.CodeEnd) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleIPv6) canonicalize() {
+	in.NWFilterRuleCommonMAC.canonicalize()
+	in.SrcIPAddr.canonicalize()
+	in.SrcIPMask.canonicalize()
+	in.DstIPAddr.canonicalize()
+	in.DstIPMask.canonicalize()
+	in.Protocol.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+	in.Type.canonicalize()
+	in.TypeEnd.canonicalize()
+	in.Code.canonicalize()
+	in.CodeEnd.canonicalize()
+}
+
+func (in *NWFilterRuleMAC) DeepCopy() *NWFilterRuleMAC {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleMAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleMAC) DeepCopyInto(out *NWFilterRuleMAC) {
+	*out = *in
+	in.NWFilterRuleCommonMAC.DeepCopyInto(&out.NWFilterRuleCommonMAC)
+	in.ProtocolID.DeepCopyInto(&out.ProtocolID)
+}
+
+func (a *NWFilterRuleMAC) Equal(b *NWFilterRuleMAC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonMAC.Equal(&b.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !a.ProtocolID.Equal(&b.ProtocolID) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleMAC) canonicalize() {
+	in.NWFilterRuleCommonMAC.canonicalize()
+	in.ProtocolID.canonicalize()
+}
+
+func (in *NWFilterRuleRARP) DeepCopy() *NWFilterRuleRARP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleRARP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleRARP) DeepCopyInto(out *NWFilterRuleRARP) {
+	*out = *in
+	in.NWFilterRuleCommonMAC.DeepCopyInto(&out.NWFilterRuleCommonMAC)
+	in.HWType.DeepCopyInto(&out.HWType)
+	in.ProtocolType.DeepCopyInto(&out.ProtocolType)
+	in.OpCode.DeepCopyInto(&out.OpCode)
+	in.ARPSrcMACAddr.DeepCopyInto(&out.ARPSrcMACAddr)
+	in.ARPDstMACAddr.DeepCopyInto(&out.ARPDstMACAddr)
+	in.ARPSrcIPAddr.DeepCopyInto(&out.ARPSrcIPAddr)
+	in.ARPSrcIPMask.DeepCopyInto(&out.ARPSrcIPMask)
+	in.ARPDstIPAddr.DeepCopyInto(&out.ARPDstIPAddr)
+	in.ARPDstIPMask.DeepCopyInto(&out.ARPDstIPMask)
+	in.Gratuitous.DeepCopyInto(&out.Gratuitous)
+}
+
+func (a *NWFilterRuleRARP) Equal(b *NWFilterRuleRARP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonMAC.Equal(&b.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !a.HWType.Equal(&b.HWType) {
+		return false
+	}
+	if !a.ProtocolType.Equal(&b.ProtocolType) {
+		return false
+	}
+	if !a.OpCode.Equal(&b.OpCode) {
+		return false
+	}
+	if !a.ARPSrcMACAddr.Equal(&b.ARPSrcMACAddr) {
+		return false
+	}
+	if !a.ARPDstMACAddr.Equal(&b.ARPDstMACAddr) {
+		return false
+	}
+	if !a.ARPSrcIPAddr.Equal(&b.ARPSrcIPAddr) {
+		return false
+	}
+	if !a.ARPSrcIPMask.Equal(&b.ARPSrcIPMask) {
+		return false
+	}
+	if !a.ARPDstIPAddr.Equal(&b.ARPDstIPAddr) {
+		return false
+	}
+	if !a.ARPDstIPMask.Equal(&b.ARPDstIPMask) {
+		return false
+	}
+	if !a.Gratuitous.Equal(&b.Gratuitous) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleRARP) canonicalize() {
+	in.NWFilterRuleCommonMAC.canonicalize()
+	in.HWType.canonicalize()
+	in.ProtocolType.canonicalize()
+	in.OpCode.canonicalize()
+	in.ARPSrcMACAddr.canonicalize()
+	in.ARPDstMACAddr.canonicalize()
+	in.ARPSrcIPAddr.canonicalize()
+	in.ARPSrcIPMask.canonicalize()
+	in.ARPDstIPAddr.canonicalize()
+	in.ARPDstIPMask.canonicalize()
+	in.Gratuitous.canonicalize()
+}
+
+func (in *NWFilterRuleSCTP) DeepCopy() *NWFilterRuleSCTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleSCTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleSCTP) DeepCopyInto(out *NWFilterRuleSCTP) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+}
+
+func (a *NWFilterRuleSCTP) Equal(b *NWFilterRuleSCTP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleSCTP) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+}
+
+func (in *NWFilterRuleSCTPIPv6) DeepCopy() *NWFilterRuleSCTPIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleSCTPIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleSCTPIPv6) DeepCopyInto(out *NWFilterRuleSCTPIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+}
+
+func (a *NWFilterRuleSCTPIPv6) Equal(b *NWFilterRuleSCTPIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleSCTPIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+}
+
+func (in *NWFilterRuleSTP) DeepCopy() *NWFilterRuleSTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleSTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleSTP) DeepCopyInto(out *NWFilterRuleSTP) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	in.SrcMACAddr.DeepCopyInto(&out.SrcMACAddr)
+	in.SrcMACMask.DeepCopyInto(&out.SrcMACMask)
+	in.Type.DeepCopyInto(&out.Type)
+	in.Flags.DeepCopyInto(&out.Flags)
+	in.RootPriority.DeepCopyInto(&out.RootPriority)
+	in.RootPriorityHi.DeepCopyInto(&out.RootPriorityHi)
+	in.RootAddress.DeepCopyInto(&out.RootAddress)
+	in.RootAddressMask.DeepCopyInto(&out.RootAddressMask)
+	in.RootCost.DeepCopyInto(&out.RootCost)
+	in.RootCostHi.DeepCopyInto(&out.RootCostHi)
+	in.SenderPriority.DeepCopyInto(&out.SenderPriority)
+	in.SenderPriorityHi.DeepCopyInto(&out.SenderPriorityHi)
+	in.SenderAddress.DeepCopyInto(&out.SenderAddress)
+	in.SenderAddressMask.DeepCopyInto(&out.SenderAddressMask)
+	in.Port.DeepCopyInto(&out.Port)
+	in.PortHi.DeepCopyInto(&out.PortHi)
+	in.Age.DeepCopyInto(&out.Age)
+	in.AgeHi.DeepCopyInto(&out.AgeHi)
+	in.MaxAge.DeepCopyInto(&out.MaxAge)
+	in.MaxAgeHi.DeepCopyInto(&out.MaxAgeHi)
+	in.HelloTime.DeepCopyInto(&out.HelloTime)
+	in.HelloTimeHi.DeepCopyInto(&out.HelloTimeHi)
+	in.ForwardDelay.DeepCopyInto(&out.ForwardDelay)
+	in.ForwardDelayHi.DeepCopyInto(&out.ForwardDelayHi)
+}
+
+func (a *NWFilterRuleSTP) Equal(b *NWFilterRuleSTP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Match.Equal(&b.Match) {
+		return false
+	}
+	if !a.SrcMACAddr.Equal(&b.SrcMACAddr) {
+		return false
+	}
+	if !a.SrcMACMask.Equal(&b.SrcMACMask) {
+		return false
+	}
+	if !a.Type.Equal(&b.Type) {
+		return false
+	}
+	if !a.Flags.Equal(&b.Flags) {
+		return false
+	}
+	if !a.RootPriority.Equal(&b.RootPriority) {
+		return false
+	}
+	if !a.RootPriorityHi.Equal(&b.RootPriorityHi) {
+		return false
+	}
+	if !a.RootAddress.Equal(&b.RootAddress) {
+		return false
+	}
+	if !a.RootAddressMask.Equal(&b.RootAddressMask) {
+		return false
+	}
+	if !a.RootCost.Equal(&b.RootCost) {
+		return false
+	}
+	if !a.RootCostHi.Equal(&b.RootCostHi) {
+		return false
+	}
+	if !a.SenderPriority.Equal(&b.SenderPriority) {
+		return false
+	}
+	if !a.SenderPriorityHi.Equal(&b.SenderPriorityHi) {
+		return false
+	}
+	if !a.SenderAddress.Equal(&b.SenderAddress) {
+		return false
+	}
+	if !a.SenderAddressMask.Equal(&b.SenderAddressMask) {
+		return false
+	}
+	if !a.Port.Equal(&b.Port) {
+		return false
+	}
+	if !a.PortHi.Equal(&b.PortHi) {
+		return false
+	}
+	if !a.Age.Equal(&b.Age) {
+		return false
+	}
+	if !a.AgeHi.Equal(&b.AgeHi) {
+		return false
+	}
+	if !a.MaxAge.Equal(&b.MaxAge) {
+		return false
+	}
+	if !a.MaxAgeHi.Equal(&b.MaxAgeHi) {
+		return false
+	}
+	if !a.HelloTime.Equal(&b.HelloTime) {
+		return false
+	}
+	if !a.HelloTimeHi.Equal(&b.HelloTimeHi) {
+		return false
+	}
+	if !a.ForwardDelay.Equal(&b.ForwardDelay) {
+		return false
+	}
+	if !a.ForwardDelayHi.Equal(&b.ForwardDelayHi) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleSTP) canonicalize() {
+	in.Match.canonicalize()
+	in.SrcMACAddr.canonicalize()
+	in.SrcMACMask.canonicalize()
+	in.Type.canonicalize()
+	in.Flags.canonicalize()
+	in.RootPriority.canonicalize()
+	in.RootPriorityHi.canonicalize()
+	in.RootAddress.canonicalize()
+	in.RootAddressMask.canonicalize()
+	in.RootCost.canonicalize()
+	in.RootCostHi.canonicalize()
+	in.SenderPriority.canonicalize()
+	in.SenderPriorityHi.canonicalize()
+	in.SenderAddress.canonicalize()
+	in.SenderAddressMask.canonicalize()
+	in.Port.canonicalize()
+	in.PortHi.canonicalize()
+	in.Age.canonicalize()
+	in.AgeHi.canonicalize()
+	in.MaxAge.canonicalize()
+	in.MaxAgeHi.canonicalize()
+	in.HelloTime.canonicalize()
+	in.HelloTimeHi.canonicalize()
+	in.ForwardDelay.canonicalize()
+	in.ForwardDelayHi.canonicalize()
+}
+
+func (in *NWFilterRuleTCP) DeepCopy() *NWFilterRuleTCP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleTCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleTCP) DeepCopyInto(out *NWFilterRuleTCP) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+	in.Option.DeepCopyInto(&out.Option)
+	in.Flags.DeepCopyInto(&out.Flags)
+}
+
+func (a *NWFilterRuleTCP) Equal(b *NWFilterRuleTCP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !a.Option.Equal(&b.Option) {
+		return false
+	}
+	if !a.Flags.Equal(&b.Flags) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleTCP) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+	in.Option.canonicalize()
+	in.Flags.canonicalize()
+}
+
+func (in *NWFilterRuleTCPIPv6) DeepCopy() *NWFilterRuleTCPIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleTCPIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleTCPIPv6) DeepCopyInto(out *NWFilterRuleTCPIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+	in.Option.DeepCopyInto(&out.Option)
+}
+
+func (a *NWFilterRuleTCPIPv6) Equal(b *NWFilterRuleTCPIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !a.Option.Equal(&b.Option) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleTCPIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+	in.Option.canonicalize()
+}
+
+func (in *NWFilterRuleUDP) DeepCopy() *NWFilterRuleUDP {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleUDP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleUDP) DeepCopyInto(out *NWFilterRuleUDP) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+}
+
+func (a *NWFilterRuleUDP) Equal(b *NWFilterRuleUDP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleUDP) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+}
+
+func (in *NWFilterRuleUDPIPv6) DeepCopy() *NWFilterRuleUDPIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleUDPIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleUDPIPv6) DeepCopyInto(out *NWFilterRuleUDPIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+	in.NWFilterRuleCommonPort.DeepCopyInto(&out.NWFilterRuleCommonPort)
+}
+
+func (a *NWFilterRuleUDPIPv6) Equal(b *NWFilterRuleUDPIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !a.NWFilterRuleCommonPort.Equal(&b.NWFilterRuleCommonPort) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleUDPIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+	in.NWFilterRuleCommonPort.canonicalize()
+}
+
+func (in *NWFilterRuleUDPLite) DeepCopy() *NWFilterRuleUDPLite {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleUDPLite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleUDPLite) DeepCopyInto(out *NWFilterRuleUDPLite) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleUDPLite) Equal(b *NWFilterRuleUDPLite) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleUDPLite) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleUDPLiteIPv6) DeepCopy() *NWFilterRuleUDPLiteIPv6 {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleUDPLiteIPv6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleUDPLiteIPv6) DeepCopyInto(out *NWFilterRuleUDPLiteIPv6) {
+	*out = *in
+	in.NWFilterRuleCommonIP.DeepCopyInto(&out.NWFilterRuleCommonIP)
+}
+
+func (a *NWFilterRuleUDPLiteIPv6) Equal(b *NWFilterRuleUDPLiteIPv6) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonIP.Equal(&b.NWFilterRuleCommonIP) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleUDPLiteIPv6) canonicalize() {
+	in.NWFilterRuleCommonIP.canonicalize()
+}
+
+func (in *NWFilterRuleVLAN) DeepCopy() *NWFilterRuleVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(NWFilterRuleVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NWFilterRuleVLAN) DeepCopyInto(out *NWFilterRuleVLAN) {
+	*out = *in
+	in.NWFilterRuleCommonMAC.DeepCopyInto(&out.NWFilterRuleCommonMAC)
+	in.VLANID.DeepCopyInto(&out.VLANID)
+	in.EncapProtocol.DeepCopyInto(&out.EncapProtocol)
+}
+
+func (a *NWFilterRuleVLAN) Equal(b *NWFilterRuleVLAN) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Match != b.Match {
+		return false
+	}
+	if !a.NWFilterRuleCommonMAC.Equal(&b.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !a.VLANID.Equal(&b.VLANID) {
+		return false
+	}
+	if !a.EncapProtocol.Equal(&b.EncapProtocol) {
+		return false
+	}
+	if a.Comment != b.Comment {
+		return false
+	}
+	return true
+}
+
+func (in *NWFilterRuleVLAN) canonicalize() {
+	in.NWFilterRuleCommonMAC.canonicalize()
+	in.VLANID.canonicalize()
+	in.EncapProtocol.canonicalize()
+}
+
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	if in.Metadata != nil {
+		out.Metadata = in.Metadata.DeepCopy()
+	}
+	if in.Forward != nil {
+		out.Forward = in.Forward.DeepCopy()
+	}
+	if in.Bridge != nil {
+		out.Bridge = in.Bridge.DeepCopy()
+	}
+	if in.MTU != nil {
+		out.MTU = in.MTU.DeepCopy()
+	}
+	if in.MAC != nil {
+		out.MAC = in.MAC.DeepCopy()
+	}
+	if in.Domain != nil {
+		out.Domain = in.Domain.DeepCopy()
+	}
+	if in.DNS != nil {
+		out.DNS = in.DNS.DeepCopy()
+	}
+	if in.VLAN != nil {
+		out.VLAN = in.VLAN.DeepCopy()
+	}
+	if in.Bandwidth != nil {
+		out.Bandwidth = in.Bandwidth.DeepCopy()
+	}
+	if in.IPs != nil {
+		out.IPs = make([]NetworkIP, len(in.IPs))
+		for i := range in.IPs {
+			in.IPs[i].DeepCopyInto(&out.IPs[i])
+		}
+	}
+	if in.Routes != nil {
+		out.Routes = make([]NetworkRoute, len(in.Routes))
+		for i := range in.Routes {
+			in.Routes[i].DeepCopyInto(&out.Routes[i])
+		}
+	}
+	if in.VirtualPort != nil {
+		out.VirtualPort = in.VirtualPort.DeepCopy()
+	}
+	if in.PortGroups != nil {
+		out.PortGroups = make([]NetworkPortGroup, len(in.PortGroups))
+		for i := range in.PortGroups {
+			in.PortGroups[i].DeepCopyInto(&out.PortGroups[i])
+		}
+	}
+}
+
+func (a *Network) Equal(b *Network) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IPv6 != b.IPv6 {
+		return false
+	}
+	if a.TrustGuestRxFilters != b.TrustGuestRxFilters {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if !a.Metadata.Equal(b.Metadata) {
+		return false
+	}
+	if !a.Forward.Equal(b.Forward) {
+		return false
+	}
+	if !a.Bridge.Equal(b.Bridge) {
+		return false
+	}
+	if !a.MTU.Equal(b.MTU) {
+		return false
+	}
+	if !a.MAC.Equal(b.MAC) {
+		return false
+	}
+	if !a.Domain.Equal(b.Domain) {
+		return false
+	}
+	if !a.DNS.Equal(b.DNS) {
+		return false
+	}
+	if !a.VLAN.Equal(b.VLAN) {
+		return false
+	}
+	if !a.Bandwidth.Equal(b.Bandwidth) {
+		return false
+	}
+	if len(a.IPs) != len(b.IPs) {
+		return false
+	}
+	for i := range a.IPs {
+		if !a.IPs[i].Equal(&b.IPs[i]) {
+			return false
+		}
+	}
+	if len(a.Routes) != len(b.Routes) {
+		return false
+	}
+	for i := range a.Routes {
+		if !a.Routes[i].Equal(&b.Routes[i]) {
+			return false
+		}
+	}
+	if !a.VirtualPort.Equal(b.VirtualPort) {
+		return false
+	}
+	if len(a.PortGroups) != len(b.PortGroups) {
+		return false
+	}
+	for i := range a.PortGroups {
+		if !a.PortGroups[i].Equal(&b.PortGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *Network) canonicalize() {
+	if in.Metadata != nil {
+		in.Metadata.canonicalize()
+	}
+	if in.Forward != nil {
+		in.Forward.canonicalize()
+	}
+	if in.Bridge != nil {
+		in.Bridge.canonicalize()
+	}
+	if in.MTU != nil {
+		in.MTU.canonicalize()
+	}
+	if in.MAC != nil {
+		in.MAC.canonicalize()
+	}
+	if in.Domain != nil {
+		in.Domain.canonicalize()
+	}
+	if in.DNS != nil {
+		in.DNS.canonicalize()
+	}
+	if in.VLAN != nil {
+		in.VLAN.canonicalize()
+	}
+	if in.Bandwidth != nil {
+		in.Bandwidth.canonicalize()
+		if in.Bandwidth.empty() {
+			in.Bandwidth = nil
+		}
+	}
+	for i := range in.IPs {
+		in.IPs[i].canonicalize()
+	}
+	if len(in.IPs) == 0 {
+		in.IPs = nil
+	}
+	for i := range in.Routes {
+		in.Routes[i].canonicalize()
+	}
+	if len(in.Routes) == 0 {
+		in.Routes = nil
+	}
+	if in.VirtualPort != nil {
+		in.VirtualPort.canonicalize()
+	}
+	for i := range in.PortGroups {
+		in.PortGroups[i].canonicalize()
+	}
+	if len(in.PortGroups) == 0 {
+		in.PortGroups = nil
+	}
+}
+
+func (d *Network) Canonicalize() *Network {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *Network) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *Network) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *Network) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *Network) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *Network) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *Network) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *Network) schemaRef() (string, string) {
+	return "network.rng", ""
+}
+
+func (in *NetworkBandwidth) DeepCopy() *NetworkBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkBandwidth) DeepCopyInto(out *NetworkBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		out.Inbound = in.Inbound.DeepCopy()
+	}
+	if in.Outbound != nil {
+		out.Outbound = in.Outbound.DeepCopy()
+	}
+}
+
+func (a *NetworkBandwidth) Equal(b *NetworkBandwidth) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Inbound.Equal(b.Inbound) {
+		return false
+	}
+	if !a.Outbound.Equal(b.Outbound) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkBandwidth) canonicalize() {
+	if in.Inbound != nil {
+		in.Inbound.canonicalize()
+	}
+	if in.Outbound != nil {
+		in.Outbound.canonicalize()
+	}
+}
+
+func (in *NetworkBandwidth) empty() bool {
+	return in.Inbound == nil &&
+		in.Outbound == nil
+}
+
+func (in *NetworkBandwidthParams) DeepCopy() *NetworkBandwidthParams {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBandwidthParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkBandwidthParams) DeepCopyInto(out *NetworkBandwidthParams) {
+	*out = *in
+	if in.Average != nil {
+		v := *in.Average
+		out.Average = &v
+	}
+	if in.Peak != nil {
+		v := *in.Peak
+		out.Peak = &v
+	}
+	if in.Burst != nil {
+		v := *in.Burst
+		out.Burst = &v
+	}
+	if in.Floor != nil {
+		v := *in.Floor
+		out.Floor = &v
+	}
+}
+
+func (a *NetworkBandwidthParams) Equal(b *NetworkBandwidthParams) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Average == nil) != (b.Average == nil) || (a.Average != nil && *a.Average != *b.Average) {
+		return false
+	}
+	if (a.Peak == nil) != (b.Peak == nil) || (a.Peak != nil && *a.Peak != *b.Peak) {
+		return false
+	}
+	if (a.Burst == nil) != (b.Burst == nil) || (a.Burst != nil && *a.Burst != *b.Burst) {
+		return false
+	}
+	if (a.Floor == nil) != (b.Floor == nil) || (a.Floor != nil && *a.Floor != *b.Floor) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkBandwidthParams) canonicalize() {
+}
+
+func (in *NetworkBootp) DeepCopy() *NetworkBootp {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBootp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkBootp) DeepCopyInto(out *NetworkBootp) {
+	*out = *in
+}
+
+func (a *NetworkBootp) Equal(b *NetworkBootp) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.File != b.File {
+		return false
+	}
+	if a.Server != b.Server {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkBootp) canonicalize() {
+}
+
+func (in *NetworkBridge) DeepCopy() *NetworkBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkBridge) DeepCopyInto(out *NetworkBridge) {
+	*out = *in
+}
+
+func (a *NetworkBridge) Equal(b *NetworkBridge) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.STP != b.STP {
+		return false
+	}
+	if a.Delay != b.Delay {
+		return false
+	}
+	if a.MACTableManager != b.MACTableManager {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkBridge) canonicalize() {
+}
+
+func (in *NetworkDHCP) DeepCopy() *NetworkDHCP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDHCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDHCP) DeepCopyInto(out *NetworkDHCP) {
+	*out = *in
+	if in.Ranges != nil {
+		out.Ranges = make([]NetworkDHCPRange, len(in.Ranges))
+		for i := range in.Ranges {
+			in.Ranges[i].DeepCopyInto(&out.Ranges[i])
+		}
+	}
+	if in.Hosts != nil {
+		out.Hosts = make([]NetworkDHCPHost, len(in.Hosts))
+		for i := range in.Hosts {
+			in.Hosts[i].DeepCopyInto(&out.Hosts[i])
+		}
+	}
+	if in.Bootp != nil {
+		out.Bootp = make([]NetworkBootp, len(in.Bootp))
+		for i := range in.Bootp {
+			in.Bootp[i].DeepCopyInto(&out.Bootp[i])
+		}
+	}
+}
+
+func (a *NetworkDHCP) Equal(b *NetworkDHCP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Ranges) != len(b.Ranges) {
+		return false
+	}
+	for i := range a.Ranges {
+		if !a.Ranges[i].Equal(&b.Ranges[i]) {
+			return false
+		}
+	}
+	if len(a.Hosts) != len(b.Hosts) {
+		return false
+	}
+	for i := range a.Hosts {
+		if !a.Hosts[i].Equal(&b.Hosts[i]) {
+			return false
+		}
+	}
+	if len(a.Bootp) != len(b.Bootp) {
+		return false
+	}
+	for i := range a.Bootp {
+		if !a.Bootp[i].Equal(&b.Bootp[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NetworkDHCP) canonicalize() {
+	for i := range in.Ranges {
+		in.Ranges[i].canonicalize()
+	}
+	if len(in.Ranges) == 0 {
+		in.Ranges = nil
+	}
+	for i := range in.Hosts {
+		in.Hosts[i].canonicalize()
+	}
+	if len(in.Hosts) == 0 {
+		in.Hosts = nil
+	}
+	for i := range in.Bootp {
+		in.Bootp[i].canonicalize()
+	}
+	if len(in.Bootp) == 0 {
+		in.Bootp = nil
+	}
+}
+
+func (in *NetworkDHCPHost) DeepCopy() *NetworkDHCPHost {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDHCPHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDHCPHost) DeepCopyInto(out *NetworkDHCPHost) {
+	*out = *in
+}
+
+func (a *NetworkDHCPHost) Equal(b *NetworkDHCPHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.MAC != b.MAC {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.IP != b.IP {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDHCPHost) canonicalize() {
+}
+
+func (d *NetworkDHCPHost) Canonicalize() *NetworkDHCPHost {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkDHCPHost) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkDHCPHost) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkDHCPHost) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkDHCPHost) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkDHCPHost) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkDHCPHost) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkDHCPRange) DeepCopy() *NetworkDHCPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDHCPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDHCPRange) DeepCopyInto(out *NetworkDHCPRange) {
+	*out = *in
+}
+
+func (a *NetworkDHCPRange) Equal(b *NetworkDHCPRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Start != b.Start {
+		return false
+	}
+	if a.End != b.End {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDHCPRange) canonicalize() {
+}
+
+func (d *NetworkDHCPRange) Canonicalize() *NetworkDHCPRange {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkDHCPRange) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkDHCPRange) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkDHCPRange) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkDHCPRange) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkDHCPRange) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkDHCPRange) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkDNS) DeepCopy() *NetworkDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDNS) DeepCopyInto(out *NetworkDNS) {
+	*out = *in
+	if in.Forwarders != nil {
+		out.Forwarders = make([]NetworkDNSForwarder, len(in.Forwarders))
+		for i := range in.Forwarders {
+			in.Forwarders[i].DeepCopyInto(&out.Forwarders[i])
+		}
+	}
+	if in.TXTs != nil {
+		out.TXTs = make([]NetworkDNSTXT, len(in.TXTs))
+		for i := range in.TXTs {
+			in.TXTs[i].DeepCopyInto(&out.TXTs[i])
+		}
+	}
+	if in.Host != nil {
+		out.Host = make([]NetworkDNSHost, len(in.Host))
+		for i := range in.Host {
+			in.Host[i].DeepCopyInto(&out.Host[i])
+		}
+	}
+	if in.SRVs != nil {
+		out.SRVs = make([]NetworkDNSSRV, len(in.SRVs))
+		for i := range in.SRVs {
+			in.SRVs[i].DeepCopyInto(&out.SRVs[i])
+		}
+	}
+}
+
+func (a *NetworkDNS) Equal(b *NetworkDNS) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Enable != b.Enable {
+		return false
+	}
+	if a.ForwardPlainNames != b.ForwardPlainNames {
+		return false
+	}
+	if len(a.Forwarders) != len(b.Forwarders) {
+		return false
+	}
+	for i := range a.Forwarders {
+		if !a.Forwarders[i].Equal(&b.Forwarders[i]) {
+			return false
+		}
+	}
+	if len(a.TXTs) != len(b.TXTs) {
+		return false
+	}
+	for i := range a.TXTs {
+		if !a.TXTs[i].Equal(&b.TXTs[i]) {
+			return false
+		}
+	}
+	if len(a.Host) != len(b.Host) {
+		return false
+	}
+	for i := range a.Host {
+		if !a.Host[i].Equal(&b.Host[i]) {
+			return false
+		}
+	}
+	if len(a.SRVs) != len(b.SRVs) {
+		return false
+	}
+	for i := range a.SRVs {
+		if !a.SRVs[i].Equal(&b.SRVs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NetworkDNS) canonicalize() {
+	for i := range in.Forwarders {
+		in.Forwarders[i].canonicalize()
+	}
+	if len(in.Forwarders) == 0 {
+		in.Forwarders = nil
+	}
+	for i := range in.TXTs {
+		in.TXTs[i].canonicalize()
+	}
+	if len(in.TXTs) == 0 {
+		in.TXTs = nil
+	}
+	for i := range in.Host {
+		in.Host[i].canonicalize()
+	}
+	if len(in.Host) == 0 {
+		in.Host = nil
+	}
+	for i := range in.SRVs {
+		in.SRVs[i].canonicalize()
+	}
+	if len(in.SRVs) == 0 {
+		in.SRVs = nil
+	}
+}
+
+func (in *NetworkDNSForwarder) DeepCopy() *NetworkDNSForwarder {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNSForwarder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDNSForwarder) DeepCopyInto(out *NetworkDNSForwarder) {
+	*out = *in
+}
+
+func (a *NetworkDNSForwarder) Equal(b *NetworkDNSForwarder) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Domain != b.Domain {
+		return false
+	}
+	if a.Addr != b.Addr {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDNSForwarder) canonicalize() {
+}
+
+func (in *NetworkDNSHost) DeepCopy() *NetworkDNSHost {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNSHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDNSHost) DeepCopyInto(out *NetworkDNSHost) {
+	*out = *in
+	if in.Hostnames != nil {
+		out.Hostnames = make([]NetworkDNSHostHostname, len(in.Hostnames))
+		for i := range in.Hostnames {
+			in.Hostnames[i].DeepCopyInto(&out.Hostnames[i])
+		}
+	}
+}
+
+func (a *NetworkDNSHost) Equal(b *NetworkDNSHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IP != b.IP {
+		return false
+	}
+	if len(a.Hostnames) != len(b.Hostnames) {
+		return false
+	}
+	for i := range a.Hostnames {
+		if !a.Hostnames[i].Equal(&b.Hostnames[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NetworkDNSHost) canonicalize() {
+	for i := range in.Hostnames {
+		in.Hostnames[i].canonicalize()
+	}
+	if len(in.Hostnames) == 0 {
+		in.Hostnames = nil
+	}
+}
+
+func (d *NetworkDNSHost) Canonicalize() *NetworkDNSHost {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkDNSHost) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkDNSHost) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkDNSHost) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkDNSHost) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkDNSHost) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkDNSHost) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkDNSHostHostname) DeepCopy() *NetworkDNSHostHostname {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNSHostHostname)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDNSHostHostname) DeepCopyInto(out *NetworkDNSHostHostname) {
+	*out = *in
+}
+
+func (a *NetworkDNSHostHostname) Equal(b *NetworkDNSHostHostname) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Hostname != b.Hostname {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDNSHostHostname) canonicalize() {
+}
+
+func (in *NetworkDNSSRV) DeepCopy() *NetworkDNSSRV {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNSSRV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDNSSRV) DeepCopyInto(out *NetworkDNSSRV) {
+	*out = *in
+}
+
+func (a *NetworkDNSSRV) Equal(b *NetworkDNSSRV) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Service != b.Service {
+		return false
+	}
+	if a.Protocol != b.Protocol {
+		return false
+	}
+	if a.Target != b.Target {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	if a.Priority != b.Priority {
+		return false
+	}
+	if a.Weight != b.Weight {
+		return false
+	}
+	if a.Domain != b.Domain {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDNSSRV) canonicalize() {
+}
+
+func (d *NetworkDNSSRV) Canonicalize() *NetworkDNSSRV {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkDNSSRV) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkDNSSRV) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkDNSSRV) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkDNSSRV) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkDNSSRV) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkDNSSRV) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkDNSTXT) DeepCopy() *NetworkDNSTXT {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDNSTXT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDNSTXT) DeepCopyInto(out *NetworkDNSTXT) {
+	*out = *in
+}
+
+func (a *NetworkDNSTXT) Equal(b *NetworkDNSTXT) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDNSTXT) canonicalize() {
+}
+
+func (d *NetworkDNSTXT) Canonicalize() *NetworkDNSTXT {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkDNSTXT) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkDNSTXT) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkDNSTXT) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkDNSTXT) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkDNSTXT) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkDNSTXT) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkDomain) DeepCopy() *NetworkDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkDomain) DeepCopyInto(out *NetworkDomain) {
+	*out = *in
+}
+
+func (a *NetworkDomain) Equal(b *NetworkDomain) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.LocalOnly != b.LocalOnly {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkDomain) canonicalize() {
+}
+
+func (in *NetworkForward) DeepCopy() *NetworkForward {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForward)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForward) DeepCopyInto(out *NetworkForward) {
+	*out = *in
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	if in.PFs != nil {
+		out.PFs = make([]NetworkForwardPF, len(in.PFs))
+		for i := range in.PFs {
+			in.PFs[i].DeepCopyInto(&out.PFs[i])
+		}
+	}
+	if in.NAT != nil {
+		out.NAT = in.NAT.DeepCopy()
+	}
+	if in.Interfaces != nil {
+		out.Interfaces = make([]NetworkForwardInterface, len(in.Interfaces))
+		for i := range in.Interfaces {
+			in.Interfaces[i].DeepCopyInto(&out.Interfaces[i])
+		}
+	}
+	if in.Addresses != nil {
+		out.Addresses = make([]NetworkForwardAddress, len(in.Addresses))
+		for i := range in.Addresses {
+			in.Addresses[i].DeepCopyInto(&out.Addresses[i])
+		}
+	}
+}
+
+func (a *NetworkForward) Equal(b *NetworkForward) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalStringDefault(a.Mode, b.Mode, "nat") {
+		return false
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	if a.Managed != b.Managed {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if len(a.PFs) != len(b.PFs) {
+		return false
+	}
+	for i := range a.PFs {
+		if !a.PFs[i].Equal(&b.PFs[i]) {
+			return false
+		}
+	}
+	if !a.NAT.Equal(b.NAT) {
+		return false
+	}
+	if len(a.Interfaces) != len(b.Interfaces) {
+		return false
+	}
+	for i := range a.Interfaces {
+		if !a.Interfaces[i].Equal(&b.Interfaces[i]) {
+			return false
+		}
+	}
+	if len(a.Addresses) != len(b.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if !a.Addresses[i].Equal(&b.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NetworkForward) canonicalize() {
+	if in.Mode == "" {
+		in.Mode = "nat"
+	}
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	for i := range in.PFs {
+		in.PFs[i].canonicalize()
+	}
+	if len(in.PFs) == 0 {
+		in.PFs = nil
+	}
+	if in.NAT != nil {
+		in.NAT.canonicalize()
+		if in.NAT.empty() {
+			in.NAT = nil
+		}
+	}
+	for i := range in.Interfaces {
+		in.Interfaces[i].canonicalize()
+	}
+	if len(in.Interfaces) == 0 {
+		in.Interfaces = nil
+	}
+	for i := range in.Addresses {
+		in.Addresses[i].canonicalize()
+	}
+	if len(in.Addresses) == 0 {
+		in.Addresses = nil
+	}
+}
+
+func (in *NetworkForwardAddress) DeepCopy() *NetworkForwardAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardAddress) DeepCopyInto(out *NetworkForwardAddress) {
+	*out = *in
+	if in.PCI != nil {
+		out.PCI = in.PCI.DeepCopy()
+	}
+}
+
+func (a *NetworkForwardAddress) Equal(b *NetworkForwardAddress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.PCI.Equal(b.PCI) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardAddress) canonicalize() {
+	if in.PCI != nil {
+		in.PCI.canonicalize()
+	}
+}
+
+func (in *NetworkForwardAddressPCI) DeepCopy() *NetworkForwardAddressPCI {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardAddressPCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardAddressPCI) DeepCopyInto(out *NetworkForwardAddressPCI) {
+	*out = *in
+	if in.Domain != nil {
+		v := *in.Domain
+		out.Domain = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+	if in.Function != nil {
+		v := *in.Function
+		out.Function = &v
+	}
+}
+
+func (a *NetworkForwardAddressPCI) Equal(b *NetworkForwardAddressPCI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Domain == nil) != (b.Domain == nil) || (a.Domain != nil && *a.Domain != *b.Domain) {
+		return false
+	}
+	if (a.Bus == nil) != (b.Bus == nil) || (a.Bus != nil && *a.Bus != *b.Bus) {
+		return false
+	}
+	if (a.Slot == nil) != (b.Slot == nil) || (a.Slot != nil && *a.Slot != *b.Slot) {
+		return false
+	}
+	if (a.Function == nil) != (b.Function == nil) || (a.Function != nil && *a.Function != *b.Function) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardAddressPCI) canonicalize() {
+}
+
+func (in *NetworkForwardDriver) DeepCopy() *NetworkForwardDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardDriver) DeepCopyInto(out *NetworkForwardDriver) {
+	*out = *in
+}
+
+func (a *NetworkForwardDriver) Equal(b *NetworkForwardDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardDriver) canonicalize() {
+}
+
+func (in *NetworkForwardInterface) DeepCopy() *NetworkForwardInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardInterface) DeepCopyInto(out *NetworkForwardInterface) {
+	*out = *in
+}
+
+func (a *NetworkForwardInterface) Equal(b *NetworkForwardInterface) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardInterface) canonicalize() {
+}
+
+func (d *NetworkForwardInterface) Canonicalize() *NetworkForwardInterface {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkForwardInterface) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkForwardInterface) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkForwardInterface) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkForwardInterface) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkForwardInterface) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkForwardInterface) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkForwardNAT) DeepCopy() *NetworkForwardNAT {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardNAT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardNAT) DeepCopyInto(out *NetworkForwardNAT) {
+	*out = *in
+	if in.Addresses != nil {
+		out.Addresses = make([]NetworkForwardNATAddress, len(in.Addresses))
+		for i := range in.Addresses {
+			in.Addresses[i].DeepCopyInto(&out.Addresses[i])
+		}
+	}
+	if in.Ports != nil {
+		out.Ports = make([]NetworkForwardNATPort, len(in.Ports))
+		for i := range in.Ports {
+			in.Ports[i].DeepCopyInto(&out.Ports[i])
+		}
+	}
+}
+
+func (a *NetworkForwardNAT) Equal(b *NetworkForwardNAT) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Addresses) != len(b.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if !a.Addresses[i].Equal(&b.Addresses[i]) {
+			return false
+		}
+	}
+	if len(a.Ports) != len(b.Ports) {
+		return false
+	}
+	for i := range a.Ports {
+		if !a.Ports[i].Equal(&b.Ports[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NetworkForwardNAT) canonicalize() {
+	for i := range in.Addresses {
+		in.Addresses[i].canonicalize()
+	}
+	if len(in.Addresses) == 0 {
+		in.Addresses = nil
+	}
+	for i := range in.Ports {
+		in.Ports[i].canonicalize()
+	}
+	if len(in.Ports) == 0 {
+		in.Ports = nil
+	}
+}
+
+func (in *NetworkForwardNAT) empty() bool {
+	return len(in.Addresses) == 0 &&
+		len(in.Ports) == 0
+}
+
+func (in *NetworkForwardNATAddress) DeepCopy() *NetworkForwardNATAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardNATAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardNATAddress) DeepCopyInto(out *NetworkForwardNATAddress) {
+	*out = *in
+}
+
+func (a *NetworkForwardNATAddress) Equal(b *NetworkForwardNATAddress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Start != b.Start {
+		return false
+	}
+	if a.End != b.End {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardNATAddress) canonicalize() {
+}
+
+func (in *NetworkForwardNATPort) DeepCopy() *NetworkForwardNATPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardNATPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardNATPort) DeepCopyInto(out *NetworkForwardNATPort) {
+	*out = *in
+}
+
+func (a *NetworkForwardNATPort) Equal(b *NetworkForwardNATPort) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Start != b.Start {
+		return false
+	}
+	if a.End != b.End {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardNATPort) canonicalize() {
+}
+
+func (in *NetworkForwardPF) DeepCopy() *NetworkForwardPF {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkForwardPF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkForwardPF) DeepCopyInto(out *NetworkForwardPF) {
+	*out = *in
+}
+
+func (a *NetworkForwardPF) Equal(b *NetworkForwardPF) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Dev != b.Dev {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkForwardPF) canonicalize() {
+}
+
+func (in *NetworkIP) DeepCopy() *NetworkIP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkIP) DeepCopyInto(out *NetworkIP) {
+	*out = *in
+	if in.DHCP != nil {
+		out.DHCP = in.DHCP.DeepCopy()
+	}
+	if in.TFTP != nil {
+		out.TFTP = in.TFTP.DeepCopy()
+	}
+}
+
+func (a *NetworkIP) Equal(b *NetworkIP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if a.Netmask != b.Netmask {
+		return false
+	}
+	if a.Prefix != b.Prefix {
+		return false
+	}
+	if a.LocalPtr != b.LocalPtr {
+		return false
+	}
+	if !a.DHCP.Equal(b.DHCP) {
+		return false
+	}
+	if !a.TFTP.Equal(b.TFTP) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkIP) canonicalize() {
+	if in.DHCP != nil {
+		in.DHCP.canonicalize()
+	}
+	if in.TFTP != nil {
+		in.TFTP.canonicalize()
+	}
+}
+
+func (in *NetworkMAC) DeepCopy() *NetworkMAC {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkMAC) DeepCopyInto(out *NetworkMAC) {
+	*out = *in
+}
+
+func (a *NetworkMAC) Equal(b *NetworkMAC) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkMAC) canonicalize() {
+}
+
+func (in *NetworkMTU) DeepCopy() *NetworkMTU {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMTU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkMTU) DeepCopyInto(out *NetworkMTU) {
+	*out = *in
+}
+
+func (a *NetworkMTU) Equal(b *NetworkMTU) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Size != b.Size {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkMTU) canonicalize() {
+}
+
+func (in *NetworkMetadata) DeepCopy() *NetworkMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkMetadata) DeepCopyInto(out *NetworkMetadata) {
+	*out = *in
+}
+
+func (a *NetworkMetadata) Equal(b *NetworkMetadata) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.XML != b.XML {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkMetadata) canonicalize() {
+}
+
+func (in *NetworkPortGroup) DeepCopy() *NetworkPortGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPortGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkPortGroup) DeepCopyInto(out *NetworkPortGroup) {
+	*out = *in
+	if in.VLAN != nil {
+		out.VLAN = in.VLAN.DeepCopy()
+	}
+	if in.VirtualPort != nil {
+		out.VirtualPort = in.VirtualPort.DeepCopy()
+	}
+}
+
+func (a *NetworkPortGroup) Equal(b *NetworkPortGroup) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Default != b.Default {
+		return false
+	}
+	if a.TrustGuestRxFilters != b.TrustGuestRxFilters {
+		return false
+	}
+	if !a.VLAN.Equal(b.VLAN) {
+		return false
+	}
+	if !a.VirtualPort.Equal(b.VirtualPort) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkPortGroup) canonicalize() {
+	if in.VLAN != nil {
+		in.VLAN.canonicalize()
+	}
+	if in.VirtualPort != nil {
+		in.VirtualPort.canonicalize()
+	}
+}
+
+func (d *NetworkPortGroup) Canonicalize() *NetworkPortGroup {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NetworkPortGroup) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NetworkPortGroup) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NetworkPortGroup) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NetworkPortGroup) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NetworkPortGroup) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NetworkPortGroup) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (in *NetworkRoute) DeepCopy() *NetworkRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkRoute) DeepCopyInto(out *NetworkRoute) {
+	*out = *in
+}
+
+func (a *NetworkRoute) Equal(b *NetworkRoute) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Family != b.Family {
+		return false
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if a.Netmask != b.Netmask {
+		return false
+	}
+	if a.Prefix != b.Prefix {
+		return false
+	}
+	if a.Gateway != b.Gateway {
+		return false
+	}
+	if a.Metric != b.Metric {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkRoute) canonicalize() {
+}
+
+func (in *NetworkTFTP) DeepCopy() *NetworkTFTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkTFTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkTFTP) DeepCopyInto(out *NetworkTFTP) {
+	*out = *in
+}
+
+func (a *NetworkTFTP) Equal(b *NetworkTFTP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Root != b.Root {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkTFTP) canonicalize() {
+}
+
+func (in *NetworkVLAN) DeepCopy() *NetworkVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVLAN) DeepCopyInto(out *NetworkVLAN) {
+	*out = *in
+	if in.Tags != nil {
+		out.Tags = make([]NetworkVLANTag, len(in.Tags))
+		for i := range in.Tags {
+			in.Tags[i].DeepCopyInto(&out.Tags[i])
+		}
+	}
+}
+
+func (a *NetworkVLAN) Equal(b *NetworkVLAN) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Trunk != b.Trunk {
+		return false
+	}
+	if len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for i := range a.Tags {
+		if !a.Tags[i].Equal(&b.Tags[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NetworkVLAN) canonicalize() {
+	for i := range in.Tags {
+		in.Tags[i].canonicalize()
+	}
+	if len(in.Tags) == 0 {
+		in.Tags = nil
+	}
+}
+
+func (in *NetworkVLANTag) DeepCopy() *NetworkVLANTag {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVLANTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVLANTag) DeepCopyInto(out *NetworkVLANTag) {
+	*out = *in
+}
+
+func (a *NetworkVLANTag) Equal(b *NetworkVLANTag) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.NativeMode != b.NativeMode {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVLANTag) canonicalize() {
+}
+
+func (in *NetworkVirtualPort) DeepCopy() *NetworkVirtualPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPort) DeepCopyInto(out *NetworkVirtualPort) {
+	*out = *in
+	if in.Params != nil {
+		out.Params = in.Params.DeepCopy()
+	}
+}
+
+func (a *NetworkVirtualPort) Equal(b *NetworkVirtualPort) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Params.Equal(b.Params) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPort) canonicalize() {
+	if in.Params != nil {
+		in.Params.canonicalize()
+	}
+}
+
+func (in *NetworkVirtualPortParams) DeepCopy() *NetworkVirtualPortParams {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPortParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPortParams) DeepCopyInto(out *NetworkVirtualPortParams) {
+	*out = *in
+	if in.Any != nil {
+		out.Any = in.Any.DeepCopy()
+	}
+	if in.VEPA8021QBG != nil {
+		out.VEPA8021QBG = in.VEPA8021QBG.DeepCopy()
+	}
+	if in.VNTag8011QBH != nil {
+		out.VNTag8011QBH = in.VNTag8011QBH.DeepCopy()
+	}
+	if in.OpenVSwitch != nil {
+		out.OpenVSwitch = in.OpenVSwitch.DeepCopy()
+	}
+	if in.MidoNet != nil {
+		out.MidoNet = in.MidoNet.DeepCopy()
+	}
+}
+
+func (a *NetworkVirtualPortParams) Equal(b *NetworkVirtualPortParams) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Any.Equal(b.Any) {
+		return false
+	}
+	if !a.VEPA8021QBG.Equal(b.VEPA8021QBG) {
+		return false
+	}
+	if !a.VNTag8011QBH.Equal(b.VNTag8011QBH) {
+		return false
+	}
+	if !a.OpenVSwitch.Equal(b.OpenVSwitch) {
+		return false
+	}
+	if !a.MidoNet.Equal(b.MidoNet) {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPortParams) canonicalize() {
+	if in.Any != nil {
+		in.Any.canonicalize()
+	}
+	if in.VEPA8021QBG != nil {
+		in.VEPA8021QBG.canonicalize()
+	}
+	if in.VNTag8011QBH != nil {
+		in.VNTag8011QBH.canonicalize()
+	}
+	if in.OpenVSwitch != nil {
+		in.OpenVSwitch.canonicalize()
+	}
+	if in.MidoNet != nil {
+		in.MidoNet.canonicalize()
+	}
+}
+
+func (in *NetworkVirtualPortParamsAny) DeepCopy() *NetworkVirtualPortParamsAny {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPortParamsAny)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPortParamsAny) DeepCopyInto(out *NetworkVirtualPortParamsAny) {
+	*out = *in
+	if in.ManagerID != nil {
+		v := *in.ManagerID
+		out.ManagerID = &v
+	}
+	if in.TypeID != nil {
+		v := *in.TypeID
+		out.TypeID = &v
+	}
+	if in.TypeIDVersion != nil {
+		v := *in.TypeIDVersion
+		out.TypeIDVersion = &v
+	}
+}
+
+func (a *NetworkVirtualPortParamsAny) Equal(b *NetworkVirtualPortParamsAny) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.ManagerID == nil) != (b.ManagerID == nil) || (a.ManagerID != nil && *a.ManagerID != *b.ManagerID) {
+		return false
+	}
+	if (a.TypeID == nil) != (b.TypeID == nil) || (a.TypeID != nil && *a.TypeID != *b.TypeID) {
+		return false
+	}
+	if (a.TypeIDVersion == nil) != (b.TypeIDVersion == nil) || (a.TypeIDVersion != nil && *a.TypeIDVersion != *b.TypeIDVersion) {
+		return false
+	}
+	if a.InstanceID != b.InstanceID {
+		return false
+	}
+	if a.ProfileID != b.ProfileID {
+		return false
+	}
+	if a.InterfaceID != b.InterfaceID {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPortParamsAny) canonicalize() {
+}
+
+func (in *NetworkVirtualPortParamsMidoNet) DeepCopy() *NetworkVirtualPortParamsMidoNet {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPortParamsMidoNet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPortParamsMidoNet) DeepCopyInto(out *NetworkVirtualPortParamsMidoNet) {
+	*out = *in
+}
+
+func (a *NetworkVirtualPortParamsMidoNet) Equal(b *NetworkVirtualPortParamsMidoNet) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.InterfaceID != b.InterfaceID {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPortParamsMidoNet) canonicalize() {
+}
+
+func (in *NetworkVirtualPortParamsOpenVSwitch) DeepCopy() *NetworkVirtualPortParamsOpenVSwitch {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPortParamsOpenVSwitch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPortParamsOpenVSwitch) DeepCopyInto(out *NetworkVirtualPortParamsOpenVSwitch) {
+	*out = *in
+}
+
+func (a *NetworkVirtualPortParamsOpenVSwitch) Equal(b *NetworkVirtualPortParamsOpenVSwitch) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.InterfaceID != b.InterfaceID {
+		return false
+	}
+	if a.ProfileID != b.ProfileID {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPortParamsOpenVSwitch) canonicalize() {
+}
+
+func (in *NetworkVirtualPortParamsVEPA8021QBG) DeepCopy() *NetworkVirtualPortParamsVEPA8021QBG {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPortParamsVEPA8021QBG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPortParamsVEPA8021QBG) DeepCopyInto(out *NetworkVirtualPortParamsVEPA8021QBG) {
+	*out = *in
+	if in.ManagerID != nil {
+		v := *in.ManagerID
+		out.ManagerID = &v
+	}
+	if in.TypeID != nil {
+		v := *in.TypeID
+		out.TypeID = &v
+	}
+	if in.TypeIDVersion != nil {
+		v := *in.TypeIDVersion
+		out.TypeIDVersion = &v
+	}
+}
+
+func (a *NetworkVirtualPortParamsVEPA8021QBG) Equal(b *NetworkVirtualPortParamsVEPA8021QBG) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.ManagerID == nil) != (b.ManagerID == nil) || (a.ManagerID != nil && *a.ManagerID != *b.ManagerID) {
+		return false
+	}
+	if (a.TypeID == nil) != (b.TypeID == nil) || (a.TypeID != nil && *a.TypeID != *b.TypeID) {
+		return false
+	}
+	if (a.TypeIDVersion == nil) != (b.TypeIDVersion == nil) || (a.TypeIDVersion != nil && *a.TypeIDVersion != *b.TypeIDVersion) {
+		return false
+	}
+	if a.InstanceID != b.InstanceID {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPortParamsVEPA8021QBG) canonicalize() {
+}
+
+func (in *NetworkVirtualPortParamsVNTag8021QBH) DeepCopy() *NetworkVirtualPortParamsVNTag8021QBH {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVirtualPortParamsVNTag8021QBH)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkVirtualPortParamsVNTag8021QBH) DeepCopyInto(out *NetworkVirtualPortParamsVNTag8021QBH) {
+	*out = *in
+}
+
+func (a *NetworkVirtualPortParamsVNTag8021QBH) Equal(b *NetworkVirtualPortParamsVNTag8021QBH) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ProfileID != b.ProfileID {
+		return false
+	}
+	return true
+}
+
+func (in *NetworkVirtualPortParamsVNTag8021QBH) canonicalize() {
+}
+
+func (in *NodeDevice) DeepCopy() *NodeDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevice) DeepCopyInto(out *NodeDevice) {
+	*out = *in
+	if in.DevNodes != nil {
+		out.DevNodes = make([]NodeDeviceDevNode, len(in.DevNodes))
+		for i := range in.DevNodes {
+			in.DevNodes[i].DeepCopyInto(&out.DevNodes[i])
+		}
+	}
+	if in.Driver != nil {
+		out.Driver = in.Driver.DeepCopy()
+	}
+	in.Capability.DeepCopyInto(&out.Capability)
+}
+
+func (a *NodeDevice) Equal(b *NodeDevice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if len(a.DevNodes) != len(b.DevNodes) {
+		return false
+	}
+	for i := range a.DevNodes {
+		if !a.DevNodes[i].Equal(&b.DevNodes[i]) {
+			return false
+		}
+	}
+	if a.Parent != b.Parent {
+		return false
+	}
+	if !a.Driver.Equal(b.Driver) {
+		return false
+	}
+	if !a.Capability.Equal(&b.Capability) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevice) canonicalize() {
+	for i := range in.DevNodes {
+		in.DevNodes[i].canonicalize()
+	}
+	if len(in.DevNodes) == 0 {
+		in.DevNodes = nil
+	}
+	if in.Driver != nil {
+		in.Driver.canonicalize()
+	}
+	in.Capability.canonicalize()
+}
+
+func (d *NodeDevice) Canonicalize() *NodeDevice {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *NodeDevice) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *NodeDevice) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *NodeDevice) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *NodeDevice) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *NodeDevice) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *NodeDevice) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *NodeDevice) schemaRef() (string, string) {
+	return "nodedev.rng", ""
+}
+
+func (in *NodeDeviceAPMatrixCapability) DeepCopy() *NodeDeviceAPMatrixCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceAPMatrixCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceAPMatrixCapability) DeepCopyInto(out *NodeDeviceAPMatrixCapability) {
+	*out = *in
+	if in.Capabilities != nil {
+		out.Capabilities = make([]NodeDeviceAPMatrixSubCapability, len(in.Capabilities))
+		for i := range in.Capabilities {
+			in.Capabilities[i].DeepCopyInto(&out.Capabilities[i])
+		}
+	}
+}
+
+func (a *NodeDeviceAPMatrixCapability) Equal(b *NodeDeviceAPMatrixCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Capabilities) != len(b.Capabilities) {
+		return false
+	}
+	for i := range a.Capabilities {
+		if !a.Capabilities[i].Equal(&b.Capabilities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceAPMatrixCapability) canonicalize() {
+	for i := range in.Capabilities {
+		in.Capabilities[i].canonicalize()
+	}
+	if len(in.Capabilities) == 0 {
+		in.Capabilities = nil
+	}
+}
+
+func (in *NodeDeviceAPMatrixCapability) empty() bool {
+	return len(in.Capabilities) == 0
+}
+
+func (in *NodeDeviceAPMatrixSubCapability) DeepCopy() *NodeDeviceAPMatrixSubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceAPMatrixSubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceAPMatrixSubCapability) DeepCopyInto(out *NodeDeviceAPMatrixSubCapability) {
+	*out = *in
+	if in.MDevTypes != nil {
+		out.MDevTypes = in.MDevTypes.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceAPMatrixSubCapability) Equal(b *NodeDeviceAPMatrixSubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.MDevTypes.Equal(b.MDevTypes) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceAPMatrixSubCapability) canonicalize() {
+	if in.MDevTypes != nil {
+		in.MDevTypes.canonicalize()
+		if in.MDevTypes.empty() {
+			in.MDevTypes = nil
+		}
+	}
+}
+
+func (in *NodeDeviceCCWCapability) DeepCopy() *NodeDeviceCCWCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceCCWCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceCCWCapability) DeepCopyInto(out *NodeDeviceCCWCapability) {
+	*out = *in
+	if in.CSSID != nil {
+		v := *in.CSSID
+		out.CSSID = &v
+	}
+	if in.SSID != nil {
+		v := *in.SSID
+		out.SSID = &v
+	}
+	if in.DevNo != nil {
+		v := *in.DevNo
+		out.DevNo = &v
+	}
+}
+
+func (a *NodeDeviceCCWCapability) Equal(b *NodeDeviceCCWCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.CSSID == nil) != (b.CSSID == nil) || (a.CSSID != nil && *a.CSSID != *b.CSSID) {
+		return false
+	}
+	if (a.SSID == nil) != (b.SSID == nil) || (a.SSID != nil && *a.SSID != *b.SSID) {
+		return false
+	}
+	if (a.DevNo == nil) != (b.DevNo == nil) || (a.DevNo != nil && *a.DevNo != *b.DevNo) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceCCWCapability) canonicalize() {
+}
+
+func (in *NodeDeviceCCWCapability) empty() bool {
+	return in.CSSID == nil &&
+		in.SSID == nil &&
+		in.DevNo == nil
+}
+
+func (in *NodeDeviceCSSCapability) DeepCopy() *NodeDeviceCSSCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceCSSCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceCSSCapability) DeepCopyInto(out *NodeDeviceCSSCapability) {
+	*out = *in
+	if in.CSSID != nil {
+		v := *in.CSSID
+		out.CSSID = &v
+	}
+	if in.SSID != nil {
+		v := *in.SSID
+		out.SSID = &v
+	}
+	if in.DevNo != nil {
+		v := *in.DevNo
+		out.DevNo = &v
+	}
+	if in.Capabilities != nil {
+		out.Capabilities = make([]NodeDeviceCSSSubCapability, len(in.Capabilities))
+		for i := range in.Capabilities {
+			in.Capabilities[i].DeepCopyInto(&out.Capabilities[i])
+		}
+	}
+}
+
+func (a *NodeDeviceCSSCapability) Equal(b *NodeDeviceCSSCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.CSSID == nil) != (b.CSSID == nil) || (a.CSSID != nil && *a.CSSID != *b.CSSID) {
+		return false
+	}
+	if (a.SSID == nil) != (b.SSID == nil) || (a.SSID != nil && *a.SSID != *b.SSID) {
+		return false
+	}
+	if (a.DevNo == nil) != (b.DevNo == nil) || (a.DevNo != nil && *a.DevNo != *b.DevNo) {
+		return false
+	}
+	if len(a.Capabilities) != len(b.Capabilities) {
+		return false
+	}
+	for i := range a.Capabilities {
+		if !a.Capabilities[i].Equal(&b.Capabilities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceCSSCapability) canonicalize() {
+	for i := range in.Capabilities {
+		in.Capabilities[i].canonicalize()
+	}
+	if len(in.Capabilities) == 0 {
+		in.Capabilities = nil
+	}
+}
+
+func (in *NodeDeviceCSSSubCapability) DeepCopy() *NodeDeviceCSSSubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceCSSSubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceCSSSubCapability) DeepCopyInto(out *NodeDeviceCSSSubCapability) {
+	*out = *in
+	if in.MDevTypes != nil {
+		out.MDevTypes = in.MDevTypes.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceCSSSubCapability) Equal(b *NodeDeviceCSSSubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.MDevTypes.Equal(b.MDevTypes) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceCSSSubCapability) canonicalize() {
+	if in.MDevTypes != nil {
+		in.MDevTypes.canonicalize()
+		if in.MDevTypes.empty() {
+			in.MDevTypes = nil
+		}
+	}
+}
+
+func (in *NodeDeviceCapability) DeepCopy() *NodeDeviceCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceCapability) DeepCopyInto(out *NodeDeviceCapability) {
+	*out = *in
+	if in.System != nil {
+		out.System = in.System.DeepCopy()
+	}
+	if in.PCI != nil {
+		out.PCI = in.PCI.DeepCopy()
+	}
+	if in.USB != nil {
+		out.USB = in.USB.DeepCopy()
+	}
+	if in.USBDevice != nil {
+		out.USBDevice = in.USBDevice.DeepCopy()
+	}
+	if in.Net != nil {
+		out.Net = in.Net.DeepCopy()
+	}
+	if in.SCSIHost != nil {
+		out.SCSIHost = in.SCSIHost.DeepCopy()
+	}
+	if in.SCSITarget != nil {
+		out.SCSITarget = in.SCSITarget.DeepCopy()
+	}
+	if in.SCSI != nil {
+		out.SCSI = in.SCSI.DeepCopy()
+	}
+	if in.Storage != nil {
+		out.Storage = in.Storage.DeepCopy()
+	}
+	if in.DRM != nil {
+		out.DRM = in.DRM.DeepCopy()
+	}
+	if in.CCW != nil {
+		out.CCW = in.CCW.DeepCopy()
+	}
+	if in.CSS != nil {
+		out.CSS = in.CSS.DeepCopy()
+	}
+	if in.APMatrix != nil {
+		out.APMatrix = in.APMatrix.DeepCopy()
+	}
+	if in.MDev != nil {
+		out.MDev = in.MDev.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceCapability) Equal(b *NodeDeviceCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.System.Equal(b.System) {
+		return false
+	}
+	if !a.PCI.Equal(b.PCI) {
+		return false
+	}
+	if !a.USB.Equal(b.USB) {
+		return false
+	}
+	if !a.USBDevice.Equal(b.USBDevice) {
+		return false
+	}
+	if !a.Net.Equal(b.Net) {
+		return false
+	}
+	if !a.SCSIHost.Equal(b.SCSIHost) {
+		return false
+	}
+	if !a.SCSITarget.Equal(b.SCSITarget) {
+		return false
+	}
+	if !a.SCSI.Equal(b.SCSI) {
+		return false
+	}
+	if !a.Storage.Equal(b.Storage) {
+		return false
+	}
+	if !a.DRM.Equal(b.DRM) {
+		return false
+	}
+	if !a.CCW.Equal(b.CCW) {
+		return false
+	}
+	if !a.CSS.Equal(b.CSS) {
+		return false
+	}
+	if !a.APMatrix.Equal(b.APMatrix) {
+		return false
+	}
+	if !a.MDev.Equal(b.MDev) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceCapability) canonicalize() {
+	if in.System != nil {
+		in.System.canonicalize()
+	}
+	if in.PCI != nil {
+		in.PCI.canonicalize()
+	}
+	if in.USB != nil {
+		in.USB.canonicalize()
+	}
+	if in.USBDevice != nil {
+		in.USBDevice.canonicalize()
+	}
+	if in.Net != nil {
+		in.Net.canonicalize()
+	}
+	if in.SCSIHost != nil {
+		in.SCSIHost.canonicalize()
+	}
+	if in.SCSITarget != nil {
+		in.SCSITarget.canonicalize()
+	}
+	if in.SCSI != nil {
+		in.SCSI.canonicalize()
+	}
+	if in.Storage != nil {
+		in.Storage.canonicalize()
+	}
+	if in.DRM != nil {
+		in.DRM.canonicalize()
+	}
+	if in.CCW != nil {
+		in.CCW.canonicalize()
+		if in.CCW.empty() {
+			in.CCW = nil
+		}
+	}
+	if in.CSS != nil {
+		in.CSS.canonicalize()
+	}
+	if in.APMatrix != nil {
+		in.APMatrix.canonicalize()
+		if in.APMatrix.empty() {
+			in.APMatrix = nil
+		}
+	}
+	if in.MDev != nil {
+		in.MDev.canonicalize()
+	}
+}
+
+func (in *NodeDeviceDRMCapability) DeepCopy() *NodeDeviceDRMCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceDRMCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceDRMCapability) DeepCopyInto(out *NodeDeviceDRMCapability) {
+	*out = *in
+}
+
+func (a *NodeDeviceDRMCapability) Equal(b *NodeDeviceDRMCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceDRMCapability) canonicalize() {
+}
+
+func (in *NodeDeviceDevNode) DeepCopy() *NodeDeviceDevNode {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceDevNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceDevNode) DeepCopyInto(out *NodeDeviceDevNode) {
+	*out = *in
+}
+
+func (a *NodeDeviceDevNode) Equal(b *NodeDeviceDevNode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceDevNode) canonicalize() {
+}
+
+func (in *NodeDeviceDriver) DeepCopy() *NodeDeviceDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceDriver) DeepCopyInto(out *NodeDeviceDriver) {
+	*out = *in
+}
+
+func (a *NodeDeviceDriver) Equal(b *NodeDeviceDriver) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceDriver) canonicalize() {
+}
+
+func (in *NodeDeviceIDName) DeepCopy() *NodeDeviceIDName {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceIDName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceIDName) DeepCopyInto(out *NodeDeviceIDName) {
+	*out = *in
+}
+
+func (a *NodeDeviceIDName) Equal(b *NodeDeviceIDName) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalHex(a.ID, b.ID) {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceIDName) canonicalize() {
+	in.ID = canonicalHex(in.ID)
+}
+
+func (in *NodeDeviceIOMMUGroup) DeepCopy() *NodeDeviceIOMMUGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceIOMMUGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceIOMMUGroup) DeepCopyInto(out *NodeDeviceIOMMUGroup) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = make([]NodeDevicePCIAddress, len(in.Address))
+		for i := range in.Address {
+			in.Address[i].DeepCopyInto(&out.Address[i])
+		}
+	}
+}
+
+func (a *NodeDeviceIOMMUGroup) Equal(b *NodeDeviceIOMMUGroup) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Number != b.Number {
+		return false
+	}
+	if len(a.Address) != len(b.Address) {
+		return false
+	}
+	for i := range a.Address {
+		if !a.Address[i].Equal(&b.Address[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceIOMMUGroup) canonicalize() {
+	for i := range in.Address {
+		in.Address[i].canonicalize()
+	}
+	if len(in.Address) == 0 {
+		in.Address = nil
+	}
+}
+
+func (in *NodeDeviceMDevCapability) DeepCopy() *NodeDeviceMDevCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceMDevCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceMDevCapability) DeepCopyInto(out *NodeDeviceMDevCapability) {
+	*out = *in
+	if in.Type != nil {
+		out.Type = in.Type.DeepCopy()
+	}
+	if in.IOMMUGroup != nil {
+		out.IOMMUGroup = in.IOMMUGroup.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceMDevCapability) Equal(b *NodeDeviceMDevCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Type.Equal(b.Type) {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if !a.IOMMUGroup.Equal(b.IOMMUGroup) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceMDevCapability) canonicalize() {
+	if in.Type != nil {
+		in.Type.canonicalize()
+	}
+	if in.IOMMUGroup != nil {
+		in.IOMMUGroup.canonicalize()
+	}
+}
+
+func (in *NodeDeviceMDevCapabilityType) DeepCopy() *NodeDeviceMDevCapabilityType {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceMDevCapabilityType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceMDevCapabilityType) DeepCopyInto(out *NodeDeviceMDevCapabilityType) {
+	*out = *in
+}
+
+func (a *NodeDeviceMDevCapabilityType) Equal(b *NodeDeviceMDevCapabilityType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceMDevCapabilityType) canonicalize() {
+}
+
+func (in *NodeDeviceNUMA) DeepCopy() *NodeDeviceNUMA {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNUMA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNUMA) DeepCopyInto(out *NodeDeviceNUMA) {
+	*out = *in
+}
+
+func (a *NodeDeviceNUMA) Equal(b *NodeDeviceNUMA) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Node != b.Node {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceNUMA) canonicalize() {
+}
+
+func (in *NodeDeviceNet80203Capability) DeepCopy() *NodeDeviceNet80203Capability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNet80203Capability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNet80203Capability) DeepCopyInto(out *NodeDeviceNet80203Capability) {
+	*out = *in
+}
+
+func (a *NodeDeviceNet80203Capability) Equal(b *NodeDeviceNet80203Capability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *NodeDeviceNet80203Capability) canonicalize() {
+}
+
+func (in *NodeDeviceNet80211Capability) DeepCopy() *NodeDeviceNet80211Capability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNet80211Capability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNet80211Capability) DeepCopyInto(out *NodeDeviceNet80211Capability) {
+	*out = *in
+}
+
+func (a *NodeDeviceNet80211Capability) Equal(b *NodeDeviceNet80211Capability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *NodeDeviceNet80211Capability) canonicalize() {
+}
+
+func (in *NodeDeviceNetCapability) DeepCopy() *NodeDeviceNetCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNetCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNetCapability) DeepCopyInto(out *NodeDeviceNetCapability) {
+	*out = *in
+	if in.Link != nil {
+		out.Link = in.Link.DeepCopy()
+	}
+	if in.Features != nil {
+		out.Features = make([]NodeDeviceNetOffloadFeatures, len(in.Features))
+		for i := range in.Features {
+			in.Features[i].DeepCopyInto(&out.Features[i])
+		}
+	}
+	if in.Capability != nil {
+		out.Capability = make([]NodeDeviceNetSubCapability, len(in.Capability))
+		for i := range in.Capability {
+			in.Capability[i].DeepCopyInto(&out.Capability[i])
+		}
+	}
+}
+
+func (a *NodeDeviceNetCapability) Equal(b *NodeDeviceNetCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Interface != b.Interface {
+		return false
+	}
+	if a.Address != b.Address {
+		return false
+	}
+	if !a.Link.Equal(b.Link) {
+		return false
+	}
+	if len(a.Features) != len(b.Features) {
+		return false
+	}
+	for i := range a.Features {
+		if !a.Features[i].Equal(&b.Features[i]) {
+			return false
+		}
+	}
+	if len(a.Capability) != len(b.Capability) {
+		return false
+	}
+	for i := range a.Capability {
+		if !a.Capability[i].Equal(&b.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceNetCapability) canonicalize() {
+	if in.Link != nil {
+		in.Link.canonicalize()
+	}
+	for i := range in.Features {
+		in.Features[i].canonicalize()
+	}
+	if len(in.Features) == 0 {
+		in.Features = nil
+	}
+	for i := range in.Capability {
+		in.Capability[i].canonicalize()
+	}
+	if len(in.Capability) == 0 {
+		in.Capability = nil
+	}
+}
+
+func (in *NodeDeviceNetLink) DeepCopy() *NodeDeviceNetLink {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNetLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNetLink) DeepCopyInto(out *NodeDeviceNetLink) {
+	*out = *in
+}
+
+func (a *NodeDeviceNetLink) Equal(b *NodeDeviceNetLink) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.State != b.State {
+		return false
+	}
+	if a.Speed != b.Speed {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceNetLink) canonicalize() {
+}
+
+func (in *NodeDeviceNetOffloadFeatures) DeepCopy() *NodeDeviceNetOffloadFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNetOffloadFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNetOffloadFeatures) DeepCopyInto(out *NodeDeviceNetOffloadFeatures) {
+	*out = *in
+}
+
+func (a *NodeDeviceNetOffloadFeatures) Equal(b *NodeDeviceNetOffloadFeatures) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceNetOffloadFeatures) canonicalize() {
+}
+
+func (in *NodeDeviceNetSubCapability) DeepCopy() *NodeDeviceNetSubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceNetSubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceNetSubCapability) DeepCopyInto(out *NodeDeviceNetSubCapability) {
+	*out = *in
+	if in.Wireless80211 != nil {
+		out.Wireless80211 = in.Wireless80211.DeepCopy()
+	}
+	if in.Ethernet80203 != nil {
+		out.Ethernet80203 = in.Ethernet80203.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceNetSubCapability) Equal(b *NodeDeviceNetSubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Wireless80211.Equal(b.Wireless80211) {
+		return false
+	}
+	if !a.Ethernet80203.Equal(b.Ethernet80203) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceNetSubCapability) canonicalize() {
+	if in.Wireless80211 != nil {
+		in.Wireless80211.canonicalize()
+	}
+	if in.Ethernet80203 != nil {
+		in.Ethernet80203.canonicalize()
+	}
+}
+
+func (in *NodeDevicePCIAddress) DeepCopy() *NodeDevicePCIAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIAddress) DeepCopyInto(out *NodeDevicePCIAddress) {
+	*out = *in
+	if in.Domain != nil {
+		v := *in.Domain
+		out.Domain = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+	if in.Function != nil {
+		v := *in.Function
+		out.Function = &v
+	}
+}
+
+func (a *NodeDevicePCIAddress) Equal(b *NodeDevicePCIAddress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Domain == nil) != (b.Domain == nil) || (a.Domain != nil && *a.Domain != *b.Domain) {
+		return false
+	}
+	if (a.Bus == nil) != (b.Bus == nil) || (a.Bus != nil && *a.Bus != *b.Bus) {
+		return false
+	}
+	if (a.Slot == nil) != (b.Slot == nil) || (a.Slot != nil && *a.Slot != *b.Slot) {
+		return false
+	}
+	if (a.Function == nil) != (b.Function == nil) || (a.Function != nil && *a.Function != *b.Function) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevicePCIAddress) canonicalize() {
+}
+
+func (in *NodeDevicePCIBridgeCapability) DeepCopy() *NodeDevicePCIBridgeCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIBridgeCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIBridgeCapability) DeepCopyInto(out *NodeDevicePCIBridgeCapability) {
+	*out = *in
+}
+
+func (a *NodeDevicePCIBridgeCapability) Equal(b *NodeDevicePCIBridgeCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return true
+}
+
+func (in *NodeDevicePCIBridgeCapability) canonicalize() {
+}
+
+func (in *NodeDevicePCICapability) DeepCopy() *NodeDevicePCICapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCICapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCICapability) DeepCopyInto(out *NodeDevicePCICapability) {
+	*out = *in
+	if in.Domain != nil {
+		v := *in.Domain
+		out.Domain = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+	if in.Function != nil {
+		v := *in.Function
+		out.Function = &v
+	}
+	in.Product.DeepCopyInto(&out.Product)
+	in.Vendor.DeepCopyInto(&out.Vendor)
+	if in.IOMMUGroup != nil {
+		out.IOMMUGroup = in.IOMMUGroup.DeepCopy()
+	}
+	if in.NUMA != nil {
+		out.NUMA = in.NUMA.DeepCopy()
+	}
+	if in.PCIExpress != nil {
+		out.PCIExpress = in.PCIExpress.DeepCopy()
+	}
+	if in.Capabilities != nil {
+		out.Capabilities = make([]NodeDevicePCISubCapability, len(in.Capabilities))
+		for i := range in.Capabilities {
+			in.Capabilities[i].DeepCopyInto(&out.Capabilities[i])
+		}
+	}
+}
+
+func (a *NodeDevicePCICapability) Equal(b *NodeDevicePCICapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Domain == nil) != (b.Domain == nil) || (a.Domain != nil && *a.Domain != *b.Domain) {
+		return false
+	}
+	if (a.Bus == nil) != (b.Bus == nil) || (a.Bus != nil && *a.Bus != *b.Bus) {
+		return false
+	}
+	if (a.Slot == nil) != (b.Slot == nil) || (a.Slot != nil && *a.Slot != *b.Slot) {
+		return false
+	}
+	if (a.Function == nil) != (b.Function == nil) || (a.Function != nil && *a.Function != *b.Function) {
+		return false
+	}
+	if !a.Product.Equal(&b.Product) {
+		return false
+	}
+	if !a.Vendor.Equal(&b.Vendor) {
+		return false
+	}
+	if !a.IOMMUGroup.Equal(b.IOMMUGroup) {
+		return false
+	}
+	if !a.NUMA.Equal(b.NUMA) {
+		return false
+	}
+	if !a.PCIExpress.Equal(b.PCIExpress) {
+		return false
+	}
+	if len(a.Capabilities) != len(b.Capabilities) {
+		return false
+	}
+	for i := range a.Capabilities {
+		if !a.Capabilities[i].Equal(&b.Capabilities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDevicePCICapability) canonicalize() {
+	in.Product.canonicalize()
+	in.Vendor.canonicalize()
+	if in.IOMMUGroup != nil {
+		in.IOMMUGroup.canonicalize()
+	}
+	if in.NUMA != nil {
+		in.NUMA.canonicalize()
+	}
+	if in.PCIExpress != nil {
+		in.PCIExpress.canonicalize()
+		if in.PCIExpress.empty() {
+			in.PCIExpress = nil
+		}
+	}
+	for i := range in.Capabilities {
+		in.Capabilities[i].canonicalize()
+	}
+	if len(in.Capabilities) == 0 {
+		in.Capabilities = nil
+	}
+}
+
+func (in *NodeDevicePCIExpress) DeepCopy() *NodeDevicePCIExpress {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIExpress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIExpress) DeepCopyInto(out *NodeDevicePCIExpress) {
+	*out = *in
+	if in.Links != nil {
+		out.Links = make([]NodeDevicePCIExpressLink, len(in.Links))
+		for i := range in.Links {
+			in.Links[i].DeepCopyInto(&out.Links[i])
+		}
+	}
+}
+
+func (a *NodeDevicePCIExpress) Equal(b *NodeDevicePCIExpress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Links) != len(b.Links) {
+		return false
+	}
+	for i := range a.Links {
+		if !a.Links[i].Equal(&b.Links[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDevicePCIExpress) canonicalize() {
+	for i := range in.Links {
+		in.Links[i].canonicalize()
+	}
+	if len(in.Links) == 0 {
+		in.Links = nil
+	}
+}
+
+func (in *NodeDevicePCIExpress) empty() bool {
+	return len(in.Links) == 0
+}
+
+func (in *NodeDevicePCIExpressLink) DeepCopy() *NodeDevicePCIExpressLink {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIExpressLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIExpressLink) DeepCopyInto(out *NodeDevicePCIExpressLink) {
+	*out = *in
+	if in.Port != nil {
+		v := *in.Port
+		out.Port = &v
+	}
+	if in.Width != nil {
+		v := *in.Width
+		out.Width = &v
+	}
+}
+
+func (a *NodeDevicePCIExpressLink) Equal(b *NodeDevicePCIExpressLink) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Validity != b.Validity {
+		return false
+	}
+	if a.Speed != b.Speed {
+		return false
+	}
+	if (a.Port == nil) != (b.Port == nil) || (a.Port != nil && *a.Port != *b.Port) {
+		return false
+	}
+	if (a.Width == nil) != (b.Width == nil) || (a.Width != nil && *a.Width != *b.Width) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevicePCIExpressLink) canonicalize() {
+}
+
+func (in *NodeDevicePCIMDevType) DeepCopy() *NodeDevicePCIMDevType {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIMDevType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIMDevType) DeepCopyInto(out *NodeDevicePCIMDevType) {
+	*out = *in
+}
+
+func (a *NodeDevicePCIMDevType) Equal(b *NodeDevicePCIMDevType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.DeviceAPI != b.DeviceAPI {
+		return false
+	}
+	if a.AvailableInstances != b.AvailableInstances {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevicePCIMDevType) canonicalize() {
+}
+
+func (in *NodeDevicePCIMDevTypesCapability) DeepCopy() *NodeDevicePCIMDevTypesCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIMDevTypesCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIMDevTypesCapability) DeepCopyInto(out *NodeDevicePCIMDevTypesCapability) {
+	*out = *in
+	if in.Types != nil {
+		out.Types = make([]NodeDevicePCIMDevType, len(in.Types))
+		for i := range in.Types {
+			in.Types[i].DeepCopyInto(&out.Types[i])
+		}
+	}
+}
+
+func (a *NodeDevicePCIMDevTypesCapability) Equal(b *NodeDevicePCIMDevTypesCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Types) != len(b.Types) {
+		return false
+	}
+	for i := range a.Types {
+		if !a.Types[i].Equal(&b.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDevicePCIMDevTypesCapability) canonicalize() {
+	for i := range in.Types {
+		in.Types[i].canonicalize()
+	}
+	if len(in.Types) == 0 {
+		in.Types = nil
+	}
+}
+
+func (in *NodeDevicePCIMDevTypesCapability) empty() bool {
+	return len(in.Types) == 0
+}
+
+func (in *NodeDevicePCIPhysFunctionCapability) DeepCopy() *NodeDevicePCIPhysFunctionCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIPhysFunctionCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIPhysFunctionCapability) DeepCopyInto(out *NodeDevicePCIPhysFunctionCapability) {
+	*out = *in
+	in.Address.DeepCopyInto(&out.Address)
+}
+
+func (a *NodeDevicePCIPhysFunctionCapability) Equal(b *NodeDevicePCIPhysFunctionCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Address.Equal(&b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevicePCIPhysFunctionCapability) canonicalize() {
+	in.Address.canonicalize()
+}
+
+func (in *NodeDevicePCISubCapability) DeepCopy() *NodeDevicePCISubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCISubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCISubCapability) DeepCopyInto(out *NodeDevicePCISubCapability) {
+	*out = *in
+	if in.VirtFunctions != nil {
+		out.VirtFunctions = in.VirtFunctions.DeepCopy()
+	}
+	if in.PhysFunction != nil {
+		out.PhysFunction = in.PhysFunction.DeepCopy()
+	}
+	if in.MDevTypes != nil {
+		out.MDevTypes = in.MDevTypes.DeepCopy()
+	}
+	if in.Bridge != nil {
+		out.Bridge = in.Bridge.DeepCopy()
+	}
+}
+
+func (a *NodeDevicePCISubCapability) Equal(b *NodeDevicePCISubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.VirtFunctions.Equal(b.VirtFunctions) {
+		return false
+	}
+	if !a.PhysFunction.Equal(b.PhysFunction) {
+		return false
+	}
+	if !a.MDevTypes.Equal(b.MDevTypes) {
+		return false
+	}
+	if !a.Bridge.Equal(b.Bridge) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevicePCISubCapability) canonicalize() {
+	if in.VirtFunctions != nil {
+		in.VirtFunctions.canonicalize()
+	}
+	if in.PhysFunction != nil {
+		in.PhysFunction.canonicalize()
+	}
+	if in.MDevTypes != nil {
+		in.MDevTypes.canonicalize()
+		if in.MDevTypes.empty() {
+			in.MDevTypes = nil
+		}
+	}
+	if in.Bridge != nil {
+		in.Bridge.canonicalize()
+	}
+}
+
+func (in *NodeDevicePCIVirtFunctionsCapability) DeepCopy() *NodeDevicePCIVirtFunctionsCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDevicePCIVirtFunctionsCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDevicePCIVirtFunctionsCapability) DeepCopyInto(out *NodeDevicePCIVirtFunctionsCapability) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = make([]NodeDevicePCIAddress, len(in.Address))
+		for i := range in.Address {
+			in.Address[i].DeepCopyInto(&out.Address[i])
+		}
+	}
+}
+
+func (a *NodeDevicePCIVirtFunctionsCapability) Equal(b *NodeDevicePCIVirtFunctionsCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Address) != len(b.Address) {
+		return false
+	}
+	for i := range a.Address {
+		if !a.Address[i].Equal(&b.Address[i]) {
+			return false
+		}
+	}
+	if a.MaxCount != b.MaxCount {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDevicePCIVirtFunctionsCapability) canonicalize() {
+	for i := range in.Address {
+		in.Address[i].canonicalize()
+	}
+	if len(in.Address) == 0 {
+		in.Address = nil
+	}
+}
+
+func (in *NodeDeviceSCSICapability) DeepCopy() *NodeDeviceSCSICapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSICapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSICapability) DeepCopyInto(out *NodeDeviceSCSICapability) {
+	*out = *in
+}
+
+func (a *NodeDeviceSCSICapability) Equal(b *NodeDeviceSCSICapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Host != b.Host {
+		return false
+	}
+	if a.Bus != b.Bus {
+		return false
+	}
+	if a.Target != b.Target {
+		return false
+	}
+	if a.Lun != b.Lun {
+		return false
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSICapability) canonicalize() {
+}
+
+func (in *NodeDeviceSCSIFCHostCapability) DeepCopy() *NodeDeviceSCSIFCHostCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSIFCHostCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSIFCHostCapability) DeepCopyInto(out *NodeDeviceSCSIFCHostCapability) {
+	*out = *in
+}
+
+func (a *NodeDeviceSCSIFCHostCapability) Equal(b *NodeDeviceSCSIFCHostCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.WWNN != b.WWNN {
+		return false
+	}
+	if a.WWPN != b.WWPN {
+		return false
+	}
+	if a.FabricWWN != b.FabricWWN {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSIFCHostCapability) canonicalize() {
+}
+
+func (in *NodeDeviceSCSIFCRemotePortCapability) DeepCopy() *NodeDeviceSCSIFCRemotePortCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSIFCRemotePortCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSIFCRemotePortCapability) DeepCopyInto(out *NodeDeviceSCSIFCRemotePortCapability) {
+	*out = *in
+}
+
+func (a *NodeDeviceSCSIFCRemotePortCapability) Equal(b *NodeDeviceSCSIFCRemotePortCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.RPort != b.RPort {
+		return false
+	}
+	if a.WWPN != b.WWPN {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSIFCRemotePortCapability) canonicalize() {
+}
+
+func (in *NodeDeviceSCSIHostCapability) DeepCopy() *NodeDeviceSCSIHostCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSIHostCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSIHostCapability) DeepCopyInto(out *NodeDeviceSCSIHostCapability) {
+	*out = *in
+	if in.UniqueID != nil {
+		v := *in.UniqueID
+		out.UniqueID = &v
+	}
+	if in.Capability != nil {
+		out.Capability = make([]NodeDeviceSCSIHostSubCapability, len(in.Capability))
+		for i := range in.Capability {
+			in.Capability[i].DeepCopyInto(&out.Capability[i])
+		}
+	}
+}
+
+func (a *NodeDeviceSCSIHostCapability) Equal(b *NodeDeviceSCSIHostCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Host != b.Host {
+		return false
+	}
+	if (a.UniqueID == nil) != (b.UniqueID == nil) || (a.UniqueID != nil && *a.UniqueID != *b.UniqueID) {
+		return false
+	}
+	if len(a.Capability) != len(b.Capability) {
+		return false
+	}
+	for i := range a.Capability {
+		if !a.Capability[i].Equal(&b.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSIHostCapability) canonicalize() {
+	for i := range in.Capability {
+		in.Capability[i].canonicalize()
+	}
+	if len(in.Capability) == 0 {
+		in.Capability = nil
+	}
+}
+
+func (in *NodeDeviceSCSIHostSubCapability) DeepCopy() *NodeDeviceSCSIHostSubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSIHostSubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSIHostSubCapability) DeepCopyInto(out *NodeDeviceSCSIHostSubCapability) {
+	*out = *in
+	if in.VPortOps != nil {
+		out.VPortOps = in.VPortOps.DeepCopy()
+	}
+	if in.FCHost != nil {
+		out.FCHost = in.FCHost.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceSCSIHostSubCapability) Equal(b *NodeDeviceSCSIHostSubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.VPortOps.Equal(b.VPortOps) {
+		return false
+	}
+	if !a.FCHost.Equal(b.FCHost) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSIHostSubCapability) canonicalize() {
+	if in.VPortOps != nil {
+		in.VPortOps.canonicalize()
+	}
+	if in.FCHost != nil {
+		in.FCHost.canonicalize()
+	}
+}
+
+func (in *NodeDeviceSCSITargetCapability) DeepCopy() *NodeDeviceSCSITargetCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSITargetCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSITargetCapability) DeepCopyInto(out *NodeDeviceSCSITargetCapability) {
+	*out = *in
+	if in.Capability != nil {
+		out.Capability = make([]NodeDeviceSCSITargetSubCapability, len(in.Capability))
+		for i := range in.Capability {
+			in.Capability[i].DeepCopyInto(&out.Capability[i])
+		}
+	}
+}
+
+func (a *NodeDeviceSCSITargetCapability) Equal(b *NodeDeviceSCSITargetCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Target != b.Target {
+		return false
+	}
+	if len(a.Capability) != len(b.Capability) {
+		return false
+	}
+	for i := range a.Capability {
+		if !a.Capability[i].Equal(&b.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSITargetCapability) canonicalize() {
+	for i := range in.Capability {
+		in.Capability[i].canonicalize()
+	}
+	if len(in.Capability) == 0 {
+		in.Capability = nil
+	}
+}
+
+func (in *NodeDeviceSCSITargetSubCapability) DeepCopy() *NodeDeviceSCSITargetSubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSITargetSubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSITargetSubCapability) DeepCopyInto(out *NodeDeviceSCSITargetSubCapability) {
+	*out = *in
+	if in.FCRemotePort != nil {
+		out.FCRemotePort = in.FCRemotePort.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceSCSITargetSubCapability) Equal(b *NodeDeviceSCSITargetSubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.FCRemotePort.Equal(b.FCRemotePort) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSITargetSubCapability) canonicalize() {
+	if in.FCRemotePort != nil {
+		in.FCRemotePort.canonicalize()
+	}
+}
+
+func (in *NodeDeviceSCSIVPortOpsCapability) DeepCopy() *NodeDeviceSCSIVPortOpsCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSCSIVPortOpsCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSCSIVPortOpsCapability) DeepCopyInto(out *NodeDeviceSCSIVPortOpsCapability) {
+	*out = *in
+}
+
+func (a *NodeDeviceSCSIVPortOpsCapability) Equal(b *NodeDeviceSCSIVPortOpsCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.VPorts != b.VPorts {
+		return false
+	}
+	if a.MaxVPorts != b.MaxVPorts {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSCSIVPortOpsCapability) canonicalize() {
+}
+
+func (in *NodeDeviceStorageCapability) DeepCopy() *NodeDeviceStorageCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceStorageCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceStorageCapability) DeepCopyInto(out *NodeDeviceStorageCapability) {
+	*out = *in
+	if in.Size != nil {
+		v := *in.Size
+		out.Size = &v
+	}
+	if in.LogicalBlockSize != nil {
+		v := *in.LogicalBlockSize
+		out.LogicalBlockSize = &v
+	}
+	if in.NumBlocks != nil {
+		v := *in.NumBlocks
+		out.NumBlocks = &v
+	}
+	if in.Capability != nil {
+		out.Capability = make([]NodeDeviceStorageSubCapability, len(in.Capability))
+		for i := range in.Capability {
+			in.Capability[i].DeepCopyInto(&out.Capability[i])
+		}
+	}
+}
+
+func (a *NodeDeviceStorageCapability) Equal(b *NodeDeviceStorageCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Block != b.Block {
+		return false
+	}
+	if a.Bus != b.Bus {
+		return false
+	}
+	if a.DriverType != b.DriverType {
+		return false
+	}
+	if a.Model != b.Model {
+		return false
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if a.Serial != b.Serial {
+		return false
+	}
+	if (a.Size == nil) != (b.Size == nil) || (a.Size != nil && *a.Size != *b.Size) {
+		return false
+	}
+	if (a.LogicalBlockSize == nil) != (b.LogicalBlockSize == nil) || (a.LogicalBlockSize != nil && *a.LogicalBlockSize != *b.LogicalBlockSize) {
+		return false
+	}
+	if (a.NumBlocks == nil) != (b.NumBlocks == nil) || (a.NumBlocks != nil && *a.NumBlocks != *b.NumBlocks) {
+		return false
+	}
+	if len(a.Capability) != len(b.Capability) {
+		return false
+	}
+	for i := range a.Capability {
+		if !a.Capability[i].Equal(&b.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *NodeDeviceStorageCapability) canonicalize() {
+	for i := range in.Capability {
+		in.Capability[i].canonicalize()
+	}
+	if len(in.Capability) == 0 {
+		in.Capability = nil
+	}
+}
+
+func (in *NodeDeviceStorageRemovableCapability) DeepCopy() *NodeDeviceStorageRemovableCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceStorageRemovableCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceStorageRemovableCapability) DeepCopyInto(out *NodeDeviceStorageRemovableCapability) {
+	*out = *in
+	if in.MediaAvailable != nil {
+		v := *in.MediaAvailable
+		out.MediaAvailable = &v
+	}
+	if in.MediaSize != nil {
+		v := *in.MediaSize
+		out.MediaSize = &v
+	}
+	if in.LogicalBlockSize != nil {
+		v := *in.LogicalBlockSize
+		out.LogicalBlockSize = &v
+	}
+	if in.NumBlocks != nil {
+		v := *in.NumBlocks
+		out.NumBlocks = &v
+	}
+}
+
+func (a *NodeDeviceStorageRemovableCapability) Equal(b *NodeDeviceStorageRemovableCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.MediaAvailable == nil) != (b.MediaAvailable == nil) || (a.MediaAvailable != nil && *a.MediaAvailable != *b.MediaAvailable) {
+		return false
+	}
+	if (a.MediaSize == nil) != (b.MediaSize == nil) || (a.MediaSize != nil && *a.MediaSize != *b.MediaSize) {
+		return false
+	}
+	if a.MediaLabel != b.MediaLabel {
+		return false
+	}
+	if (a.LogicalBlockSize == nil) != (b.LogicalBlockSize == nil) || (a.LogicalBlockSize != nil && *a.LogicalBlockSize != *b.LogicalBlockSize) {
+		return false
+	}
+	if (a.NumBlocks == nil) != (b.NumBlocks == nil) || (a.NumBlocks != nil && *a.NumBlocks != *b.NumBlocks) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceStorageRemovableCapability) canonicalize() {
+}
+
+func (in *NodeDeviceStorageSubCapability) DeepCopy() *NodeDeviceStorageSubCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceStorageSubCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceStorageSubCapability) DeepCopyInto(out *NodeDeviceStorageSubCapability) {
+	*out = *in
+	if in.Removable != nil {
+		out.Removable = in.Removable.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceStorageSubCapability) Equal(b *NodeDeviceStorageSubCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.Removable.Equal(b.Removable) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceStorageSubCapability) canonicalize() {
+	if in.Removable != nil {
+		in.Removable.canonicalize()
+	}
+}
+
+func (in *NodeDeviceSystemCapability) DeepCopy() *NodeDeviceSystemCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSystemCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSystemCapability) DeepCopyInto(out *NodeDeviceSystemCapability) {
+	*out = *in
+	if in.Hardware != nil {
+		out.Hardware = in.Hardware.DeepCopy()
+	}
+	if in.Firmware != nil {
+		out.Firmware = in.Firmware.DeepCopy()
+	}
+}
+
+func (a *NodeDeviceSystemCapability) Equal(b *NodeDeviceSystemCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Product != b.Product {
+		return false
+	}
+	if !a.Hardware.Equal(b.Hardware) {
+		return false
+	}
+	if !a.Firmware.Equal(b.Firmware) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSystemCapability) canonicalize() {
+	if in.Hardware != nil {
+		in.Hardware.canonicalize()
+	}
+	if in.Firmware != nil {
+		in.Firmware.canonicalize()
+	}
+}
+
+func (in *NodeDeviceSystemFirmware) DeepCopy() *NodeDeviceSystemFirmware {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSystemFirmware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSystemFirmware) DeepCopyInto(out *NodeDeviceSystemFirmware) {
+	*out = *in
+}
+
+func (a *NodeDeviceSystemFirmware) Equal(b *NodeDeviceSystemFirmware) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if a.Version != b.Version {
+		return false
+	}
+	if a.ReleaseData != b.ReleaseData {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSystemFirmware) canonicalize() {
+}
+
+func (in *NodeDeviceSystemHardware) DeepCopy() *NodeDeviceSystemHardware {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceSystemHardware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceSystemHardware) DeepCopyInto(out *NodeDeviceSystemHardware) {
+	*out = *in
+}
+
+func (a *NodeDeviceSystemHardware) Equal(b *NodeDeviceSystemHardware) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Vendor != b.Vendor {
+		return false
+	}
+	if a.Version != b.Version {
+		return false
+	}
+	if a.Serial != b.Serial {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceSystemHardware) canonicalize() {
+}
+
+func (in *NodeDeviceUSBCapability) DeepCopy() *NodeDeviceUSBCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceUSBCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceUSBCapability) DeepCopyInto(out *NodeDeviceUSBCapability) {
+	*out = *in
+}
+
+func (a *NodeDeviceUSBCapability) Equal(b *NodeDeviceUSBCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Number != b.Number {
+		return false
+	}
+	if a.Class != b.Class {
+		return false
+	}
+	if a.Subclass != b.Subclass {
+		return false
+	}
+	if a.Protocol != b.Protocol {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceUSBCapability) canonicalize() {
+}
+
+func (in *NodeDeviceUSBDeviceCapability) DeepCopy() *NodeDeviceUSBDeviceCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDeviceUSBDeviceCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeDeviceUSBDeviceCapability) DeepCopyInto(out *NodeDeviceUSBDeviceCapability) {
+	*out = *in
+	in.Product.DeepCopyInto(&out.Product)
+	in.Vendor.DeepCopyInto(&out.Vendor)
+}
+
+func (a *NodeDeviceUSBDeviceCapability) Equal(b *NodeDeviceUSBDeviceCapability) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Bus != b.Bus {
+		return false
+	}
+	if a.Device != b.Device {
+		return false
+	}
+	if !a.Product.Equal(&b.Product) {
+		return false
+	}
+	if !a.Vendor.Equal(&b.Vendor) {
+		return false
+	}
+	return true
+}
+
+func (in *NodeDeviceUSBDeviceCapability) canonicalize() {
+	in.Product.canonicalize()
+	in.Vendor.canonicalize()
+}
+
+func (in *Secret) DeepCopy() *Secret {
+	if in == nil {
+		return nil
+	}
+	out := new(Secret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Secret) DeepCopyInto(out *Secret) {
+	*out = *in
+	if in.Usage != nil {
+		out.Usage = in.Usage.DeepCopy()
+	}
+}
+
+func (a *Secret) Equal(b *Secret) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Ephemeral != b.Ephemeral {
+		return false
+	}
+	if a.Private != b.Private {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if !a.Usage.Equal(b.Usage) {
+		return false
+	}
+	return true
+}
+
+func (in *Secret) canonicalize() {
+	if in.Usage != nil {
+		in.Usage.canonicalize()
+	}
+}
+
+func (d *Secret) Canonicalize() *Secret {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *Secret) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *Secret) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *Secret) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *Secret) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *Secret) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *Secret) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *Secret) schemaRef() (string, string) {
+	return "secret.rng", ""
+}
+
+func (d *Secret) Validate() error {
+	return validateDocument(d, "secret.rng", "")
+}
+
+func (in *SecretUsage) DeepCopy() *SecretUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *SecretUsage) DeepCopyInto(out *SecretUsage) {
+	*out = *in
+}
+
+func (a *SecretUsage) Equal(b *SecretUsage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Volume != b.Volume {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Target != b.Target {
+		return false
+	}
+	return true
+}
+
+func (in *SecretUsage) canonicalize() {
+}
+
+func (in *StorageEncryption) DeepCopy() *StorageEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageEncryption) DeepCopyInto(out *StorageEncryption) {
+	*out = *in
+	if in.Secret != nil {
+		out.Secret = in.Secret.DeepCopy()
+	}
+	if in.Cipher != nil {
+		out.Cipher = in.Cipher.DeepCopy()
+	}
+	if in.Ivgen != nil {
+		out.Ivgen = in.Ivgen.DeepCopy()
+	}
+}
+
+func (a *StorageEncryption) Equal(b *StorageEncryption) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Format != b.Format {
+		return false
+	}
+	if !a.Secret.Equal(b.Secret) {
+		return false
+	}
+	if !a.Cipher.Equal(b.Cipher) {
+		return false
+	}
+	if !a.Ivgen.Equal(b.Ivgen) {
+		return false
+	}
+	return true
+}
+
+func (in *StorageEncryption) canonicalize() {
+	if in.Secret != nil {
+		in.Secret.canonicalize()
+	}
+	if in.Cipher != nil {
+		in.Cipher.canonicalize()
+	}
+	if in.Ivgen != nil {
+		in.Ivgen.canonicalize()
+	}
+}
+
+func (in *StorageEncryptionCipher) DeepCopy() *StorageEncryptionCipher {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageEncryptionCipher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageEncryptionCipher) DeepCopyInto(out *StorageEncryptionCipher) {
+	*out = *in
+}
+
+func (a *StorageEncryptionCipher) Equal(b *StorageEncryptionCipher) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Size != b.Size {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Hash != b.Hash {
+		return false
+	}
+	return true
+}
+
+func (in *StorageEncryptionCipher) canonicalize() {
+}
+
+func (in *StorageEncryptionIvgen) DeepCopy() *StorageEncryptionIvgen {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageEncryptionIvgen)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageEncryptionIvgen) DeepCopyInto(out *StorageEncryptionIvgen) {
+	*out = *in
+}
+
+func (a *StorageEncryptionIvgen) Equal(b *StorageEncryptionIvgen) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Hash != b.Hash {
+		return false
+	}
+	return true
+}
+
+func (in *StorageEncryptionIvgen) canonicalize() {
+}
+
+func (in *StorageEncryptionSecret) DeepCopy() *StorageEncryptionSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageEncryptionSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageEncryptionSecret) DeepCopyInto(out *StorageEncryptionSecret) {
+	*out = *in
+}
+
+func (a *StorageEncryptionSecret) Equal(b *StorageEncryptionSecret) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	return true
+}
+
+func (in *StorageEncryptionSecret) canonicalize() {
+}
+
+func (in *StoragePool) DeepCopy() *StoragePool {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePool) DeepCopyInto(out *StoragePool) {
+	*out = *in
+	if in.Allocation != nil {
+		out.Allocation = in.Allocation.DeepCopy()
+	}
+	if in.Capacity != nil {
+		out.Capacity = in.Capacity.DeepCopy()
+	}
+	if in.Available != nil {
+		out.Available = in.Available.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.Source != nil {
+		out.Source = in.Source.DeepCopy()
+	}
+}
+
+func (a *StoragePool) Equal(b *StoragePool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	if !a.Allocation.Equal(b.Allocation) {
+		return false
+	}
+	if !a.Capacity.Equal(b.Capacity) {
+		return false
+	}
+	if !a.Available.Equal(b.Available) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.Source.Equal(b.Source) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePool) canonicalize() {
+	if in.Allocation != nil {
+		in.Allocation.canonicalize()
+	}
+	if in.Capacity != nil {
+		in.Capacity.canonicalize()
+	}
+	if in.Available != nil {
+		in.Available.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.Source != nil {
+		in.Source.canonicalize()
+	}
+}
+
+func (d *StoragePool) Canonicalize() *StoragePool {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *StoragePool) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *StoragePool) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *StoragePool) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *StoragePool) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *StoragePool) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *StoragePool) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *StoragePool) schemaRef() (string, string) {
+	return "storagepool.rng", ""
+}
+
+func (in *StoragePoolPCIAddress) DeepCopy() *StoragePoolPCIAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolPCIAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolPCIAddress) DeepCopyInto(out *StoragePoolPCIAddress) {
+	*out = *in
+	if in.Domain != nil {
+		v := *in.Domain
+		out.Domain = &v
+	}
+	if in.Bus != nil {
+		v := *in.Bus
+		out.Bus = &v
+	}
+	if in.Slot != nil {
+		v := *in.Slot
+		out.Slot = &v
+	}
+	if in.Function != nil {
+		v := *in.Function
+		out.Function = &v
+	}
+}
+
+func (a *StoragePoolPCIAddress) Equal(b *StoragePoolPCIAddress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Domain == nil) != (b.Domain == nil) || (a.Domain != nil && *a.Domain != *b.Domain) {
+		return false
+	}
+	if (a.Bus == nil) != (b.Bus == nil) || (a.Bus != nil && *a.Bus != *b.Bus) {
+		return false
+	}
+	if (a.Slot == nil) != (b.Slot == nil) || (a.Slot != nil && *a.Slot != *b.Slot) {
+		return false
+	}
+	if (a.Function == nil) != (b.Function == nil) || (a.Function != nil && *a.Function != *b.Function) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolPCIAddress) canonicalize() {
+}
+
+func (in *StoragePoolSize) DeepCopy() *StoragePoolSize {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSize) DeepCopyInto(out *StoragePoolSize) {
+	*out = *in
+}
+
+func (a *StoragePoolSize) Equal(b *StoragePoolSize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "B") {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Value = uint64(canonicalScaled(uint64(in.Value), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *StoragePoolSource) DeepCopy() *StoragePoolSource {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSource) DeepCopyInto(out *StoragePoolSource) {
+	*out = *in
+	if in.Dir != nil {
+		out.Dir = in.Dir.DeepCopy()
+	}
+	if in.Host != nil {
+		out.Host = make([]StoragePoolSourceHost, len(in.Host))
+		for i := range in.Host {
+			in.Host[i].DeepCopyInto(&out.Host[i])
+		}
+	}
+	if in.Device != nil {
+		out.Device = make([]StoragePoolSourceDevice, len(in.Device))
+		for i := range in.Device {
+			in.Device[i].DeepCopyInto(&out.Device[i])
+		}
+	}
+	if in.Auth != nil {
+		out.Auth = in.Auth.DeepCopy()
+	}
+	if in.Vendor != nil {
+		out.Vendor = in.Vendor.DeepCopy()
+	}
+	if in.Product != nil {
+		out.Product = in.Product.DeepCopy()
+	}
+	if in.Format != nil {
+		out.Format = in.Format.DeepCopy()
+	}
+	if in.Adapter != nil {
+		out.Adapter = in.Adapter.DeepCopy()
+	}
+	if in.Initiator != nil {
+		out.Initiator = in.Initiator.DeepCopy()
+	}
+}
+
+func (a *StoragePoolSource) Equal(b *StoragePoolSource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if !a.Dir.Equal(b.Dir) {
+		return false
+	}
+	if len(a.Host) != len(b.Host) {
+		return false
+	}
+	for i := range a.Host {
+		if !a.Host[i].Equal(&b.Host[i]) {
+			return false
+		}
+	}
+	if len(a.Device) != len(b.Device) {
+		return false
+	}
+	for i := range a.Device {
+		if !a.Device[i].Equal(&b.Device[i]) {
+			return false
+		}
+	}
+	if !a.Auth.Equal(b.Auth) {
+		return false
+	}
+	if !a.Vendor.Equal(b.Vendor) {
+		return false
+	}
+	if !a.Product.Equal(b.Product) {
+		return false
+	}
+	if !a.Format.Equal(b.Format) {
+		return false
+	}
+	if !a.Adapter.Equal(b.Adapter) {
+		return false
+	}
+	if !a.Initiator.Equal(b.Initiator) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSource) canonicalize() {
+	if in.Dir != nil {
+		in.Dir.canonicalize()
+	}
+	for i := range in.Host {
+		in.Host[i].canonicalize()
+	}
+	if len(in.Host) == 0 {
+		in.Host = nil
+	}
+	for i := range in.Device {
+		in.Device[i].canonicalize()
+	}
+	if len(in.Device) == 0 {
+		in.Device = nil
+	}
+	if in.Auth != nil {
+		in.Auth.canonicalize()
+	}
+	if in.Vendor != nil {
+		in.Vendor.canonicalize()
+	}
+	if in.Product != nil {
+		in.Product.canonicalize()
+	}
+	if in.Format != nil {
+		in.Format.canonicalize()
+	}
+	if in.Adapter != nil {
+		in.Adapter.canonicalize()
+	}
+	if in.Initiator != nil {
+		in.Initiator.canonicalize()
+	}
+}
+
+func (in *StoragePoolSourceAdapter) DeepCopy() *StoragePoolSourceAdapter {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceAdapter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceAdapter) DeepCopyInto(out *StoragePoolSourceAdapter) {
+	*out = *in
+	if in.ParentAddr != nil {
+		out.ParentAddr = in.ParentAddr.DeepCopy()
+	}
+}
+
+func (a *StoragePoolSourceAdapter) Equal(b *StoragePoolSourceAdapter) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Parent != b.Parent {
+		return false
+	}
+	if a.Managed != b.Managed {
+		return false
+	}
+	if a.WWNN != b.WWNN {
+		return false
+	}
+	if a.WWPN != b.WWPN {
+		return false
+	}
+	if !a.ParentAddr.Equal(b.ParentAddr) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceAdapter) canonicalize() {
+	if in.ParentAddr != nil {
+		in.ParentAddr.canonicalize()
+	}
+}
+
+func (in *StoragePoolSourceAdapterParentAddr) DeepCopy() *StoragePoolSourceAdapterParentAddr {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceAdapterParentAddr)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceAdapterParentAddr) DeepCopyInto(out *StoragePoolSourceAdapterParentAddr) {
+	*out = *in
+	if in.Address != nil {
+		out.Address = in.Address.DeepCopy()
+	}
+}
+
+func (a *StoragePoolSourceAdapterParentAddr) Equal(b *StoragePoolSourceAdapterParentAddr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.UniqueID != b.UniqueID {
+		return false
+	}
+	if !a.Address.Equal(b.Address) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceAdapterParentAddr) canonicalize() {
+	if in.Address != nil {
+		in.Address.canonicalize()
+	}
+}
+
+func (in *StoragePoolSourceAuth) DeepCopy() *StoragePoolSourceAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceAuth) DeepCopyInto(out *StoragePoolSourceAuth) {
+	*out = *in
+	if in.Secret != nil {
+		out.Secret = in.Secret.DeepCopy()
+	}
+}
+
+func (a *StoragePoolSourceAuth) Equal(b *StoragePoolSourceAuth) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Username != b.Username {
+		return false
+	}
+	if !a.Secret.Equal(b.Secret) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceAuth) canonicalize() {
+	if in.Secret != nil {
+		in.Secret.canonicalize()
+	}
+}
+
+func (in *StoragePoolSourceAuthSecret) DeepCopy() *StoragePoolSourceAuthSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceAuthSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceAuthSecret) DeepCopyInto(out *StoragePoolSourceAuthSecret) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceAuthSecret) Equal(b *StoragePoolSourceAuthSecret) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Usage != b.Usage {
+		return false
+	}
+	if a.UUID != b.UUID {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceAuthSecret) canonicalize() {
+}
+
+func (in *StoragePoolSourceDevice) DeepCopy() *StoragePoolSourceDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceDevice) DeepCopyInto(out *StoragePoolSourceDevice) {
+	*out = *in
+	if in.FreeExtents != nil {
+		out.FreeExtents = make([]StoragePoolSourceDeviceFreeExtent, len(in.FreeExtents))
+		for i := range in.FreeExtents {
+			in.FreeExtents[i].DeepCopyInto(&out.FreeExtents[i])
+		}
+	}
+}
+
+func (a *StoragePoolSourceDevice) Equal(b *StoragePoolSourceDevice) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if a.PartSeparator != b.PartSeparator {
+		return false
+	}
+	if len(a.FreeExtents) != len(b.FreeExtents) {
+		return false
+	}
+	for i := range a.FreeExtents {
+		if !a.FreeExtents[i].Equal(&b.FreeExtents[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (in *StoragePoolSourceDevice) canonicalize() {
+	for i := range in.FreeExtents {
+		in.FreeExtents[i].canonicalize()
+	}
+	if len(in.FreeExtents) == 0 {
+		in.FreeExtents = nil
+	}
+}
+
+func (in *StoragePoolSourceDeviceFreeExtent) DeepCopy() *StoragePoolSourceDeviceFreeExtent {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceDeviceFreeExtent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceDeviceFreeExtent) DeepCopyInto(out *StoragePoolSourceDeviceFreeExtent) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceDeviceFreeExtent) Equal(b *StoragePoolSourceDeviceFreeExtent) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Start != b.Start {
+		return false
+	}
+	if a.End != b.End {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceDeviceFreeExtent) canonicalize() {
+}
+
+func (in *StoragePoolSourceDir) DeepCopy() *StoragePoolSourceDir {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceDir)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceDir) DeepCopyInto(out *StoragePoolSourceDir) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceDir) Equal(b *StoragePoolSourceDir) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceDir) canonicalize() {
+}
+
+func (in *StoragePoolSourceFormat) DeepCopy() *StoragePoolSourceFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceFormat) DeepCopyInto(out *StoragePoolSourceFormat) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceFormat) Equal(b *StoragePoolSourceFormat) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceFormat) canonicalize() {
+}
+
+func (in *StoragePoolSourceHost) DeepCopy() *StoragePoolSourceHost {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceHost) DeepCopyInto(out *StoragePoolSourceHost) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceHost) Equal(b *StoragePoolSourceHost) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Port != b.Port {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceHost) canonicalize() {
+}
+
+func (in *StoragePoolSourceInitiator) DeepCopy() *StoragePoolSourceInitiator {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceInitiator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceInitiator) DeepCopyInto(out *StoragePoolSourceInitiator) {
+	*out = *in
+	in.IQN.DeepCopyInto(&out.IQN)
+}
+
+func (a *StoragePoolSourceInitiator) Equal(b *StoragePoolSourceInitiator) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !a.IQN.Equal(&b.IQN) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceInitiator) canonicalize() {
+	in.IQN.canonicalize()
+}
+
+func (in *StoragePoolSourceInitiatorIQN) DeepCopy() *StoragePoolSourceInitiatorIQN {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceInitiatorIQN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceInitiatorIQN) DeepCopyInto(out *StoragePoolSourceInitiatorIQN) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceInitiatorIQN) Equal(b *StoragePoolSourceInitiatorIQN) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceInitiatorIQN) canonicalize() {
+}
+
+func (in *StoragePoolSourceProduct) DeepCopy() *StoragePoolSourceProduct {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceProduct)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceProduct) DeepCopyInto(out *StoragePoolSourceProduct) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceProduct) Equal(b *StoragePoolSourceProduct) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceProduct) canonicalize() {
+}
+
+func (in *StoragePoolSourceVendor) DeepCopy() *StoragePoolSourceVendor {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolSourceVendor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolSourceVendor) DeepCopyInto(out *StoragePoolSourceVendor) {
+	*out = *in
+}
+
+func (a *StoragePoolSourceVendor) Equal(b *StoragePoolSourceVendor) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolSourceVendor) canonicalize() {
+}
+
+func (in *StoragePoolTarget) DeepCopy() *StoragePoolTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolTarget) DeepCopyInto(out *StoragePoolTarget) {
+	*out = *in
+	if in.Permissions != nil {
+		out.Permissions = in.Permissions.DeepCopy()
+	}
+	if in.Timestamps != nil {
+		out.Timestamps = in.Timestamps.DeepCopy()
+	}
+	if in.Encryption != nil {
+		out.Encryption = in.Encryption.DeepCopy()
+	}
+}
+
+func (a *StoragePoolTarget) Equal(b *StoragePoolTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if !a.Permissions.Equal(b.Permissions) {
+		return false
+	}
+	if !a.Timestamps.Equal(b.Timestamps) {
+		return false
+	}
+	if !a.Encryption.Equal(b.Encryption) {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolTarget) canonicalize() {
+	if in.Permissions != nil {
+		in.Permissions.canonicalize()
+	}
+	if in.Timestamps != nil {
+		in.Timestamps.canonicalize()
+	}
+	if in.Encryption != nil {
+		in.Encryption.canonicalize()
+	}
+}
+
+func (in *StoragePoolTargetPermissions) DeepCopy() *StoragePoolTargetPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolTargetPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolTargetPermissions) DeepCopyInto(out *StoragePoolTargetPermissions) {
+	*out = *in
+}
+
+func (a *StoragePoolTargetPermissions) Equal(b *StoragePoolTargetPermissions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Owner != b.Owner {
+		return false
+	}
+	if a.Group != b.Group {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Label != b.Label {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolTargetPermissions) canonicalize() {
+}
+
+func (in *StoragePoolTargetTimestamps) DeepCopy() *StoragePoolTargetTimestamps {
+	if in == nil {
+		return nil
+	}
+	out := new(StoragePoolTargetTimestamps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StoragePoolTargetTimestamps) DeepCopyInto(out *StoragePoolTargetTimestamps) {
+	*out = *in
+}
+
+func (a *StoragePoolTargetTimestamps) Equal(b *StoragePoolTargetTimestamps) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Atime != b.Atime {
+		return false
+	}
+	if a.Mtime != b.Mtime {
+		return false
+	}
+	if a.Ctime != b.Ctime {
+		return false
+	}
+	return true
+}
+
+func (in *StoragePoolTargetTimestamps) canonicalize() {
+}
+
+func (in *StorageVolume) DeepCopy() *StorageVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolume) DeepCopyInto(out *StorageVolume) {
+	*out = *in
+	if in.Allocation != nil {
+		out.Allocation = in.Allocation.DeepCopy()
+	}
+	if in.Capacity != nil {
+		out.Capacity = in.Capacity.DeepCopy()
+	}
+	if in.Physical != nil {
+		out.Physical = in.Physical.DeepCopy()
+	}
+	if in.Target != nil {
+		out.Target = in.Target.DeepCopy()
+	}
+	if in.BackingStore != nil {
+		out.BackingStore = in.BackingStore.DeepCopy()
+	}
+}
+
+func (a *StorageVolume) Equal(b *StorageVolume) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalStringDefault(a.Type, b.Type, "file") {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Key != b.Key {
+		return false
+	}
+	if !a.Allocation.Equal(b.Allocation) {
+		return false
+	}
+	if !a.Capacity.Equal(b.Capacity) {
+		return false
+	}
+	if !a.Physical.Equal(b.Physical) {
+		return false
+	}
+	if !a.Target.Equal(b.Target) {
+		return false
+	}
+	if !a.BackingStore.Equal(b.BackingStore) {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolume) canonicalize() {
+	if in.Type == "" {
+		in.Type = "file"
+	}
+	if in.Allocation != nil {
+		in.Allocation.canonicalize()
+	}
+	if in.Capacity != nil {
+		in.Capacity.canonicalize()
+	}
+	if in.Physical != nil {
+		in.Physical.canonicalize()
+	}
+	if in.Target != nil {
+		in.Target.canonicalize()
+	}
+	if in.BackingStore != nil {
+		in.BackingStore.canonicalize()
+	}
+}
+
+func (d *StorageVolume) Canonicalize() *StorageVolume {
+	c := d.DeepCopy()
+	c.canonicalize()
+	return c
+}
+
+func (d *StorageVolume) Fingerprint() (string, error) {
+	return fingerprint(d.Canonicalize())
+}
+
+func (d *StorageVolume) MarshalFor(version uint, policy string) (string, error) {
+	return marshalFor(d.DeepCopy(), version, policy)
+}
+
+func (d *StorageVolume) Decode(r io.Reader) error {
+	return decodeDocument(r, d)
+}
+
+func (d *StorageVolume) Encode(w io.Writer) error {
+	return encodeDocument(w, d)
+}
+
+func (d *StorageVolume) UnmarshalBytes(doc []byte) error {
+	return unmarshalBytes(doc, d)
+}
+
+func (d *StorageVolume) MarshalBytes() ([]byte, error) {
+	return marshalBytes(d)
+}
+
+func (d *StorageVolume) schemaRef() (string, string) {
+	return "storagevol.rng", ""
+}
+
+func (in *StorageVolumeBackingStore) DeepCopy() *StorageVolumeBackingStore {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeBackingStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeBackingStore) DeepCopyInto(out *StorageVolumeBackingStore) {
+	*out = *in
+	if in.Format != nil {
+		out.Format = in.Format.DeepCopy()
+	}
+	if in.Permissions != nil {
+		out.Permissions = in.Permissions.DeepCopy()
+	}
+}
+
+func (a *StorageVolumeBackingStore) Equal(b *StorageVolumeBackingStore) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if !a.Format.Equal(b.Format) {
+		return false
+	}
+	if !a.Permissions.Equal(b.Permissions) {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeBackingStore) canonicalize() {
+	if in.Format != nil {
+		in.Format.canonicalize()
+	}
+	if in.Permissions != nil {
+		in.Permissions.canonicalize()
+	}
+}
+
+func (in *StorageVolumeSize) DeepCopy() *StorageVolumeSize {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeSize) DeepCopyInto(out *StorageVolumeSize) {
+	*out = *in
+}
+
+func (a *StorageVolumeSize) Equal(b *StorageVolumeSize) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !equalScaled(uint64(a.Value), a.Unit, uint64(b.Value), b.Unit, "B") {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeSize) canonicalize() {
+	if scale, err := unitScale(in.Unit, "B"); err == nil {
+		in.Value = uint64(canonicalScaled(uint64(in.Value), scale, 1))
+		in.Unit = "bytes"
+	}
+}
+
+func (in *StorageVolumeTarget) DeepCopy() *StorageVolumeTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeTarget) DeepCopyInto(out *StorageVolumeTarget) {
+	*out = *in
+	if in.Format != nil {
+		out.Format = in.Format.DeepCopy()
+	}
+	if in.Permissions != nil {
+		out.Permissions = in.Permissions.DeepCopy()
+	}
+	if in.Timestamps != nil {
+		out.Timestamps = in.Timestamps.DeepCopy()
+	}
+	if in.NoCOW != nil {
+		out.NoCOW = &struct{}{}
+	}
+	if in.Features != nil {
+		out.Features = make([]StorageVolumeTargetFeature, len(in.Features))
+		for i := range in.Features {
+			in.Features[i].DeepCopyInto(&out.Features[i])
+		}
+	}
+	if in.Encryption != nil {
+		out.Encryption = in.Encryption.DeepCopy()
+	}
+}
+
+func (a *StorageVolumeTarget) Equal(b *StorageVolumeTarget) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Path != b.Path {
+		return false
+	}
+	if !a.Format.Equal(b.Format) {
+		return false
+	}
+	if !a.Permissions.Equal(b.Permissions) {
+		return false
+	}
+	if !a.Timestamps.Equal(b.Timestamps) {
+		return false
+	}
+	if a.Compat != b.Compat {
+		return false
+	}
+	if (a.NoCOW == nil) != (b.NoCOW == nil) {
+		return false
+	}
+	if len(a.Features) != len(b.Features) {
+		return false
+	}
+	for i := range a.Features {
+		if !a.Features[i].Equal(&b.Features[i]) {
+			return false
+		}
+	}
+	if !a.Encryption.Equal(b.Encryption) {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeTarget) canonicalize() {
+	if in.Format != nil {
+		in.Format.canonicalize()
+	}
+	if in.Permissions != nil {
+		in.Permissions.canonicalize()
+	}
+	if in.Timestamps != nil {
+		in.Timestamps.canonicalize()
+	}
+	for i := range in.Features {
+		in.Features[i].canonicalize()
+	}
+	if len(in.Features) == 0 {
+		in.Features = nil
+	}
+	if in.Encryption != nil {
+		in.Encryption.canonicalize()
+	}
+}
+
+func (in *StorageVolumeTargetFeature) DeepCopy() *StorageVolumeTargetFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeTargetFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeTargetFeature) DeepCopyInto(out *StorageVolumeTargetFeature) {
+	*out = *in
+	if in.LazyRefcounts != nil {
+		out.LazyRefcounts = &struct{}{}
+	}
+}
+
+func (a *StorageVolumeTargetFeature) Equal(b *StorageVolumeTargetFeature) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.LazyRefcounts == nil) != (b.LazyRefcounts == nil) {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeTargetFeature) canonicalize() {
+}
+
+func (in *StorageVolumeTargetFeature) empty() bool {
+	return in.LazyRefcounts == nil
+}
+
+func (in *StorageVolumeTargetFormat) DeepCopy() *StorageVolumeTargetFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeTargetFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeTargetFormat) DeepCopyInto(out *StorageVolumeTargetFormat) {
+	*out = *in
+}
+
+func (a *StorageVolumeTargetFormat) Equal(b *StorageVolumeTargetFormat) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeTargetFormat) canonicalize() {
+}
+
+func (in *StorageVolumeTargetPermissions) DeepCopy() *StorageVolumeTargetPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeTargetPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeTargetPermissions) DeepCopyInto(out *StorageVolumeTargetPermissions) {
+	*out = *in
+}
+
+func (a *StorageVolumeTargetPermissions) Equal(b *StorageVolumeTargetPermissions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Owner != b.Owner {
+		return false
+	}
+	if a.Group != b.Group {
+		return false
+	}
+	if a.Mode != b.Mode {
+		return false
+	}
+	if a.Label != b.Label {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeTargetPermissions) canonicalize() {
+}
+
+func (in *StorageVolumeTargetTimestamps) DeepCopy() *StorageVolumeTargetTimestamps {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageVolumeTargetTimestamps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StorageVolumeTargetTimestamps) DeepCopyInto(out *StorageVolumeTargetTimestamps) {
+	*out = *in
+}
+
+func (a *StorageVolumeTargetTimestamps) Equal(b *StorageVolumeTargetTimestamps) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Atime != b.Atime {
+		return false
+	}
+	if a.Mtime != b.Mtime {
+		return false
+	}
+	if a.Ctime != b.Ctime {
+		return false
+	}
+	return true
+}
+
+func (in *StorageVolumeTargetTimestamps) canonicalize() {
+}
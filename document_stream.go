@@ -0,0 +1,76 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// documentCharsetReader accepts the encodings which are subsets of
+// UTF-8, which the decoder otherwise refuses when declared
+func documentCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "us-ascii", "ascii", "utf8":
+		return input, nil
+	}
+	return nil, fmt.Errorf("Unsupported document encoding '%s'", charset)
+}
+
+// newDocumentDecoder returns the decoder shared by all the ways of
+// parsing a document
+func newDocumentDecoder(r io.Reader) *xml.Decoder {
+	d := xml.NewDecoder(r)
+	d.CharsetReader = documentCharsetReader
+	return d
+}
+
+func decodeDocument(r io.Reader, doc interface{}) error {
+	return newDocumentDecoder(r).Decode(doc)
+}
+
+// encodeDocument writes the document formatted exactly like the
+// Marshal methods do
+func encodeDocument(w io.Writer, doc interface{}) error {
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	return e.Encode(doc)
+}
+
+func unmarshalBytes(data []byte, doc interface{}) error {
+	return decodeDocument(bytes.NewReader(data), doc)
+}
+
+func marshalBytes(doc interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	err := encodeDocument(&buf, doc)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
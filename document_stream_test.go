@@ -0,0 +1,219 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type streamDocument interface {
+	Document
+	Decode(r io.Reader) error
+	Encode(w io.Writer) error
+	UnmarshalBytes(doc []byte) error
+	MarshalBytes() ([]byte, error)
+}
+
+func TestDocumentStream(t *testing.T) {
+	for _, test := range domainTestData {
+		obj, ok := test.Object.(streamDocument)
+		if !ok {
+			t.Fatalf("%T has no streaming methods", test.Object)
+		}
+		expect := strings.Join(test.Expected, "\n")
+
+		var buf bytes.Buffer
+		err := obj.Encode(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != expect {
+			t.Fatal("Bad xml:\n", buf.String(), "\n does not match\n", expect, "\n")
+		}
+
+		data, err := obj.MarshalBytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expect {
+			t.Fatal("Bad xml:\n", string(data), "\n does not match\n", expect, "\n")
+		}
+
+		decoded := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(streamDocument)
+		err = decoded.Decode(strings.NewReader(expect))
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc, err := decoded.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc != expect {
+			t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+		}
+
+		decoded = reflect.New(reflect.TypeOf(obj).Elem()).Interface().(streamDocument)
+		err = decoded.UnmarshalBytes([]byte(expect))
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc, err = decoded.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc != expect {
+			t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestDocumentStreamEncoding(t *testing.T) {
+	secret := &Secret{}
+	err := secret.UnmarshalBytes([]byte(`<?xml version="1.0" encoding="US-ASCII"?><secret><description>Demo</description></secret>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Description != "Demo" {
+		t.Fatalf("Expected description 'Demo', got '%s'", secret.Description)
+	}
+
+	err = secret.UnmarshalBytes([]byte(`<?xml version="1.0" encoding="ISO-8859-1"?><secret></secret>`))
+	if err == nil {
+		t.Fatal("Expected error for unsupported encoding")
+	}
+}
+
+func benchmarkCaps() *Caps {
+	caps := &Caps{
+		Host: CapsHost{
+			UUID: "cd6c0fe3-8ef8-4f6b-9e0b-6e8b62a6c1fb",
+			NUMA: &CapsHostNUMATopology{
+				Cells: &CapsHostNUMACells{},
+			},
+		},
+	}
+	for i := 0; i < 64; i++ {
+		cell := CapsHostNUMACell{
+			ID: i,
+			Memory: &CapsHostNUMAMemory{
+				Size: 263921024,
+				Unit: "KiB",
+			},
+			Distances: &CapsHostNUMADistances{},
+			CPUS:      &CapsHostNUMACPUs{},
+		}
+		for j := 0; j < 64; j++ {
+			cell.Distances.Siblings = append(cell.Distances.Siblings, CapsHostNUMASibling{
+				ID:    j,
+				Value: 10 + (i+j)%22,
+			})
+			socket := i
+			core := j / 2
+			cell.CPUS.CPUs = append(cell.CPUS.CPUs, CapsHostNUMACPU{
+				ID:       i*64 + j,
+				SocketID: &socket,
+				CoreID:   &core,
+				Siblings: "0-1",
+			})
+		}
+		cell.CPUS.Num = uint(len(cell.CPUS.CPUs))
+		caps.Host.NUMA.Cells.Cells = append(caps.Host.NUMA.Cells.Cells, cell)
+	}
+	caps.Host.NUMA.Cells.Num = uint(len(caps.Host.NUMA.Cells.Cells))
+	return caps
+}
+
+func BenchmarkDocumentUnmarshalString(b *testing.B) {
+	doc, err := benchmarkCaps().Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.SetBytes(int64(len(doc)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		caps := &Caps{}
+		err = caps.Unmarshal(doc)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDocumentDecode(b *testing.B) {
+	data, err := benchmarkCaps().MarshalBytes()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		caps := &Caps{}
+		err = caps.Decode(bytes.NewReader(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDocumentMarshalString(b *testing.B) {
+	caps := benchmarkCaps()
+	doc, err := caps.Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.SetBytes(int64(len(doc)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := caps.Marshal()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDocumentEncode(b *testing.B) {
+	caps := benchmarkCaps()
+	data, err := caps.MarshalBytes()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := caps.Encode(ioutil.Discard)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	VersionPolicyStrip  = "strip"
+	VersionPolicyReject = "reject"
+)
+
+// documentVersions maps struct fields to the libvirt version which
+// introduced them. Versions use the encoding of
+// virConnectGetLibVersion, major * 1000000 + minor * 1000 + micro.
+var documentVersions = map[string]uint{
+	"Domain.GenID":                        4004000,
+	"Domain.IOThreadIDs":                  1002015,
+	"Domain.KeyWrap":                      1002016,
+	"Domain.LaunchSecurity":               4004000,
+	"Domain.Perf":                         1003003,
+	"DomainCPU.Cache":                     3003000,
+	"DomainCPUTune.CacheTune":             4001000,
+	"DomainCPUTune.GlobalPeriod":          1003003,
+	"DomainCPUTune.GlobalQuota":           1003003,
+	"DomainCPUTune.IOThreadPeriod":        2002000,
+	"DomainCPUTune.IOThreadPin":           1002014,
+	"DomainCPUTune.IOThreadQuota":         2002000,
+	"DomainChannel.Log":                   1003003,
+	"DomainConsole.Log":                   1003003,
+	"DomainDeviceList.IOMMU":              2001000,
+	"DomainDeviceList.Memorydevs":         1002014,
+	"DomainDeviceList.Panics":             1002001,
+	"DomainDeviceList.RNGs":               1000003,
+	"DomainDeviceList.Shmems":             1002010,
+	"DomainDeviceList.TPMs":               1000005,
+	"DomainDeviceList.VSock":              4004000,
+	"DomainDiskSource.Reservations":       4004000,
+	"DomainFeatureList.GIC":               1002016,
+	"DomainFeatureList.HPT":               3010000,
+	"DomainFeatureList.HTM":               4006000,
+	"DomainFeatureList.IOAPIC":            3004000,
+	"DomainFeatureList.PMU":               1002012,
+	"DomainFeatureList.SMM":               2001000,
+	"DomainFeatureList.VMCoreInfo":        4004000,
+	"DomainFeatureList.VMPort":            1002016,
+	"DomainInterface.Coalesce":            3003000,
+	"DomainInterface.MTU":                 3001000,
+	"DomainInterface.TrustGuestRXFilters": 1002010,
+	"DomainParallel.Log":                  1003003,
+	"DomainSerial.Log":                    1003003,
+	"DomainSysInfo.Chassis":               4001000,
+	"DomainSysInfo.OEMStrings":            3010000,
+	"Network.MTU":                         3001000,
+}
+
+func versionString(version uint) string {
+	return fmt.Sprintf("%d.%d.%d", version/1000000, version/1000%1000, version%1000)
+}
+
+// isZero reports whether val holds the zero value of its type
+func isZero(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface, reflect.Chan, reflect.Func:
+		return val.IsNil()
+	case reflect.Bool:
+		return !val.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return val.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return val.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return val.Float() == 0
+	case reflect.String:
+		return val.Len() == 0
+	case reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			if !isZero(val.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < val.NumField(); i++ {
+			if !isZero(val.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+type versionWalker struct {
+	target   uint
+	strip    bool
+	required uint
+	unknown  []string
+}
+
+func (w *versionWalker) walk(val reflect.Value) {
+	switch val.Kind() {
+	case reflect.Ptr:
+		if !val.IsNil() {
+			w.walk(val.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			w.walk(val.Index(i))
+		}
+	case reflect.Struct:
+		typ := val.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			fval := val.Field(i)
+			if field.PkgPath != "" || isZero(fval) {
+				continue
+			}
+			name := typ.Name() + "." + field.Name
+			if version, ok := documentVersions[name]; ok {
+				if version > w.required {
+					w.required = version
+				}
+				if w.target != 0 && version > w.target {
+					if w.strip {
+						fval.Set(reflect.Zero(field.Type))
+						continue
+					}
+					w.unknown = append(w.unknown,
+						fmt.Sprintf("%s requires libvirt %s", name, versionString(version)))
+				}
+			}
+			w.walk(fval)
+		}
+	}
+}
+
+// RequiredVersion returns the oldest libvirt version which knows all
+// the elements and attributes used by doc, or 0 if any version does
+func RequiredVersion(doc Document) uint {
+	w := &versionWalker{}
+	w.walk(reflect.ValueOf(doc))
+	return w.required
+}
+
+// marshalFor formats doc for the libvirt version given, modifying it
+// when stripping what that version does not know
+func marshalFor(doc Document, version uint, policy string) (string, error) {
+	w := &versionWalker{
+		target: version,
+	}
+	switch policy {
+	case VersionPolicyStrip:
+		w.strip = true
+	case VersionPolicyReject:
+	default:
+		return "", fmt.Errorf("Unknown version policy '%s'", policy)
+	}
+	w.walk(reflect.ValueOf(doc))
+	if len(w.unknown) != 0 {
+		return "", fmt.Errorf("Document is not supported by libvirt %s: %s",
+			versionString(version), strings.Join(w.unknown, ", "))
+	}
+	return doc.Marshal()
+}
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var documentVersionXML = []string{
+	`<domain type="kvm">`,
+	`  <name>demo</name>`,
+	`  <devices>`,
+	`    <interface type="network">`,
+	`      <source network="default"></source>`,
+	`      <mtu size="9000"></mtu>`,
+	`      <coalesce>`,
+	`        <rx>`,
+	`          <frames max="7"></frames>`,
+	`        </rx>`,
+	`      </coalesce>`,
+	`    </interface>`,
+	`    <vsock model="virtio">`,
+	`      <cid auto="yes"></cid>`,
+	`    </vsock>`,
+	`  </devices>`,
+	`</domain>`,
+}
+
+var documentVersionStrippedXML = []string{
+	`<domain type="kvm">`,
+	`  <name>demo</name>`,
+	`  <devices>`,
+	`    <interface type="network">`,
+	`      <source network="default"></source>`,
+	`      <mtu size="9000"></mtu>`,
+	`    </interface>`,
+	`  </devices>`,
+	`</domain>`,
+}
+
+func TestDocumentMarshalFor(t *testing.T) {
+	dom := &Domain{}
+	expect := strings.Join(documentVersionXML, "\n")
+	err := dom.Unmarshal(expect)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version := RequiredVersion(dom); version != 4004000 {
+		t.Fatalf("Expected required version 4004000, got %d", version)
+	}
+
+	doc, err := dom.MarshalFor(4004000, VersionPolicyReject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != expect {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+
+	doc, err = dom.MarshalFor(3001000, VersionPolicyStrip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stripped := strings.Join(documentVersionStrippedXML, "\n")
+	if doc != stripped {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", stripped, "\n")
+	}
+	if dom.Devices.VSock == nil {
+		t.Fatal("MarshalFor modified the domain")
+	}
+
+	_, err = dom.MarshalFor(3001000, VersionPolicyReject)
+	if err == nil {
+		t.Fatal("Expected error for elements unknown to libvirt 3.1.0")
+	}
+	for _, name := range []string{"DomainDeviceList.VSock", "DomainInterface.Coalesce"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Fatalf("Expected %s in error '%s'", name, err)
+		}
+	}
+	if strings.Contains(err.Error(), "DomainInterface.MTU") {
+		t.Fatalf("Unexpected DomainInterface.MTU in error '%s'", err)
+	}
+
+	_, err = dom.MarshalFor(3001000, "ignore")
+	if err == nil {
+		t.Fatal("Expected error for unknown policy")
+	}
+
+	if version := RequiredVersion(&Domain{Name: "demo"}); version != 0 {
+		t.Fatalf("Expected no required version, got %d", version)
+	}
+}
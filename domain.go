@@ -933,7 +933,8 @@ type DomainRedirDev struct {
 }
 
 type DomainRedirFilter struct {
-	USB []DomainRedirFilterUSB `xml:"usbdev"`
+	XMLName xml.Name               `xml:"redirfilter"`
+	USB     []DomainRedirFilterUSB `xml:"usbdev"`
 }
 
 type DomainRedirFilterUSB struct {
@@ -1399,14 +1400,16 @@ type DomainWatchdog struct {
 }
 
 type DomainHub struct {
+	XMLName xml.Name       `xml:"hub"`
 	Type    string         `xml:"type,attr"`
 	Alias   *DomainAlias   `xml:"alias"`
 	Address *DomainAddress `xml:"address"`
 }
 
 type DomainIOMMU struct {
-	Model  string             `xml:"model,attr"`
-	Driver *DomainIOMMUDriver `xml:"driver"`
+	XMLName xml.Name           `xml:"iommu"`
+	Model   string             `xml:"model,attr"`
+	Driver  *DomainIOMMUDriver `xml:"driver"`
 }
 
 type DomainIOMMUDriver struct {
@@ -1417,11 +1420,13 @@ type DomainIOMMUDriver struct {
 }
 
 type DomainNVRAM struct {
+	XMLName xml.Name       `xml:"nvram"`
 	Alias   *DomainAlias   `xml:"alias"`
 	Address *DomainAddress `xml:"address"`
 }
 
 type DomainLease struct {
+	XMLName   xml.Name           `xml:"lease"`
 	Lockspace string             `xml:"lockspace"`
 	Key       string             `xml:"key"`
 	Target    *DomainLeaseTarget `xml:"target"`
@@ -4471,6 +4476,78 @@ func (d *DomainWatchdog) Marshal() (string, error) {
 	return string(doc), nil
 }
 
+func (d *DomainLease) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), d)
+}
+
+func (d *DomainLease) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func (d *DomainRedirFilter) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), d)
+}
+
+func (d *DomainRedirFilter) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func (d *DomainHub) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), d)
+}
+
+func (d *DomainHub) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func (d *DomainPanic) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), d)
+}
+
+func (d *DomainPanic) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func (d *DomainNVRAM) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), d)
+}
+
+func (d *DomainNVRAM) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func (d *DomainIOMMU) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), d)
+}
+
+func (d *DomainIOMMU) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
 func marshalUintAttr(start *xml.StartElement, name string, val *uint, format string) {
 	if val != nil {
 		start.Attr = append(start.Attr, xml.Attr{
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"sync"
+)
+
+// DomainParseResult is the outcome of parsing one of the documents
+// passed to ParseDomains. Index is the position of the document in
+// the input, and Domain is nil when Err is set.
+type DomainParseResult struct {
+	Index  int
+	Domain *Domain
+	Err    error
+}
+
+type domainParseJob struct {
+	index int
+	data  []byte
+}
+
+// ParseDomains parses the domain documents read from docs using a
+// pool of workers, defaulting to GOMAXPROCS of them when workers is
+// not positive. Results are delivered in input order, with at most
+// twice as many documents in flight as there are workers, so one slow
+// document holds back the input rather than buffering without bound.
+// The returned channel is closed once docs is closed and every result
+// has been delivered, or as soon as ctx is cancelled.
+func ParseDomains(ctx context.Context, docs <-chan []byte, workers int) <-chan DomainParseResult {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	jobs := make(chan domainParseJob)
+	parsed := make(chan DomainParseResult, workers)
+	results := make(chan DomainParseResult, workers)
+	window := make(chan struct{}, workers*2)
+
+	go func() {
+		defer close(jobs)
+		for index := 0; ; index++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			var data []byte
+			var ok bool
+			select {
+			case data, ok = <-docs:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- domainParseJob{index, data}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			// Each worker decodes from a single reader, which
+			// being an io.ByteReader also spares the decoder
+			// from allocating a buffered reader per document
+			r := bytes.NewReader(nil)
+			for job := range jobs {
+				r.Reset(job.data)
+				dom := &Domain{}
+				err := newDocumentDecoder(r).Decode(dom)
+				if err != nil {
+					dom = nil
+				}
+				select {
+				case parsed <- DomainParseResult{job.index, dom, err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(parsed)
+	}()
+
+	go func() {
+		defer close(results)
+		pending := make(map[int]DomainParseResult)
+		next := 0
+		for res := range parsed {
+			pending[res.Index] = res
+			for {
+				res, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+				<-window
+				next++
+			}
+		}
+	}()
+
+	return results
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func bulkDomainXML(i int) []byte {
+	return []byte(fmt.Sprintf(`<domain type="kvm">
+  <name>demo%d</name>
+  <memory unit="KiB">1048576</memory>
+  <vcpu>2</vcpu>
+  <os>
+    <type arch="x86_64" machine="pc">hvm</type>
+  </os>
+  <devices>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="qcow2"></driver>
+      <source file="/var/lib/libvirt/images/demo%d.qcow2"></source>
+      <target dev="vda" bus="virtio"></target>
+    </disk>
+    <interface type="network">
+      <mac address="52:54:00:00:%02x:%02x"></mac>
+      <source network="default"></source>
+      <model type="virtio"></model>
+    </interface>
+  </devices>
+</domain>`, i, i, (i/256)%256, i%256))
+}
+
+func TestParseDomains(t *testing.T) {
+	docs := make(chan []byte)
+	go func() {
+		for i := 0; i < 100; i++ {
+			if i%10 == 3 {
+				docs <- []byte("<domain><name>broken</domain>")
+			} else {
+				docs <- bulkDomainXML(i)
+			}
+		}
+		close(docs)
+	}()
+
+	n := 0
+	for res := range ParseDomains(context.Background(), docs, 4) {
+		if res.Index != n {
+			t.Fatalf("Expected result %d, got %d", n, res.Index)
+		}
+		if n%10 == 3 {
+			if res.Err == nil || res.Domain != nil {
+				t.Fatalf("Expected error for document %d", n)
+			}
+		} else {
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			if res.Domain.Name != fmt.Sprintf("demo%d", n) {
+				t.Fatalf("Expected domain demo%d, got %s", n, res.Domain.Name)
+			}
+		}
+		n++
+	}
+	if n != 100 {
+		t.Fatalf("Expected 100 results, got %d", n)
+	}
+}
+
+func TestParseDomainsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	docs := make(chan []byte)
+	results := ParseDomains(ctx, docs, 2)
+
+	docs <- bulkDomainXML(0)
+	res := <-results
+	if res.Err != nil || res.Domain.Name != "demo0" {
+		t.Fatalf("Unexpected result %v", res)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-results:
+		for ok {
+			_, ok = <-results
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Results were not closed after cancellation")
+	}
+}
+
+func bulkDomainDocs(n int) [][]byte {
+	docs := make([][]byte, n)
+	for i := range docs {
+		docs[i] = bulkDomainXML(i)
+	}
+	return docs
+}
+
+func BenchmarkParseDomainsSequential(b *testing.B) {
+	docs := bulkDomainDocs(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, doc := range docs {
+			dom := &Domain{}
+			err := dom.Unmarshal(string(doc))
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkParseDomainsPool(b *testing.B) {
+	docs := bulkDomainDocs(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input := make(chan []byte)
+		go func() {
+			for _, doc := range docs {
+				input <- doc
+			}
+			close(input)
+		}()
+		for res := range ParseDomains(context.Background(), input, 0) {
+			if res.Err != nil {
+				b.Fatal(res.Err)
+			}
+		}
+	}
+}
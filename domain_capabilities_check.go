@@ -0,0 +1,321 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+)
+
+// DomainCapsViolation describes a single element of a domain which
+// is not supported according to the domain capabilities.
+type DomainCapsViolation struct {
+	// Location of the element, in a simplified XPath syntax
+	Path    string
+	Value   string
+	Message string
+}
+
+func (v DomainCapsViolation) String() string {
+	if v.Value == "" {
+		return fmt.Sprintf("%s: %s", v.Path, v.Message)
+	}
+	return fmt.Sprintf("%s='%s': %s", v.Path, v.Value, v.Message)
+}
+
+type domainCapsChecker struct {
+	violations []DomainCapsViolation
+}
+
+func (c *domainCapsChecker) report(path, value, format string, args ...interface{}) {
+	c.violations = append(c.violations, DomainCapsViolation{
+		Path:    path,
+		Value:   value,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func domainCapsEnumAllows(enums []DomainCapsEnum, name, value string) bool {
+	for _, enum := range enums {
+		if enum.Name != name {
+			continue
+		}
+		for _, val := range enum.Values {
+			if val == value {
+				return true
+			}
+		}
+		return false
+	}
+	// An enum which is not reported at all tells us nothing
+	return true
+}
+
+func (c *domainCapsChecker) device(dev *DomainCapsDevice, kind, path string) bool {
+	if dev == nil {
+		return true
+	}
+	if dev.Supported == "no" {
+		c.report(path, "", "%s devices are not supported", kind)
+		return false
+	}
+	return true
+}
+
+func (c *domainCapsChecker) enum(enums []DomainCapsEnum, name, path, value string) {
+	if value == "" {
+		return
+	}
+	if !domainCapsEnumAllows(enums, name, value) {
+		c.report(path, value, "%s is not supported", name)
+	}
+}
+
+func (c *domainCapsChecker) os(os *DomainOS, caps *DomainCapsOS) {
+	if os == nil || os.Loader == nil {
+		return
+	}
+	if caps.Supported == "no" || (caps.Loader != nil && caps.Loader.Supported == "no") {
+		c.report("os/loader", "", "loader is not supported")
+		return
+	}
+	if caps.Loader == nil {
+		return
+	}
+	c.enum(caps.Loader.Enums, "type", "os/loader/@type", os.Loader.Type)
+	c.enum(caps.Loader.Enums, "readonly", "os/loader/@readonly", os.Loader.Readonly)
+}
+
+func (c *domainCapsChecker) cpu(cpu *DomainCPU, caps *DomainCapsCPU) {
+	if cpu == nil || caps == nil {
+		return
+	}
+	mode := cpu.Mode
+	if mode == "" {
+		if cpu.Model == nil || cpu.Model.Value == "" {
+			return
+		}
+		mode = "custom"
+	}
+
+	var capsMode *DomainCapsCPUMode
+	for i := range caps.Modes {
+		if caps.Modes[i].Name == mode {
+			capsMode = &caps.Modes[i]
+			break
+		}
+	}
+	if capsMode == nil {
+		return
+	}
+	if capsMode.Supported != "yes" {
+		c.report("cpu/@mode", mode, "CPU mode is not supported")
+		return
+	}
+
+	if mode != "custom" || cpu.Model == nil || cpu.Model.Value == "" {
+		return
+	}
+	for _, model := range capsMode.Models {
+		if model.Name != cpu.Model.Value {
+			continue
+		}
+		if model.Usable == "no" {
+			c.report("cpu/model", model.Name, "CPU model is not usable on this host")
+		}
+		return
+	}
+	c.report("cpu/model", cpu.Model.Value, "CPU model is not supported")
+}
+
+func (c *domainCapsChecker) devices(devs *DomainDeviceList, caps *DomainCapsDevices) {
+	if devs == nil || caps == nil {
+		return
+	}
+
+	for i, disk := range devs.Disks {
+		path := fmt.Sprintf("devices/disk[%d]", i)
+		if !c.device(caps.Disk, "disk", path) {
+			continue
+		}
+		if caps.Disk == nil {
+			break
+		}
+		c.enum(caps.Disk.Enums, "diskDevice", path+"/@device", disk.Device)
+		if disk.Target != nil {
+			c.enum(caps.Disk.Enums, "bus", path+"/target/@bus", disk.Target.Bus)
+		}
+	}
+
+	for i, graphic := range devs.Graphics {
+		path := fmt.Sprintf("devices/graphics[%d]", i)
+		if !c.device(caps.Graphics, "graphics", path) {
+			continue
+		}
+		if caps.Graphics == nil {
+			break
+		}
+		typ := ""
+		if graphic.SDL != nil {
+			typ = "sdl"
+		} else if graphic.VNC != nil {
+			typ = "vnc"
+		} else if graphic.RDP != nil {
+			typ = "rdp"
+		} else if graphic.Desktop != nil {
+			typ = "desktop"
+		} else if graphic.Spice != nil {
+			typ = "spice"
+		} else if graphic.EGLHeadless != nil {
+			typ = "egl-headless"
+		}
+		c.enum(caps.Graphics.Enums, "type", path+"/@type", typ)
+	}
+
+	for i, video := range devs.Videos {
+		path := fmt.Sprintf("devices/video[%d]", i)
+		if !c.device(caps.Video, "video", path) {
+			continue
+		}
+		if caps.Video == nil {
+			break
+		}
+		c.enum(caps.Video.Enums, "modelType", path+"/model/@type", video.Model.Type)
+	}
+
+	for i, hostdev := range devs.Hostdevs {
+		path := fmt.Sprintf("devices/hostdev[%d]", i)
+		if !c.device(caps.HostDev, "hostdev", path) {
+			continue
+		}
+		if caps.HostDev == nil {
+			break
+		}
+		mode := "subsystem"
+		typ := ""
+		if hostdev.SubsysUSB != nil {
+			typ = "usb"
+		} else if hostdev.SubsysSCSI != nil {
+			typ = "scsi"
+		} else if hostdev.SubsysSCSIHost != nil {
+			typ = "scsi_host"
+		} else if hostdev.SubsysPCI != nil {
+			typ = "pci"
+		} else if hostdev.SubsysMDev != nil {
+			typ = "mdev"
+		} else {
+			mode = "capabilities"
+			if hostdev.CapsStorage != nil {
+				typ = "storage"
+			} else if hostdev.CapsMisc != nil {
+				typ = "misc"
+			} else if hostdev.CapsNet != nil {
+				typ = "net"
+			}
+		}
+		c.enum(caps.HostDev.Enums, "mode", path+"/@mode", mode)
+		if mode == "subsystem" {
+			c.enum(caps.HostDev.Enums, "subsysType", path+"/@type", typ)
+		} else {
+			c.enum(caps.HostDev.Enums, "capsType", path+"/@type", typ)
+		}
+		if hostdev.SubsysPCI != nil && hostdev.SubsysPCI.Driver != nil {
+			c.enum(caps.HostDev.Enums, "pciBackend", path+"/driver/@name", hostdev.SubsysPCI.Driver.Name)
+		}
+	}
+}
+
+func (c *domainCapsChecker) features(dom *Domain, caps *DomainCapsFeatures) {
+	if caps == nil {
+		caps = &DomainCapsFeatures{}
+	}
+
+	if dom.Features != nil && dom.Features.GIC != nil {
+		if caps.GIC == nil || caps.GIC.Supported != "yes" {
+			c.report("features/gic", "", "GIC is not supported")
+		} else {
+			c.enum(caps.GIC.Enums, "version", "features/gic/@version", dom.Features.GIC.Version)
+		}
+	}
+
+	if dom.Features != nil && dom.Features.VMCoreInfo != nil &&
+		dom.Features.VMCoreInfo.State != "off" {
+		if caps.VMCoreInfo == nil || caps.VMCoreInfo.Supported != "yes" {
+			c.report("features/vmcoreinfo", "", "vmcoreinfo is not supported")
+		}
+	}
+
+	if dom.GenID != nil {
+		if caps.GenID == nil || caps.GenID.Supported != "yes" {
+			c.report("genid", "", "VM generation ID is not supported")
+		}
+	}
+
+	if dom.LaunchSecurity != nil && dom.LaunchSecurity.SEV != nil {
+		sev := dom.LaunchSecurity.SEV
+		if caps.SEV == nil || caps.SEV.Supported != "yes" {
+			c.report("launchSecurity", "sev", "SEV is not supported")
+			return
+		}
+		if sev.CBitPos != nil && *sev.CBitPos != caps.SEV.CBitPos {
+			c.report("launchSecurity/cbitpos", fmt.Sprintf("%d", *sev.CBitPos),
+				"host C-bit position is %d", caps.SEV.CBitPos)
+		}
+		if sev.ReducedPhysBits != nil && *sev.ReducedPhysBits != caps.SEV.ReducedPhysBits {
+			c.report("launchSecurity/reducedPhysBits", fmt.Sprintf("%d", *sev.ReducedPhysBits),
+				"host reduces physical address bits by %d", caps.SEV.ReducedPhysBits)
+		}
+	}
+}
+
+// CheckDomainCaps returns every element of dom which caps report as
+// unsupported. Elements which caps do not describe are assumed to be
+// supported. A nil result means no problems were found.
+func CheckDomainCaps(dom *Domain, caps *DomainCaps) []DomainCapsViolation {
+	c := &domainCapsChecker{}
+
+	if caps.Domain != "" && dom.Type != "" && caps.Domain != dom.Type {
+		c.report("@type", dom.Type, "capabilities are for domain type '%s'", caps.Domain)
+	}
+	if dom.OS != nil && dom.OS.Type != nil && dom.OS.Type.Arch != "" &&
+		caps.Arch != "" && dom.OS.Type.Arch != caps.Arch {
+		c.report("os/type/@arch", dom.OS.Type.Arch, "capabilities are for architecture '%s'", caps.Arch)
+	}
+
+	if dom.VCPU != nil && caps.VCPU != nil && caps.VCPU.Max != 0 &&
+		dom.VCPU.Value > 0 && uint(dom.VCPU.Value) > caps.VCPU.Max {
+		c.report("vcpu", fmt.Sprintf("%d", dom.VCPU.Value), "maximum is %d vCPUs", caps.VCPU.Max)
+	}
+	if dom.IOThreads > 0 && caps.IOThreads != nil && caps.IOThreads.Supported != "yes" {
+		c.report("iothreads", fmt.Sprintf("%d", dom.IOThreads), "I/O threads are not supported")
+	}
+
+	c.os(dom.OS, &caps.OS)
+	c.cpu(dom.CPU, caps.CPU)
+	c.devices(dom.Devices, caps.Devices)
+	c.features(dom, caps.Features)
+
+	return c.violations
+}
@@ -0,0 +1,262 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var domainCapsCheckCaps = []string{
+	`<domainCapabilities>`,
+	`  <path>/usr/bin/qemu-system-x86_64</path>`,
+	`  <domain>kvm</domain>`,
+	`  <machine>pc-i440fx-2.11</machine>`,
+	`  <arch>x86_64</arch>`,
+	`  <vcpu max="255"/>`,
+	`  <iothreads supported="yes"/>`,
+	`  <os supported="yes">`,
+	`    <loader supported="yes">`,
+	`      <value>/usr/share/OVMF/OVMF_CODE.fd</value>`,
+	`      <enum name="type">`,
+	`        <value>rom</value>`,
+	`        <value>pflash</value>`,
+	`      </enum>`,
+	`      <enum name="readonly">`,
+	`        <value>yes</value>`,
+	`        <value>no</value>`,
+	`      </enum>`,
+	`    </loader>`,
+	`  </os>`,
+	`  <cpu>`,
+	`    <mode name="host-passthrough" supported="yes"/>`,
+	`    <mode name="host-model" supported="no"/>`,
+	`    <mode name="custom" supported="yes">`,
+	`      <model usable="yes">Nehalem</model>`,
+	`      <model usable="no">Skylake-Client</model>`,
+	`    </mode>`,
+	`  </cpu>`,
+	`  <devices>`,
+	`    <disk supported="yes">`,
+	`      <enum name="diskDevice">`,
+	`        <value>disk</value>`,
+	`        <value>cdrom</value>`,
+	`      </enum>`,
+	`      <enum name="bus">`,
+	`        <value>ide</value>`,
+	`        <value>scsi</value>`,
+	`        <value>virtio</value>`,
+	`      </enum>`,
+	`    </disk>`,
+	`    <graphics supported="yes">`,
+	`      <enum name="type">`,
+	`        <value>vnc</value>`,
+	`        <value>spice</value>`,
+	`      </enum>`,
+	`    </graphics>`,
+	`    <video supported="yes">`,
+	`      <enum name="modelType">`,
+	`        <value>vga</value>`,
+	`        <value>cirrus</value>`,
+	`        <value>qxl</value>`,
+	`      </enum>`,
+	`    </video>`,
+	`    <hostdev supported="yes">`,
+	`      <enum name="mode">`,
+	`        <value>subsystem</value>`,
+	`      </enum>`,
+	`      <enum name="subsysType">`,
+	`        <value>usb</value>`,
+	`        <value>pci</value>`,
+	`      </enum>`,
+	`      <enum name="pciBackend">`,
+	`        <value>vfio</value>`,
+	`      </enum>`,
+	`    </hostdev>`,
+	`  </devices>`,
+	`  <features>`,
+	`    <gic supported="no"/>`,
+	`    <vmcoreinfo supported="yes"/>`,
+	`    <genid supported="yes"/>`,
+	`    <sev supported="no"/>`,
+	`  </features>`,
+	`</domainCapabilities>`,
+}
+
+var domainCapsCheckTestData = []struct {
+	Domain   *Domain
+	Expected []DomainCapsViolation
+}{
+	{
+		Domain: &Domain{
+			Type: "kvm",
+			OS: &DomainOS{
+				Type: &DomainOSType{
+					Arch: "x86_64",
+					Type: "hvm",
+				},
+				Loader: &DomainLoader{
+					Path:     "/usr/share/OVMF/OVMF_CODE.fd",
+					Readonly: "yes",
+					Type:     "pflash",
+				},
+			},
+			CPU: &DomainCPU{
+				Mode: "custom",
+				Model: &DomainCPUModel{
+					Value: "Nehalem",
+				},
+			},
+			Devices: &DomainDeviceList{
+				Disks: []DomainDisk{
+					DomainDisk{
+						Device: "disk",
+						Target: &DomainDiskTarget{
+							Dev: "vda",
+							Bus: "virtio",
+						},
+					},
+				},
+				Videos: []DomainVideo{
+					DomainVideo{
+						Model: DomainVideoModel{
+							Type: "qxl",
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Domain: &Domain{
+			Type: "kvm",
+			OS: &DomainOS{
+				Type: &DomainOSType{
+					Arch: "aarch64",
+				},
+				Loader: &DomainLoader{
+					Type: "nvram",
+				},
+			},
+			CPU: &DomainCPU{
+				Mode: "host-model",
+			},
+			Features: &DomainFeatureList{
+				GIC: &DomainFeatureGIC{
+					Version: "3",
+				},
+			},
+			Devices: &DomainDeviceList{
+				Disks: []DomainDisk{
+					DomainDisk{
+						Device: "lun",
+						Target: &DomainDiskTarget{
+							Dev: "sda",
+							Bus: "sata",
+						},
+					},
+				},
+				Graphics: []DomainGraphic{
+					DomainGraphic{
+						SDL: &DomainGraphicSDL{},
+					},
+				},
+				Videos: []DomainVideo{
+					DomainVideo{
+						Model: DomainVideoModel{
+							Type: "virtio",
+						},
+					},
+				},
+				Hostdevs: []DomainHostdev{
+					DomainHostdev{
+						SubsysPCI: &DomainHostdevSubsysPCI{
+							Driver: &DomainHostdevSubsysPCIDriver{
+								Name: "kvm",
+							},
+						},
+					},
+					DomainHostdev{
+						CapsNet: &DomainHostdevCapsNet{},
+					},
+				},
+			},
+		},
+		Expected: []DomainCapsViolation{
+			{"os/type/@arch", "aarch64", "capabilities are for architecture 'x86_64'"},
+			{"os/loader/@type", "nvram", "type is not supported"},
+			{"cpu/@mode", "host-model", "CPU mode is not supported"},
+			{"devices/disk[0]/@device", "lun", "diskDevice is not supported"},
+			{"devices/disk[0]/target/@bus", "sata", "bus is not supported"},
+			{"devices/graphics[0]/@type", "sdl", "type is not supported"},
+			{"devices/video[0]/model/@type", "virtio", "modelType is not supported"},
+			{"devices/hostdev[0]/driver/@name", "kvm", "pciBackend is not supported"},
+			{"devices/hostdev[1]/@mode", "capabilities", "mode is not supported"},
+			{"features/gic", "", "GIC is not supported"},
+		},
+	},
+	{
+		Domain: &Domain{
+			CPU: &DomainCPU{
+				Model: &DomainCPUModel{
+					Value: "Skylake-Client",
+				},
+			},
+			GenID: &DomainGenID{},
+			LaunchSecurity: &DomainLaunchSecurity{
+				SEV: &DomainLaunchSecuritySEV{},
+			},
+		},
+		Expected: []DomainCapsViolation{
+			{"cpu/model", "Skylake-Client", "CPU model is not usable on this host"},
+			{"launchSecurity", "sev", "SEV is not supported"},
+		},
+	},
+	{
+		Domain: &Domain{
+			CPU: &DomainCPU{
+				Mode: "maximum",
+			},
+		},
+		Expected: nil,
+	},
+}
+
+func TestCheckDomainCaps(t *testing.T) {
+	caps := &DomainCaps{}
+	err := caps.Unmarshal(strings.Join(domainCapsCheckCaps, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range domainCapsCheckTestData {
+		violations := CheckDomainCaps(test.Domain, caps)
+		if !reflect.DeepEqual(violations, test.Expected) {
+			t.Fatal("Bad violations:\n", violations, "\n does not match\n", test.Expected)
+		}
+	}
+}
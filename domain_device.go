@@ -0,0 +1,758 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+)
+
+// DomainDevice is implemented by pointers to each of the device types
+// held in a DomainDeviceList. The alias and address accessors carry a
+// Device prefix since the structs already have fields with the plain
+// names.
+type DomainDevice interface {
+	// Kind returns the name of the device's XML element, such as
+	// "disk" or "hostdev"
+	Kind() string
+	// DeviceAlias returns the alias assigned to the device, or nil
+	DeviceAlias() *DomainAlias
+	// DeviceAddress returns the guest address of the device, or nil
+	DeviceAddress() *DomainAddress
+	Marshal() (string, error)
+}
+
+func (d *DomainDisk) Kind() string {
+	return "disk"
+}
+
+func (d *DomainDisk) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainDisk) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainController) Kind() string {
+	return "controller"
+}
+
+func (d *DomainController) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainController) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainLease) Kind() string {
+	return "lease"
+}
+
+func (d *DomainLease) DeviceAlias() *DomainAlias {
+	return nil
+}
+
+func (d *DomainLease) DeviceAddress() *DomainAddress {
+	return nil
+}
+
+func (d *DomainFilesystem) Kind() string {
+	return "filesystem"
+}
+
+func (d *DomainFilesystem) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainFilesystem) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainInterface) Kind() string {
+	return "interface"
+}
+
+func (d *DomainInterface) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainInterface) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainSmartcard) Kind() string {
+	return "smartcard"
+}
+
+func (d *DomainSmartcard) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainSmartcard) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainSerial) Kind() string {
+	return "serial"
+}
+
+func (d *DomainSerial) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainSerial) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainParallel) Kind() string {
+	return "parallel"
+}
+
+func (d *DomainParallel) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainParallel) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainConsole) Kind() string {
+	return "console"
+}
+
+func (d *DomainConsole) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainConsole) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainChannel) Kind() string {
+	return "channel"
+}
+
+func (d *DomainChannel) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainChannel) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainInput) Kind() string {
+	return "input"
+}
+
+func (d *DomainInput) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainInput) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainTPM) Kind() string {
+	return "tpm"
+}
+
+func (d *DomainTPM) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainTPM) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainGraphic) Kind() string {
+	return "graphics"
+}
+
+func (d *DomainGraphic) DeviceAlias() *DomainAlias {
+	return nil
+}
+
+func (d *DomainGraphic) DeviceAddress() *DomainAddress {
+	return nil
+}
+
+func (d *DomainSound) Kind() string {
+	return "sound"
+}
+
+func (d *DomainSound) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainSound) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainVideo) Kind() string {
+	return "video"
+}
+
+func (d *DomainVideo) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainVideo) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainHostdev) Kind() string {
+	return "hostdev"
+}
+
+func (d *DomainHostdev) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainHostdev) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainRedirDev) Kind() string {
+	return "redirdev"
+}
+
+func (d *DomainRedirDev) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainRedirDev) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainRedirFilter) Kind() string {
+	return "redirfilter"
+}
+
+func (d *DomainRedirFilter) DeviceAlias() *DomainAlias {
+	return nil
+}
+
+func (d *DomainRedirFilter) DeviceAddress() *DomainAddress {
+	return nil
+}
+
+func (d *DomainHub) Kind() string {
+	return "hub"
+}
+
+func (d *DomainHub) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainHub) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainWatchdog) Kind() string {
+	return "watchdog"
+}
+
+func (d *DomainWatchdog) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainWatchdog) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainMemBalloon) Kind() string {
+	return "memballoon"
+}
+
+func (d *DomainMemBalloon) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainMemBalloon) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainRNG) Kind() string {
+	return "rng"
+}
+
+func (d *DomainRNG) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainRNG) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainNVRAM) Kind() string {
+	return "nvram"
+}
+
+func (d *DomainNVRAM) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainNVRAM) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainPanic) Kind() string {
+	return "panic"
+}
+
+func (d *DomainPanic) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainPanic) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainShmem) Kind() string {
+	return "shmem"
+}
+
+func (d *DomainShmem) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainShmem) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainMemorydev) Kind() string {
+	return "memory"
+}
+
+func (d *DomainMemorydev) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainMemorydev) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+func (d *DomainIOMMU) Kind() string {
+	return "iommu"
+}
+
+func (d *DomainIOMMU) DeviceAlias() *DomainAlias {
+	return nil
+}
+
+func (d *DomainIOMMU) DeviceAddress() *DomainAddress {
+	return nil
+}
+
+func (d *DomainVSock) Kind() string {
+	return "vsock"
+}
+
+func (d *DomainVSock) DeviceAlias() *DomainAlias {
+	return d.Alias
+}
+
+func (d *DomainVSock) DeviceAddress() *DomainAddress {
+	return d.Address
+}
+
+// All returns every device of the list, in the order they appear in
+// the XML document. The devices are referenced, not copied, so they
+// can be modified in place.
+func (l *DomainDeviceList) All() []DomainDevice {
+	var devs []DomainDevice
+	for i := range l.Disks {
+		devs = append(devs, &l.Disks[i])
+	}
+	for i := range l.Controllers {
+		devs = append(devs, &l.Controllers[i])
+	}
+	for i := range l.Leases {
+		devs = append(devs, &l.Leases[i])
+	}
+	for i := range l.Filesystems {
+		devs = append(devs, &l.Filesystems[i])
+	}
+	for i := range l.Interfaces {
+		devs = append(devs, &l.Interfaces[i])
+	}
+	for i := range l.Smartcards {
+		devs = append(devs, &l.Smartcards[i])
+	}
+	for i := range l.Serials {
+		devs = append(devs, &l.Serials[i])
+	}
+	for i := range l.Parallels {
+		devs = append(devs, &l.Parallels[i])
+	}
+	for i := range l.Consoles {
+		devs = append(devs, &l.Consoles[i])
+	}
+	for i := range l.Channels {
+		devs = append(devs, &l.Channels[i])
+	}
+	for i := range l.Inputs {
+		devs = append(devs, &l.Inputs[i])
+	}
+	for i := range l.TPMs {
+		devs = append(devs, &l.TPMs[i])
+	}
+	for i := range l.Graphics {
+		devs = append(devs, &l.Graphics[i])
+	}
+	for i := range l.Sounds {
+		devs = append(devs, &l.Sounds[i])
+	}
+	for i := range l.Videos {
+		devs = append(devs, &l.Videos[i])
+	}
+	for i := range l.Hostdevs {
+		devs = append(devs, &l.Hostdevs[i])
+	}
+	for i := range l.RedirDevs {
+		devs = append(devs, &l.RedirDevs[i])
+	}
+	for i := range l.RedirFilters {
+		devs = append(devs, &l.RedirFilters[i])
+	}
+	for i := range l.Hubs {
+		devs = append(devs, &l.Hubs[i])
+	}
+	if l.Watchdog != nil {
+		devs = append(devs, l.Watchdog)
+	}
+	if l.MemBalloon != nil {
+		devs = append(devs, l.MemBalloon)
+	}
+	for i := range l.RNGs {
+		devs = append(devs, &l.RNGs[i])
+	}
+	if l.NVRAM != nil {
+		devs = append(devs, l.NVRAM)
+	}
+	for i := range l.Panics {
+		devs = append(devs, &l.Panics[i])
+	}
+	for i := range l.Shmems {
+		devs = append(devs, &l.Shmems[i])
+	}
+	for i := range l.Memorydevs {
+		devs = append(devs, &l.Memorydevs[i])
+	}
+	if l.IOMMU != nil {
+		devs = append(devs, l.IOMMU)
+	}
+	if l.VSock != nil {
+		devs = append(devs, l.VSock)
+	}
+	return devs
+}
+
+// Add appends a copy of dev to the matching member of the list. It
+// fails if the list can only hold a single device of that kind and
+// already has one.
+func (l *DomainDeviceList) Add(dev DomainDevice) error {
+	switch d := dev.(type) {
+	case *DomainDisk:
+		l.Disks = append(l.Disks, *d)
+	case *DomainController:
+		l.Controllers = append(l.Controllers, *d)
+	case *DomainLease:
+		l.Leases = append(l.Leases, *d)
+	case *DomainFilesystem:
+		l.Filesystems = append(l.Filesystems, *d)
+	case *DomainInterface:
+		l.Interfaces = append(l.Interfaces, *d)
+	case *DomainSmartcard:
+		l.Smartcards = append(l.Smartcards, *d)
+	case *DomainSerial:
+		l.Serials = append(l.Serials, *d)
+	case *DomainParallel:
+		l.Parallels = append(l.Parallels, *d)
+	case *DomainConsole:
+		l.Consoles = append(l.Consoles, *d)
+	case *DomainChannel:
+		l.Channels = append(l.Channels, *d)
+	case *DomainInput:
+		l.Inputs = append(l.Inputs, *d)
+	case *DomainTPM:
+		l.TPMs = append(l.TPMs, *d)
+	case *DomainGraphic:
+		l.Graphics = append(l.Graphics, *d)
+	case *DomainSound:
+		l.Sounds = append(l.Sounds, *d)
+	case *DomainVideo:
+		l.Videos = append(l.Videos, *d)
+	case *DomainHostdev:
+		l.Hostdevs = append(l.Hostdevs, *d)
+	case *DomainRedirDev:
+		l.RedirDevs = append(l.RedirDevs, *d)
+	case *DomainRedirFilter:
+		l.RedirFilters = append(l.RedirFilters, *d)
+	case *DomainHub:
+		l.Hubs = append(l.Hubs, *d)
+	case *DomainWatchdog:
+		if l.Watchdog != nil {
+			return fmt.Errorf("Domain already has a watchdog device")
+		}
+		c := *d
+		l.Watchdog = &c
+	case *DomainMemBalloon:
+		if l.MemBalloon != nil {
+			return fmt.Errorf("Domain already has a memballoon device")
+		}
+		c := *d
+		l.MemBalloon = &c
+	case *DomainRNG:
+		l.RNGs = append(l.RNGs, *d)
+	case *DomainNVRAM:
+		if l.NVRAM != nil {
+			return fmt.Errorf("Domain already has a nvram device")
+		}
+		c := *d
+		l.NVRAM = &c
+	case *DomainPanic:
+		l.Panics = append(l.Panics, *d)
+	case *DomainShmem:
+		l.Shmems = append(l.Shmems, *d)
+	case *DomainMemorydev:
+		l.Memorydevs = append(l.Memorydevs, *d)
+	case *DomainIOMMU:
+		if l.IOMMU != nil {
+			return fmt.Errorf("Domain already has a iommu device")
+		}
+		c := *d
+		l.IOMMU = &c
+	case *DomainVSock:
+		if l.VSock != nil {
+			return fmt.Errorf("Domain already has a vsock device")
+		}
+		c := *d
+		l.VSock = &c
+	default:
+		return fmt.Errorf("Unsupported device type %T", dev)
+	}
+	return nil
+}
+
+// Remove deletes dev from the list. The device must be one returned by
+// All, as it is identified by its location rather than its content.
+// Removing a device invalidates the other references to devices of
+// the same kind.
+func (l *DomainDeviceList) Remove(dev DomainDevice) error {
+	switch d := dev.(type) {
+	case *DomainDisk:
+		for i := range l.Disks {
+			if &l.Disks[i] == d {
+				l.Disks = append(l.Disks[:i], l.Disks[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainController:
+		for i := range l.Controllers {
+			if &l.Controllers[i] == d {
+				l.Controllers = append(l.Controllers[:i], l.Controllers[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainLease:
+		for i := range l.Leases {
+			if &l.Leases[i] == d {
+				l.Leases = append(l.Leases[:i], l.Leases[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainFilesystem:
+		for i := range l.Filesystems {
+			if &l.Filesystems[i] == d {
+				l.Filesystems = append(l.Filesystems[:i], l.Filesystems[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainInterface:
+		for i := range l.Interfaces {
+			if &l.Interfaces[i] == d {
+				l.Interfaces = append(l.Interfaces[:i], l.Interfaces[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainSmartcard:
+		for i := range l.Smartcards {
+			if &l.Smartcards[i] == d {
+				l.Smartcards = append(l.Smartcards[:i], l.Smartcards[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainSerial:
+		for i := range l.Serials {
+			if &l.Serials[i] == d {
+				l.Serials = append(l.Serials[:i], l.Serials[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainParallel:
+		for i := range l.Parallels {
+			if &l.Parallels[i] == d {
+				l.Parallels = append(l.Parallels[:i], l.Parallels[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainConsole:
+		for i := range l.Consoles {
+			if &l.Consoles[i] == d {
+				l.Consoles = append(l.Consoles[:i], l.Consoles[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainChannel:
+		for i := range l.Channels {
+			if &l.Channels[i] == d {
+				l.Channels = append(l.Channels[:i], l.Channels[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainInput:
+		for i := range l.Inputs {
+			if &l.Inputs[i] == d {
+				l.Inputs = append(l.Inputs[:i], l.Inputs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainTPM:
+		for i := range l.TPMs {
+			if &l.TPMs[i] == d {
+				l.TPMs = append(l.TPMs[:i], l.TPMs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainGraphic:
+		for i := range l.Graphics {
+			if &l.Graphics[i] == d {
+				l.Graphics = append(l.Graphics[:i], l.Graphics[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainSound:
+		for i := range l.Sounds {
+			if &l.Sounds[i] == d {
+				l.Sounds = append(l.Sounds[:i], l.Sounds[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainVideo:
+		for i := range l.Videos {
+			if &l.Videos[i] == d {
+				l.Videos = append(l.Videos[:i], l.Videos[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainHostdev:
+		for i := range l.Hostdevs {
+			if &l.Hostdevs[i] == d {
+				l.Hostdevs = append(l.Hostdevs[:i], l.Hostdevs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainRedirDev:
+		for i := range l.RedirDevs {
+			if &l.RedirDevs[i] == d {
+				l.RedirDevs = append(l.RedirDevs[:i], l.RedirDevs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainRedirFilter:
+		for i := range l.RedirFilters {
+			if &l.RedirFilters[i] == d {
+				l.RedirFilters = append(l.RedirFilters[:i], l.RedirFilters[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainHub:
+		for i := range l.Hubs {
+			if &l.Hubs[i] == d {
+				l.Hubs = append(l.Hubs[:i], l.Hubs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainWatchdog:
+		if l.Watchdog == d {
+			l.Watchdog = nil
+			return nil
+		}
+	case *DomainMemBalloon:
+		if l.MemBalloon == d {
+			l.MemBalloon = nil
+			return nil
+		}
+	case *DomainRNG:
+		for i := range l.RNGs {
+			if &l.RNGs[i] == d {
+				l.RNGs = append(l.RNGs[:i], l.RNGs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainNVRAM:
+		if l.NVRAM == d {
+			l.NVRAM = nil
+			return nil
+		}
+	case *DomainPanic:
+		for i := range l.Panics {
+			if &l.Panics[i] == d {
+				l.Panics = append(l.Panics[:i], l.Panics[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainShmem:
+		for i := range l.Shmems {
+			if &l.Shmems[i] == d {
+				l.Shmems = append(l.Shmems[:i], l.Shmems[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainMemorydev:
+		for i := range l.Memorydevs {
+			if &l.Memorydevs[i] == d {
+				l.Memorydevs = append(l.Memorydevs[:i], l.Memorydevs[i+1:]...)
+				return nil
+			}
+		}
+	case *DomainIOMMU:
+		if l.IOMMU == d {
+			l.IOMMU = nil
+			return nil
+		}
+	case *DomainVSock:
+		if l.VSock == d {
+			l.VSock = nil
+			return nil
+		}
+	default:
+		return fmt.Errorf("Unsupported device type %T", dev)
+	}
+	return fmt.Errorf("Device %s is not part of the domain", dev.Kind())
+}
@@ -0,0 +1,527 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// findString matches an optional string of the partial device, which
+// matches anything when empty
+func findString(partial, actual string) bool {
+	return partial == "" || partial == actual
+}
+
+// findUint compares unsigned attributes which default to zero when
+// left out, as is the case for the parts of a PCI address
+func findUint(partial, actual *uint) bool {
+	var p, a uint
+	if partial != nil {
+		p = *partial
+	}
+	if actual != nil {
+		a = *actual
+	}
+	return p == a
+}
+
+func findAlias(partial, actual *DomainAlias) bool {
+	if partial == nil || partial.Name == "" {
+		return true
+	}
+	return actual != nil && partial.Name == actual.Name
+}
+
+func findAddressPCI(partial, actual *DomainAddressPCI) bool {
+	if partial == nil || actual == nil {
+		return partial == actual
+	}
+	return findUint(partial.Domain, actual.Domain) &&
+		findUint(partial.Bus, actual.Bus) &&
+		findUint(partial.Slot, actual.Slot) &&
+		findUint(partial.Function, actual.Function)
+}
+
+func findAddress(partial, actual *DomainAddress) bool {
+	if partial == nil {
+		return true
+	}
+	if actual == nil {
+		return false
+	}
+	if partial.PCI != nil {
+		return findAddressPCI(partial.PCI, actual.PCI)
+	}
+	return reflect.DeepEqual(partial, actual)
+}
+
+func findDiskSource(src *DomainDiskSource) string {
+	if src == nil {
+		return ""
+	}
+	if src.File != nil {
+		return src.File.File
+	} else if src.Block != nil {
+		return src.Block.Dev
+	} else if src.Dir != nil {
+		return src.Dir.Dir
+	} else if src.Volume != nil {
+		return src.Volume.Pool + "/" + src.Volume.Volume
+	} else if src.Network != nil {
+		return src.Network.Protocol + ":" + src.Network.Name
+	}
+	return ""
+}
+
+// findDisk matches disks by target device name, falling back to the
+// source when the target is not given
+func findDisk(partial, actual *DomainDisk) bool {
+	if partial.Target != nil && partial.Target.Dev != "" {
+		return actual.Target != nil && partial.Target.Dev == actual.Target.Dev
+	}
+	if src := findDiskSource(partial.Source); src != "" {
+		return src == findDiskSource(actual.Source)
+	}
+	return true
+}
+
+func findController(partial, actual *DomainController) bool {
+	if partial.Type != actual.Type {
+		return false
+	}
+	return partial.Index == nil || findUint(partial.Index, actual.Index)
+}
+
+func findLease(partial, actual *DomainLease) bool {
+	return partial.Lockspace == actual.Lockspace && partial.Key == actual.Key
+}
+
+func findFilesystem(partial, actual *DomainFilesystem) bool {
+	if partial.Target == nil {
+		return true
+	}
+	return actual.Target != nil && partial.Target.Dir == actual.Target.Dir
+}
+
+func findInterface(partial, actual *DomainInterface) bool {
+	if partial.MAC == nil || partial.MAC.Address == "" {
+		return true
+	}
+	return actual.MAC != nil && strings.EqualFold(partial.MAC.Address, actual.MAC.Address)
+}
+
+func findHostdev(partial, actual *DomainHostdev) bool {
+	switch {
+	case partial.SubsysUSB != nil:
+		if actual.SubsysUSB == nil {
+			return false
+		}
+		if partial.SubsysUSB.Source == nil || partial.SubsysUSB.Source.Address == nil {
+			return true
+		}
+		if actual.SubsysUSB.Source == nil || actual.SubsysUSB.Source.Address == nil {
+			return false
+		}
+		p := partial.SubsysUSB.Source.Address
+		a := actual.SubsysUSB.Source.Address
+		return findUint(p.Bus, a.Bus) && findUint(p.Device, a.Device)
+	case partial.SubsysSCSI != nil:
+		if actual.SubsysSCSI == nil {
+			return false
+		}
+		p := partial.SubsysSCSI.Source
+		a := actual.SubsysSCSI.Source
+		if p == nil {
+			return true
+		}
+		if a == nil {
+			return false
+		}
+		if p.Host != nil {
+			if a.Host == nil {
+				return false
+			}
+			if p.Host.Adapter != nil && (a.Host.Adapter == nil || p.Host.Adapter.Name != a.Host.Adapter.Name) {
+				return false
+			}
+			return p.Host.Address == nil || reflect.DeepEqual(p.Host.Address, a.Host.Address)
+		}
+		if p.ISCSI != nil {
+			return a.ISCSI != nil && p.ISCSI.Name == a.ISCSI.Name
+		}
+		return true
+	case partial.SubsysSCSIHost != nil:
+		if actual.SubsysSCSIHost == nil {
+			return false
+		}
+		if partial.SubsysSCSIHost.Source == nil {
+			return true
+		}
+		return actual.SubsysSCSIHost.Source != nil &&
+			partial.SubsysSCSIHost.Source.WWPN == actual.SubsysSCSIHost.Source.WWPN
+	case partial.SubsysPCI != nil:
+		if actual.SubsysPCI == nil {
+			return false
+		}
+		if partial.SubsysPCI.Source == nil || partial.SubsysPCI.Source.Address == nil {
+			return true
+		}
+		return actual.SubsysPCI.Source != nil &&
+			findAddressPCI(partial.SubsysPCI.Source.Address, actual.SubsysPCI.Source.Address)
+	case partial.SubsysMDev != nil:
+		if actual.SubsysMDev == nil {
+			return false
+		}
+		if partial.SubsysMDev.Source == nil || partial.SubsysMDev.Source.Address == nil {
+			return true
+		}
+		return actual.SubsysMDev.Source != nil && actual.SubsysMDev.Source.Address != nil &&
+			strings.EqualFold(partial.SubsysMDev.Source.Address.UUID, actual.SubsysMDev.Source.Address.UUID)
+	case partial.CapsStorage != nil:
+		if actual.CapsStorage == nil {
+			return false
+		}
+		if partial.CapsStorage.Source == nil {
+			return true
+		}
+		return actual.CapsStorage.Source != nil &&
+			partial.CapsStorage.Source.Block == actual.CapsStorage.Source.Block
+	case partial.CapsMisc != nil:
+		if actual.CapsMisc == nil {
+			return false
+		}
+		if partial.CapsMisc.Source == nil {
+			return true
+		}
+		return actual.CapsMisc.Source != nil &&
+			partial.CapsMisc.Source.Char == actual.CapsMisc.Source.Char
+	case partial.CapsNet != nil:
+		if actual.CapsNet == nil {
+			return false
+		}
+		if partial.CapsNet.Source == nil {
+			return true
+		}
+		return actual.CapsNet.Source != nil &&
+			partial.CapsNet.Source.Interface == actual.CapsNet.Source.Interface
+	}
+	return true
+}
+
+// findChardevTarget matches the target type and port of serial,
+// parallel and console devices
+func findChardevTarget(partialType string, partialPort *uint, actualType string, actualPort *uint) bool {
+	if !findString(partialType, actualType) {
+		return false
+	}
+	return partialPort == nil || findUint(partialPort, actualPort)
+}
+
+func findSerial(partial, actual *DomainSerial) bool {
+	if partial.Target == nil {
+		return true
+	}
+	if actual.Target == nil {
+		return false
+	}
+	return findChardevTarget(partial.Target.Type, partial.Target.Port, actual.Target.Type, actual.Target.Port)
+}
+
+func findParallel(partial, actual *DomainParallel) bool {
+	if partial.Target == nil {
+		return true
+	}
+	if actual.Target == nil {
+		return false
+	}
+	return findChardevTarget(partial.Target.Type, partial.Target.Port, actual.Target.Type, actual.Target.Port)
+}
+
+func findConsole(partial, actual *DomainConsole) bool {
+	if partial.Target == nil {
+		return true
+	}
+	if actual.Target == nil {
+		return false
+	}
+	return findChardevTarget(partial.Target.Type, partial.Target.Port, actual.Target.Type, actual.Target.Port)
+}
+
+// findChannel matches virtio and xen channels by name and guestfwd
+// channels by address and port
+func findChannel(partial, actual *DomainChannel) bool {
+	p := partial.Target
+	a := actual.Target
+	if p == nil {
+		return true
+	}
+	if a == nil {
+		return false
+	}
+	switch {
+	case p.VirtIO != nil:
+		return a.VirtIO != nil && findString(p.VirtIO.Name, a.VirtIO.Name)
+	case p.Xen != nil:
+		return a.Xen != nil && findString(p.Xen.Name, a.Xen.Name)
+	case p.GuestFWD != nil:
+		return a.GuestFWD != nil &&
+			findString(p.GuestFWD.Address, a.GuestFWD.Address) &&
+			findString(p.GuestFWD.Port, a.GuestFWD.Port)
+	}
+	return true
+}
+
+func findInput(partial, actual *DomainInput) bool {
+	if partial.Type != actual.Type || !findString(partial.Bus, actual.Bus) {
+		return false
+	}
+	if partial.Source == nil {
+		return true
+	}
+	return actual.Source != nil && partial.Source.EVDev == actual.Source.EVDev
+}
+
+func findTPM(partial, actual *DomainTPM) bool {
+	return findString(partial.Model, actual.Model)
+}
+
+func findGraphicType(graphic *DomainGraphic) string {
+	switch {
+	case graphic.SDL != nil:
+		return "sdl"
+	case graphic.VNC != nil:
+		return "vnc"
+	case graphic.RDP != nil:
+		return "rdp"
+	case graphic.Desktop != nil:
+		return "desktop"
+	case graphic.Spice != nil:
+		return "spice"
+	case graphic.EGLHeadless != nil:
+		return "egl-headless"
+	}
+	return ""
+}
+
+func findGraphic(partial, actual *DomainGraphic) bool {
+	return findString(findGraphicType(partial), findGraphicType(actual))
+}
+
+func findSound(partial, actual *DomainSound) bool {
+	return findString(partial.Model, actual.Model)
+}
+
+func findVideo(partial, actual *DomainVideo) bool {
+	return findString(partial.Model.Type, actual.Model.Type)
+}
+
+func findRedirDev(partial, actual *DomainRedirDev) bool {
+	if !findString(partial.Bus, actual.Bus) {
+		return false
+	}
+	if partial.Source == nil {
+		return true
+	}
+	return actual.Source != nil && getChardevSourceType(partial.Source) == getChardevSourceType(actual.Source)
+}
+
+func findHub(partial, actual *DomainHub) bool {
+	return findString(partial.Type, actual.Type)
+}
+
+func findWatchdog(partial, actual *DomainWatchdog) bool {
+	return findString(partial.Model, actual.Model) && findString(partial.Action, actual.Action)
+}
+
+func findMemBalloon(partial, actual *DomainMemBalloon) bool {
+	return findString(partial.Model, actual.Model)
+}
+
+func findRNG(partial, actual *DomainRNG) bool {
+	if !findString(partial.Model, actual.Model) {
+		return false
+	}
+	if partial.Backend == nil {
+		return true
+	}
+	if actual.Backend == nil {
+		return false
+	}
+	if partial.Backend.Random != nil {
+		return actual.Backend.Random != nil &&
+			findString(partial.Backend.Random.Device, actual.Backend.Random.Device)
+	}
+	if partial.Backend.EGD != nil {
+		return actual.Backend.EGD != nil
+	}
+	return true
+}
+
+func findPanic(partial, actual *DomainPanic) bool {
+	return findString(partial.Model, actual.Model)
+}
+
+func findShmem(partial, actual *DomainShmem) bool {
+	return partial.Name == actual.Name
+}
+
+// findMemorydev matches memory devices by model and the target size
+// and node, as these are what tell two DIMMs apart
+func findMemorydev(partial, actual *DomainMemorydev) bool {
+	if partial.Model != actual.Model {
+		return false
+	}
+	p := partial.Target
+	a := actual.Target
+	if p == nil {
+		return true
+	}
+	if a == nil {
+		return false
+	}
+	if p.Size != nil {
+		if a.Size == nil {
+			return false
+		}
+		psize, err := scaleToKiB(uint64(p.Size.Value), p.Size.Unit)
+		if err != nil {
+			return false
+		}
+		asize, err := scaleToKiB(uint64(a.Size.Value), a.Size.Unit)
+		if err != nil || psize != asize {
+			return false
+		}
+	}
+	if p.Node != nil && (a.Node == nil || p.Node.Value != a.Node.Value) {
+		return false
+	}
+	return true
+}
+
+func findVSock(partial, actual *DomainVSock) bool {
+	if !findString(partial.Model, actual.Model) {
+		return false
+	}
+	if partial.CID == nil {
+		return true
+	}
+	if actual.CID == nil {
+		return false
+	}
+	return findString(partial.CID.Auto, actual.CID.Auto) && findString(partial.CID.Address, actual.CID.Address)
+}
+
+func findDevice(partial, actual DomainDevice) bool {
+	switch p := partial.(type) {
+	case *DomainDisk:
+		return findDisk(p, actual.(*DomainDisk))
+	case *DomainController:
+		return findController(p, actual.(*DomainController))
+	case *DomainLease:
+		return findLease(p, actual.(*DomainLease))
+	case *DomainFilesystem:
+		return findFilesystem(p, actual.(*DomainFilesystem))
+	case *DomainInterface:
+		return findInterface(p, actual.(*DomainInterface))
+	case *DomainSmartcard:
+		return true
+	case *DomainSerial:
+		return findSerial(p, actual.(*DomainSerial))
+	case *DomainParallel:
+		return findParallel(p, actual.(*DomainParallel))
+	case *DomainConsole:
+		return findConsole(p, actual.(*DomainConsole))
+	case *DomainChannel:
+		return findChannel(p, actual.(*DomainChannel))
+	case *DomainInput:
+		return findInput(p, actual.(*DomainInput))
+	case *DomainTPM:
+		return findTPM(p, actual.(*DomainTPM))
+	case *DomainGraphic:
+		return findGraphic(p, actual.(*DomainGraphic))
+	case *DomainSound:
+		return findSound(p, actual.(*DomainSound))
+	case *DomainVideo:
+		return findVideo(p, actual.(*DomainVideo))
+	case *DomainHostdev:
+		return findHostdev(p, actual.(*DomainHostdev))
+	case *DomainRedirDev:
+		return findRedirDev(p, actual.(*DomainRedirDev))
+	case *DomainHub:
+		return findHub(p, actual.(*DomainHub))
+	case *DomainWatchdog:
+		return findWatchdog(p, actual.(*DomainWatchdog))
+	case *DomainMemBalloon:
+		return findMemBalloon(p, actual.(*DomainMemBalloon))
+	case *DomainRNG:
+		return findRNG(p, actual.(*DomainRNG))
+	case *DomainPanic:
+		return findPanic(p, actual.(*DomainPanic))
+	case *DomainShmem:
+		return findShmem(p, actual.(*DomainShmem))
+	case *DomainMemorydev:
+		return findMemorydev(p, actual.(*DomainMemorydev))
+	case *DomainVSock:
+		return findVSock(p, actual.(*DomainVSock))
+	}
+	return true
+}
+
+// FindDevice looks up the device described by partial, following the
+// rules libvirt applies to the XML given when detaching a device:
+// disks match by target device or else by source, interfaces by MAC
+// address, hostdevs by source address and character devices by target
+// type and port. An alias or address in partial must match as well.
+// It returns the index of the device among those of the same kind,
+// such as the index into Devices.Disks, and fails unless exactly one
+// device matches.
+func (d *Domain) FindDevice(partial DomainDevice) (int, error) {
+	if d.Devices == nil {
+		return -1, fmt.Errorf("No %s device matches, the domain has no devices", partial.Kind())
+	}
+
+	found := -1
+	idx := 0
+	for _, dev := range d.Devices.All() {
+		if dev.Kind() != partial.Kind() {
+			continue
+		}
+		if findAlias(partial.DeviceAlias(), dev.DeviceAlias()) &&
+			findAddress(partial.DeviceAddress(), dev.DeviceAddress()) &&
+			findDevice(partial, dev) {
+			if found != -1 {
+				return -1, fmt.Errorf("Multiple %s devices match, at index %d and %d", partial.Kind(), found, idx)
+			}
+			found = idx
+		}
+		idx++
+	}
+	if found == -1 {
+		return -1, fmt.Errorf("No %s device matches", partial.Kind())
+	}
+	return found, nil
+}
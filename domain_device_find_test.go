@@ -0,0 +1,187 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+var domainFindDeviceXML = []string{
+	`<domain type="kvm">`,
+	`  <name>demo</name>`,
+	`  <devices>`,
+	`    <disk type="file" device="disk">`,
+	`      <source file="/srv/images/demo.img"/>`,
+	`      <target dev="vda" bus="virtio"/>`,
+	`      <alias name="virtio-disk0"/>`,
+	`    </disk>`,
+	`    <disk type="file" device="disk">`,
+	`      <source file="/srv/images/data.img"/>`,
+	`      <target dev="vdb" bus="virtio"/>`,
+	`      <alias name="virtio-disk1"/>`,
+	`    </disk>`,
+	`    <disk type="file" device="cdrom">`,
+	`      <source file="/srv/images/demo.img"/>`,
+	`      <target dev="sda" bus="sata"/>`,
+	`    </disk>`,
+	`    <controller type="usb" index="0"/>`,
+	`    <controller type="pci" index="0" model="pcie-root"/>`,
+	`    <controller type="pci" index="1" model="pcie-root-port"/>`,
+	`    <interface type="network">`,
+	`      <mac address="52:54:00:12:34:56"/>`,
+	`      <source network="default"/>`,
+	`      <address type="pci" domain="0x0000" bus="0x01" slot="0x00" function="0x0"/>`,
+	`    </interface>`,
+	`    <interface type="network">`,
+	`      <mac address="52:54:00:ab:cd:ef"/>`,
+	`      <source network="default"/>`,
+	`      <address type="pci" domain="0x0000" bus="0x02" slot="0x00" function="0x0"/>`,
+	`    </interface>`,
+	`    <serial type="pty">`,
+	`      <target type="isa-serial" port="0"/>`,
+	`    </serial>`,
+	`    <serial type="pty">`,
+	`      <target type="isa-serial" port="1"/>`,
+	`    </serial>`,
+	`    <channel type="unix">`,
+	`      <target type="virtio" name="org.qemu.guest_agent.0"/>`,
+	`    </channel>`,
+	`    <channel type="spicevmc">`,
+	`      <target type="virtio" name="com.redhat.spice.0"/>`,
+	`    </channel>`,
+	`    <hostdev mode="subsystem" type="pci" managed="yes">`,
+	`      <source>`,
+	`        <address domain="0x0000" bus="0x06" slot="0x12" function="0x5"/>`,
+	`      </source>`,
+	`    </hostdev>`,
+	`    <hostdev mode="subsystem" type="usb">`,
+	`      <source>`,
+	`        <address bus="1" device="4"/>`,
+	`      </source>`,
+	`    </hostdev>`,
+	`    <memory model="dimm">`,
+	`      <target>`,
+	`        <size unit="GiB">1</size>`,
+	`        <node>0</node>`,
+	`      </target>`,
+	`    </memory>`,
+	`    <memory model="dimm">`,
+	`      <target>`,
+	`        <size unit="MiB">1024</size>`,
+	`        <node>1</node>`,
+	`      </target>`,
+	`    </memory>`,
+	`    <memballoon model="virtio"/>`,
+	`  </devices>`,
+	`</domain>`,
+}
+
+var domainFindDeviceTests = []struct {
+	Partial []string
+	Device  DomainDevice
+	Index   int
+}{
+	{[]string{`<disk><target dev="vdb"/></disk>`}, &DomainDisk{}, 1},
+	{[]string{`<disk type="file"><source file="/srv/images/data.img"/></disk>`}, &DomainDisk{}, 1},
+	{[]string{`<disk type="file"><source file="/srv/images/demo.img"/></disk>`}, &DomainDisk{}, -1},
+	{[]string{`<disk><target dev="sda"/></disk>`}, &DomainDisk{}, 2},
+	{[]string{`<disk><alias name="virtio-disk0"/></disk>`}, &DomainDisk{}, 0},
+	{[]string{`<disk><target dev="vdc"/></disk>`}, &DomainDisk{}, -1},
+	{[]string{`<controller type="pci" index="1"/>`}, &DomainController{}, 2},
+	{[]string{`<controller type="usb"/>`}, &DomainController{}, 0},
+	{[]string{`<controller type="pci"/>`}, &DomainController{}, -1},
+	{[]string{`<interface type="network"><mac address="52:54:00:AB:CD:EF"/></interface>`}, &DomainInterface{}, 1},
+	{
+		[]string{
+			`<interface type="network">`,
+			`  <address type="pci" bus="0x01" slot="0x00" function="0x0"/>`,
+			`</interface>`,
+		},
+		&DomainInterface{}, 0,
+	},
+	{[]string{`<interface type="network"/>`}, &DomainInterface{}, -1},
+	{[]string{`<serial type="pty"><target port="1"/></serial>`}, &DomainSerial{}, 1},
+	{[]string{`<serial type="pty"><target type="usb-serial" port="1"/></serial>`}, &DomainSerial{}, -1},
+	{[]string{`<channel type="unix"><target type="virtio" name="org.qemu.guest_agent.0"/></channel>`}, &DomainChannel{}, 0},
+	{
+		[]string{
+			`<hostdev mode="subsystem" type="pci">`,
+			`  <source><address bus="0x06" slot="0x12" function="0x5"/></source>`,
+			`</hostdev>`,
+		},
+		&DomainHostdev{}, 0,
+	},
+	{
+		[]string{
+			`<hostdev mode="subsystem" type="usb">`,
+			`  <source><address bus="1" device="4"/></source>`,
+			`</hostdev>`,
+		},
+		&DomainHostdev{}, 1,
+	},
+	{
+		[]string{
+			`<hostdev mode="subsystem" type="pci">`,
+			`  <source><address bus="0x06" slot="0x12" function="0x6"/></source>`,
+			`</hostdev>`,
+		},
+		&DomainHostdev{}, -1,
+	},
+	{[]string{`<memory model="dimm"><target><size unit="MiB">1024</size><node>1</node></target></memory>`}, &DomainMemorydev{}, 1},
+	{[]string{`<memory model="dimm"><target><size unit="KiB">1048576</size></target></memory>`}, &DomainMemorydev{}, -1},
+	{[]string{`<memballoon model="virtio"/>`}, &DomainMemBalloon{}, 0},
+	{[]string{`<watchdog model="i6300esb"/>`}, &DomainWatchdog{}, -1},
+}
+
+func TestDomainFindDevice(t *testing.T) {
+	dom := &Domain{}
+	err := dom.Unmarshal(strings.Join(domainFindDeviceXML, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range domainFindDeviceTests {
+		doc := strings.Join(test.Partial, "\n")
+		err := xml.Unmarshal([]byte(doc), test.Device)
+		if err != nil {
+			t.Fatal(err)
+		}
+		idx, err := dom.FindDevice(test.Device)
+		if test.Index == -1 {
+			if err == nil {
+				t.Fatalf("Expected no match for %s, got index %d", doc, idx)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed to find %s: %s", doc, err)
+		}
+		if idx != test.Index {
+			t.Fatalf("Expected index %d for %s, got %d", test.Index, doc, idx)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+var domainDeviceTestXML = []string{
+	`<devices>`,
+	`  <disk type="file" device="disk">`,
+	`    <source file="/srv/images/demo.img"></source>`,
+	`    <target dev="vda" bus="virtio"></target>`,
+	`    <alias name="virtio-disk0"></alias>`,
+	`    <address type="pci" domain="0x0000" bus="0x00" slot="0x04" function="0x0"></address>`,
+	`  </disk>`,
+	`  <lease>`,
+	`    <lockspace>space</lockspace>`,
+	`    <key>key</key>`,
+	`    <target path="/var/lib/libvirt/lockd/demo"></target>`,
+	`  </lease>`,
+	`  <interface type="network">`,
+	`    <mac address="52:54:00:12:34:56"></mac>`,
+	`    <source network="default"></source>`,
+	`    <alias name="net0"></alias>`,
+	`  </interface>`,
+	`  <graphics type="vnc" autoport="yes"></graphics>`,
+	`  <hostdev mode="subsystem" type="pci" managed="yes">`,
+	`    <source>`,
+	`      <address domain="0x0000" bus="0x06" slot="0x12" function="0x5"></address>`,
+	`    </source>`,
+	`    <alias name="hostdev0"></alias>`,
+	`  </hostdev>`,
+	`  <memballoon model="virtio">`,
+	`    <alias name="balloon0"></alias>`,
+	`  </memballoon>`,
+	`</devices>`,
+}
+
+func TestDomainDeviceList(t *testing.T) {
+	var list DomainDeviceList
+	err := xml.Unmarshal([]byte(strings.Join(domainDeviceTestXML, "\n")), &list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devs := list.All()
+	var kinds, aliases []string
+	for _, dev := range devs {
+		kinds = append(kinds, dev.Kind())
+		alias := ""
+		if dev.DeviceAlias() != nil {
+			alias = dev.DeviceAlias().Name
+		}
+		aliases = append(aliases, alias)
+	}
+	expectKinds := "disk lease interface graphics hostdev memballoon"
+	if strings.Join(kinds, " ") != expectKinds {
+		t.Fatalf("Expected kinds %q, got %q", expectKinds, strings.Join(kinds, " "))
+	}
+	expectAliases := "virtio-disk0,,net0,,hostdev0,balloon0"
+	if strings.Join(aliases, ",") != expectAliases {
+		t.Fatalf("Expected aliases %q, got %q", expectAliases, strings.Join(aliases, ","))
+	}
+
+	addr := devs[0].DeviceAddress()
+	if addr == nil || addr.PCI == nil || *addr.PCI.Slot != 4 {
+		t.Fatal("Missing PCI address of disk")
+	}
+	if devs[2].DeviceAddress() != nil {
+		t.Fatal("Unexpected address of interface")
+	}
+
+	doc, err := devs[1].Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectLease := strings.Join(domainDeviceTestXML[7:12], "\n")
+	expectLease = strings.Replace(expectLease, "\n  ", "\n", -1)[2:]
+	if doc != expectLease {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expectLease, "\n")
+	}
+
+	// References are into the list, so changes are visible there
+	devs[0].(*DomainDisk).Target.Dev = "vdb"
+	if list.Disks[0].Target.Dev != "vdb" {
+		t.Fatal("Device was copied by All")
+	}
+
+	err = list.Remove(devs[4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Hostdevs) != 0 {
+		t.Fatal("Hostdev was not removed")
+	}
+	err = list.Remove(devs[4])
+	if err == nil {
+		t.Fatal("Expected error removing a missing device")
+	}
+	err = list.Remove(&DomainDisk{})
+	if err == nil {
+		t.Fatal("Expected error removing a device not part of the list")
+	}
+
+	err = list.Remove(devs[5])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.MemBalloon != nil {
+		t.Fatal("Memballoon was not removed")
+	}
+
+	dev := &DomainWatchdog{
+		Model:  "i6300esb",
+		Action: "reset",
+	}
+	err = list.Add(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Watchdog == nil || list.Watchdog == dev || list.Watchdog.Model != "i6300esb" {
+		t.Fatal("Watchdog was not added as a copy")
+	}
+	err = list.Add(dev)
+	if err == nil {
+		t.Fatal("Expected error adding a second watchdog")
+	}
+
+	err = list.Add(&DomainDisk{
+		Device: "cdrom",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Disks) != 2 || list.Disks[1].Device != "cdrom" {
+		t.Fatal("Disk was not added")
+	}
+	if len(list.All()) != 6 {
+		t.Fatalf("Expected 6 devices, got %d", len(list.All()))
+	}
+}
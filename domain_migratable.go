@@ -0,0 +1,328 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+)
+
+// DomainMigratableOptions controls the transformation done by
+// Domain.Migratable
+type DomainMigratableOptions struct {
+	// Paths maps path prefixes on the source host to the prefix
+	// to use instead on the destination host. The longest matching
+	// prefix wins, and prefixes only match whole path components.
+	Paths map[string]string
+}
+
+func (o *DomainMigratableOptions) path(path string) string {
+	if o == nil || path == "" {
+		return path
+	}
+	best := ""
+	for prefix := range o.Paths {
+		if len(prefix) <= len(best) || !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		if len(path) != len(prefix) &&
+			!strings.HasSuffix(prefix, "/") && path[len(prefix)] != '/' {
+			continue
+		}
+		best = prefix
+	}
+	if best == "" {
+		return path
+	}
+	return o.Paths[best] + path[len(best):]
+}
+
+func migratableAlias(alias *DomainAlias) *DomainAlias {
+	if alias != nil && strings.HasPrefix(alias.Name, "ua-") {
+		return alias
+	}
+	return nil
+}
+
+func migratableIfname(dev string) bool {
+	for _, prefix := range []string{"vnet", "macvtap", "macvlan", "vif"} {
+		if strings.HasPrefix(dev, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *DomainMigratableOptions) diskSource(src *DomainDiskSource) {
+	if src == nil {
+		return
+	}
+	if src.File != nil {
+		src.File.File = o.path(src.File.File)
+	} else if src.Block != nil {
+		src.Block.Dev = o.path(src.Block.Dev)
+	} else if src.Dir != nil {
+		src.Dir.Dir = o.path(src.Dir.Dir)
+	}
+}
+
+func (o *DomainMigratableOptions) chardevSource(src *DomainChardevSource) {
+	if src == nil {
+		return
+	}
+	if src.Pty != nil {
+		src.Pty.Path = ""
+	} else if src.Dev != nil {
+		src.Dev.Path = o.path(src.Dev.Path)
+	} else if src.File != nil {
+		src.File.Path = o.path(src.File.Path)
+	} else if src.Pipe != nil {
+		src.Pipe.Path = o.path(src.Pipe.Path)
+	} else if src.UNIX != nil {
+		src.UNIX.Path = o.path(src.UNIX.Path)
+	}
+}
+
+func (o *DomainMigratableOptions) chardevLog(log *DomainChardevLog) {
+	if log != nil {
+		log.File = o.path(log.File)
+	}
+}
+
+func (o *DomainMigratableOptions) graphicListeners(listeners []DomainGraphicListener) {
+	for i := range listeners {
+		if listeners[i].Socket != nil {
+			listeners[i].Socket.Socket = o.path(listeners[i].Socket.Socket)
+		}
+	}
+}
+
+func (o *DomainMigratableOptions) disk(disk *DomainDisk) {
+	o.diskSource(disk.Source)
+	disk.Mirror = nil
+	if disk.Driver == nil || disk.Driver.Type != "qcow2" {
+		disk.BackingStore = nil
+	}
+	for store := disk.BackingStore; store != nil; store = store.BackingStore {
+		o.diskSource(store.Source)
+	}
+	disk.Alias = migratableAlias(disk.Alias)
+}
+
+func (o *DomainMigratableOptions) filesystem(fs *DomainFilesystem) {
+	if fs.Source != nil {
+		if fs.Source.Mount != nil {
+			fs.Source.Mount.Dir = o.path(fs.Source.Mount.Dir)
+		} else if fs.Source.Block != nil {
+			fs.Source.Block.Dev = o.path(fs.Source.Block.Dev)
+		} else if fs.Source.File != nil {
+			fs.Source.File.File = o.path(fs.Source.File.File)
+		} else if fs.Source.Bind != nil {
+			fs.Source.Bind.Dir = o.path(fs.Source.Bind.Dir)
+		}
+	}
+	fs.Alias = migratableAlias(fs.Alias)
+}
+
+func (o *DomainMigratableOptions) iface(iface *DomainInterface) {
+	if iface.Target != nil && migratableIfname(iface.Target.Dev) {
+		iface.Target = nil
+	}
+	if iface.Source != nil {
+		o.chardevSource(iface.Source.VHostUser)
+	}
+	iface.Alias = migratableAlias(iface.Alias)
+}
+
+func (o *DomainMigratableOptions) graphic(graphic *DomainGraphic) {
+	if graphic.VNC != nil {
+		if graphic.VNC.AutoPort == "yes" {
+			graphic.VNC.Port = 0
+			graphic.VNC.WebSocket = 0
+		}
+		graphic.VNC.Socket = o.path(graphic.VNC.Socket)
+		o.graphicListeners(graphic.VNC.Listeners)
+	} else if graphic.Spice != nil {
+		if graphic.Spice.AutoPort == "yes" {
+			graphic.Spice.Port = 0
+			graphic.Spice.TLSPort = 0
+		}
+		o.graphicListeners(graphic.Spice.Listeners)
+	} else if graphic.RDP != nil {
+		if graphic.RDP.AutoPort == "yes" {
+			graphic.RDP.Port = 0
+		}
+		o.graphicListeners(graphic.RDP.Listeners)
+	}
+}
+
+func (o *DomainMigratableOptions) devices(devs *DomainDeviceList) {
+	devs.Emulator = o.path(devs.Emulator)
+	for i := range devs.Disks {
+		o.disk(&devs.Disks[i])
+	}
+	for i := range devs.Controllers {
+		devs.Controllers[i].Alias = migratableAlias(devs.Controllers[i].Alias)
+	}
+	for i := range devs.Filesystems {
+		o.filesystem(&devs.Filesystems[i])
+	}
+	for i := range devs.Interfaces {
+		o.iface(&devs.Interfaces[i])
+	}
+	for i := range devs.Smartcards {
+		devs.Smartcards[i].Alias = migratableAlias(devs.Smartcards[i].Alias)
+	}
+	for i := range devs.Serials {
+		serial := &devs.Serials[i]
+		o.chardevSource(serial.Source)
+		o.chardevLog(serial.Log)
+		serial.Alias = migratableAlias(serial.Alias)
+	}
+	for i := range devs.Parallels {
+		parallel := &devs.Parallels[i]
+		o.chardevSource(parallel.Source)
+		o.chardevLog(parallel.Log)
+		parallel.Alias = migratableAlias(parallel.Alias)
+	}
+	for i := range devs.Consoles {
+		console := &devs.Consoles[i]
+		console.TTY = ""
+		o.chardevSource(console.Source)
+		o.chardevLog(console.Log)
+		console.Alias = migratableAlias(console.Alias)
+	}
+	for i := range devs.Channels {
+		channel := &devs.Channels[i]
+		o.chardevSource(channel.Source)
+		o.chardevLog(channel.Log)
+		if channel.Target != nil {
+			if channel.Target.VirtIO != nil {
+				channel.Target.VirtIO.State = ""
+			} else if channel.Target.Xen != nil {
+				channel.Target.Xen.State = ""
+			}
+		}
+		channel.Alias = migratableAlias(channel.Alias)
+	}
+	for i := range devs.Inputs {
+		devs.Inputs[i].Alias = migratableAlias(devs.Inputs[i].Alias)
+	}
+	for i := range devs.TPMs {
+		devs.TPMs[i].Alias = migratableAlias(devs.TPMs[i].Alias)
+	}
+	for i := range devs.Graphics {
+		o.graphic(&devs.Graphics[i])
+	}
+	for i := range devs.Sounds {
+		devs.Sounds[i].Alias = migratableAlias(devs.Sounds[i].Alias)
+	}
+	for i := range devs.Videos {
+		devs.Videos[i].Alias = migratableAlias(devs.Videos[i].Alias)
+	}
+	for i := range devs.Hostdevs {
+		devs.Hostdevs[i].Alias = migratableAlias(devs.Hostdevs[i].Alias)
+	}
+	for i := range devs.RedirDevs {
+		devs.RedirDevs[i].Alias = migratableAlias(devs.RedirDevs[i].Alias)
+	}
+	for i := range devs.Hubs {
+		devs.Hubs[i].Alias = migratableAlias(devs.Hubs[i].Alias)
+	}
+	if devs.Watchdog != nil {
+		devs.Watchdog.Alias = migratableAlias(devs.Watchdog.Alias)
+	}
+	if devs.MemBalloon != nil {
+		devs.MemBalloon.Alias = migratableAlias(devs.MemBalloon.Alias)
+	}
+	for i := range devs.RNGs {
+		devs.RNGs[i].Alias = migratableAlias(devs.RNGs[i].Alias)
+	}
+	if devs.NVRAM != nil {
+		devs.NVRAM.Alias = migratableAlias(devs.NVRAM.Alias)
+	}
+	for i := range devs.Panics {
+		devs.Panics[i].Alias = migratableAlias(devs.Panics[i].Alias)
+	}
+	for i := range devs.Shmems {
+		devs.Shmems[i].Alias = migratableAlias(devs.Shmems[i].Alias)
+	}
+	for i := range devs.Memorydevs {
+		devs.Memorydevs[i].Alias = migratableAlias(devs.Memorydevs[i].Alias)
+	}
+	if devs.VSock != nil {
+		devs.VSock.Alias = migratableAlias(devs.VSock.Alias)
+	}
+}
+
+// Migratable returns a copy of the domain with the runtime only
+// state of a live domain stripped, in the same way libvirt formats
+// XML with the VIR_DOMAIN_XML_MIGRATABLE and VIR_DOMAIN_XML_INACTIVE
+// flags. Host specific paths are rewritten according to opts, which
+// may be nil.
+func (d *Domain) Migratable(opts *DomainMigratableOptions) (*Domain, error) {
+	doc, err := d.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dom := &Domain{}
+	err = dom.Unmarshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	dom.ID = nil
+
+	for i := range dom.SecLabel {
+		if dom.SecLabel[i].Type == "dynamic" {
+			dom.SecLabel[i].Label = ""
+			dom.SecLabel[i].ImageLabel = ""
+		}
+	}
+
+	if dom.OS != nil {
+		if dom.OS.Loader != nil {
+			dom.OS.Loader.Path = opts.path(dom.OS.Loader.Path)
+		}
+		if dom.OS.NVRam != nil {
+			dom.OS.NVRam.NVRam = opts.path(dom.OS.NVRam.NVRam)
+			dom.OS.NVRam.Template = opts.path(dom.OS.NVRam.Template)
+		}
+		dom.OS.Kernel = opts.path(dom.OS.Kernel)
+		dom.OS.Initrd = opts.path(dom.OS.Initrd)
+		dom.OS.DTB = opts.path(dom.OS.DTB)
+		if dom.OS.ACPI != nil {
+			for i := range dom.OS.ACPI.Tables {
+				dom.OS.ACPI.Tables[i].Path = opts.path(dom.OS.ACPI.Tables[i].Path)
+			}
+		}
+	}
+
+	if dom.Devices != nil {
+		opts.devices(dom.Devices)
+	}
+
+	return dom, nil
+}
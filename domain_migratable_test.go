@@ -0,0 +1,206 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var domainMigratableID = 7
+
+var domainMigratableTestData = []struct {
+	Object   *Domain
+	Options  *DomainMigratableOptions
+	Expected []string
+}{
+	{
+		Object: &Domain{
+			Type: "kvm",
+			ID:   &domainMigratableID,
+			Name: "demo",
+			SecLabel: []DomainSecLabel{
+				DomainSecLabel{
+					Type:       "dynamic",
+					Model:      "selinux",
+					Relabel:    "yes",
+					Label:      "system_u:system_r:svirt_t:s0:c12,c345",
+					ImageLabel: "system_u:object_r:svirt_image_t:s0:c12,c345",
+				},
+			},
+			Devices: &DomainDeviceList{
+				Emulator: "/usr/bin/qemu-kvm",
+				Disks: []DomainDisk{
+					DomainDisk{
+						Device: "disk",
+						Driver: &DomainDiskDriver{
+							Type: "raw",
+						},
+						Source: &DomainDiskSource{
+							File: &DomainDiskSourceFile{
+								File: "/srv/images/demo.img",
+							},
+						},
+						BackingStore: &DomainDiskBackingStore{},
+						Mirror: &DomainDiskMirror{
+							Job:   "copy",
+							Ready: "yes",
+						},
+						Target: &DomainDiskTarget{
+							Dev: "vda",
+						},
+						Alias: &DomainAlias{
+							Name: "virtio-disk0",
+						},
+					},
+					DomainDisk{
+						Device: "disk",
+						Driver: &DomainDiskDriver{
+							Type: "qcow2",
+						},
+						Source: &DomainDiskSource{
+							File: &DomainDiskSourceFile{
+								File: "/srv/imagesx/top.qcow2",
+							},
+						},
+						BackingStore: &DomainDiskBackingStore{
+							Index: 1,
+							Format: &DomainDiskFormat{
+								Type: "qcow2",
+							},
+							Source: &DomainDiskSource{
+								File: &DomainDiskSourceFile{
+									File: "/srv/images/base.qcow2",
+								},
+							},
+						},
+						Target: &DomainDiskTarget{
+							Dev: "vdb",
+						},
+						Alias: &DomainAlias{
+							Name: "ua-data",
+						},
+					},
+				},
+				Interfaces: []DomainInterface{
+					DomainInterface{
+						Source: &DomainInterfaceSource{
+							Network: &DomainInterfaceSourceNetwork{
+								Network: "default",
+							},
+						},
+						Target: &DomainInterfaceTarget{
+							Dev: "vnet0",
+						},
+						Alias: &DomainAlias{
+							Name: "net0",
+						},
+					},
+				},
+				Consoles: []DomainConsole{
+					DomainConsole{
+						TTY: "/dev/pts/3",
+						Source: &DomainChardevSource{
+							Pty: &DomainChardevSourcePty{
+								Path: "/dev/pts/3",
+							},
+						},
+						Alias: &DomainAlias{
+							Name: "console0",
+						},
+					},
+				},
+				Graphics: []DomainGraphic{
+					DomainGraphic{
+						VNC: &DomainGraphicVNC{
+							Port:     5900,
+							AutoPort: "yes",
+						},
+					},
+				},
+			},
+		},
+		Options: &DomainMigratableOptions{
+			Paths: map[string]string{
+				"/srv":        "/mnt/shared",
+				"/srv/images": "/var/lib/libvirt/images",
+			},
+		},
+		Expected: []string{
+			`<domain type="kvm">`,
+			`  <name>demo</name>`,
+			`  <devices>`,
+			`    <emulator>/usr/bin/qemu-kvm</emulator>`,
+			`    <disk type="file" device="disk">`,
+			`      <driver type="raw"></driver>`,
+			`      <source file="/var/lib/libvirt/images/demo.img"></source>`,
+			`      <target dev="vda"></target>`,
+			`    </disk>`,
+			`    <disk type="file" device="disk">`,
+			`      <driver type="qcow2"></driver>`,
+			`      <source file="/mnt/shared/imagesx/top.qcow2"></source>`,
+			`      <backingStore type="file" index="1">`,
+			`        <format type="qcow2"></format>`,
+			`        <source file="/var/lib/libvirt/images/base.qcow2"></source>`,
+			`      </backingStore>`,
+			`      <target dev="vdb"></target>`,
+			`      <alias name="ua-data"></alias>`,
+			`    </disk>`,
+			`    <interface type="network">`,
+			`      <source network="default"></source>`,
+			`    </interface>`,
+			`    <console type="pty"></console>`,
+			`    <graphics type="vnc" autoport="yes"></graphics>`,
+			`  </devices>`,
+			`  <seclabel type="dynamic" model="selinux" relabel="yes"></seclabel>`,
+			`</domain>`,
+		},
+	},
+}
+
+func TestDomainMigratable(t *testing.T) {
+	for _, test := range domainMigratableTestData {
+		dom, err := test.Object.Migratable(test.Options)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		doc, err := dom.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := strings.Join(test.Expected, "\n")
+
+		if doc != expect {
+			t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+		}
+
+		if test.Object.ID == nil || test.Object.Devices.Disks[0].Mirror == nil {
+			t.Fatal("Original domain was modified")
+		}
+	}
+}
@@ -0,0 +1,434 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DomainNUMAPlanRequest describes the size of a guest to be placed on
+// the NUMA topology of a host
+type DomainNUMAPlanRequest struct {
+	VCPUs uint
+	// Guest memory in KiB
+	Memory uint64
+	// Huge page size in KiB, or 0 to back the guest by normal memory
+	HugepageSize uint64
+	// Number of guest NUMA cells, 0 meaning 1
+	Cells     uint
+	IOThreads uint
+
+	// Host CPUs already used by other guests, as a libvirt bitmap
+	ReservedCPUs string
+	// Host memory already used by other guests per host NUMA node,
+	// in KiB of the page pool the guest is going to use
+	ReservedMemory map[uint]uint64
+}
+
+// DomainNUMAPlan is the placement computed by PlanDomainNUMA
+type DomainNUMAPlan struct {
+	Memory        *DomainMemory
+	VCPU          *DomainVCPU
+	IOThreads     uint
+	CPU           *DomainCPU
+	CPUTune       *DomainCPUTune
+	NUMATune      *DomainNUMATune
+	MemoryBacking *DomainMemoryBacking
+
+	// Host CPUs and NUMA nodes used by the guest, as libvirt bitmaps,
+	// for adding to the reservations of further plans
+	HostCPUs  string
+	HostNodes string
+}
+
+type numaPlanCore struct {
+	cpus []uint
+}
+
+type numaPlanCell struct {
+	id        uint
+	cores     []*numaPlanCore
+	memory    uint64
+	distances map[uint]uint
+	guests    int
+}
+
+func numaPlanHostCells(caps *Caps, req *DomainNUMAPlanRequest) ([]*numaPlanCell, error) {
+	reserved := make(map[uint]bool)
+	if req.ReservedCPUs != "" {
+		bits, err := parseBitmap(req.ReservedCPUs)
+		if err != nil {
+			return nil, err
+		}
+		for _, bit := range bits {
+			reserved[bit] = true
+		}
+	}
+
+	hostCells := capsHostCells(caps)
+	if len(hostCells) == 0 {
+		return nil, fmt.Errorf("Host capabilities do not describe the NUMA topology")
+	}
+
+	var cells []*numaPlanCell
+	for _, hostCell := range hostCells {
+		cell := &numaPlanCell{
+			id:        uint(hostCell.ID),
+			distances: make(map[uint]uint),
+		}
+
+		if req.HugepageSize != 0 {
+			for _, page := range hostCell.PageInfo {
+				size, err := scaleToKiB(uint64(page.Size), page.Unit)
+				if err != nil {
+					return nil, err
+				}
+				if size == req.HugepageSize {
+					cell.memory = page.Count * size
+				}
+			}
+		} else if hostCell.Memory != nil {
+			size, err := scaleToKiB(hostCell.Memory.Size, hostCell.Memory.Unit)
+			if err != nil {
+				return nil, err
+			}
+			cell.memory = size
+		}
+		used := req.ReservedMemory[cell.id]
+		if used > cell.memory {
+			used = cell.memory
+		}
+		cell.memory -= used
+
+		if hostCell.Distances != nil {
+			for _, sibling := range hostCell.Distances.Siblings {
+				cell.distances[uint(sibling.ID)] = uint(sibling.Value)
+			}
+		}
+
+		// Group the CPUs into cores, and only consider cores which
+		// have no reserved thread at all
+		if hostCell.CPUS != nil {
+			seen := make(map[uint]bool)
+			for _, cpu := range hostCell.CPUS.CPUs {
+				if seen[uint(cpu.ID)] {
+					continue
+				}
+				threads := []uint{uint(cpu.ID)}
+				if cpu.Siblings != "" {
+					var err error
+					threads, err = parseBitmap(cpu.Siblings)
+					if err != nil {
+						return nil, err
+					}
+				}
+				free := true
+				for _, thread := range threads {
+					seen[thread] = true
+					if reserved[thread] {
+						free = false
+					}
+				}
+				if free {
+					cell.cores = append(cell.cores, &numaPlanCore{cpus: threads})
+				}
+			}
+		}
+
+		cells = append(cells, cell)
+	}
+	return cells, nil
+}
+
+func (c *numaPlanCell) distance(other *numaPlanCell) uint {
+	if c == other {
+		return 10
+	}
+	if dist, ok := c.distances[other.id]; ok {
+		return dist
+	}
+	return 20
+}
+
+func numaPlanDescribe(cells []*numaPlanCell) string {
+	var desc []string
+	for _, cell := range cells {
+		desc = append(desc, fmt.Sprintf("node %d has %d free cores and %d KiB",
+			cell.id, len(cell.cores), cell.memory))
+	}
+	return strings.Join(desc, ", ")
+}
+
+// PlanDomainNUMA places a guest of the requested size on the host
+// described by caps. Each guest NUMA cell is put on a single host
+// NUMA node, preferring nodes close to the ones already picked, and
+// gets whole host cores so that thread siblings stay in the same
+// guest core.
+func PlanDomainNUMA(caps *Caps, req *DomainNUMAPlanRequest) (*DomainNUMAPlan, error) {
+	ncells := req.Cells
+	if ncells == 0 {
+		ncells = 1
+	}
+	if req.VCPUs == 0 {
+		return nil, fmt.Errorf("Guest must have at least one vCPU")
+	}
+	if req.VCPUs%ncells != 0 {
+		return nil, fmt.Errorf("%d vCPUs cannot be split evenly across %d guest NUMA cells",
+			req.VCPUs, ncells)
+	}
+	if req.Memory%uint64(ncells) != 0 {
+		return nil, fmt.Errorf("%d KiB of memory cannot be split evenly across %d guest NUMA cells",
+			req.Memory, ncells)
+	}
+	cellVCPUs := req.VCPUs / ncells
+	cellMemory := req.Memory / uint64(ncells)
+	if req.HugepageSize != 0 && cellMemory%req.HugepageSize != 0 {
+		return nil, fmt.Errorf("Guest NUMA cell memory of %d KiB is not a multiple of the %d KiB page size",
+			cellMemory, req.HugepageSize)
+	}
+
+	hostCells, err := numaPlanHostCells(caps, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use as many threads per guest core as the host cores provide,
+	// as long as they divide the vCPUs of a cell
+	hostThreads := uint(1)
+	for _, cell := range hostCells {
+		for _, core := range cell.cores {
+			if uint(len(core.cpus)) > hostThreads {
+				hostThreads = uint(len(core.cpus))
+			}
+		}
+	}
+	threads := hostThreads
+	for cellVCPUs%threads != 0 {
+		threads--
+	}
+	cores := cellVCPUs / threads
+
+	var picked []*numaPlanCell
+	var reserved [][]*numaPlanCore
+	for g := uint(0); g < ncells; g++ {
+		var candidates []*numaPlanCell
+		for _, cell := range hostCells {
+			if uint(len(cell.cores)) < cores || cell.memory < cellMemory {
+				continue
+			}
+			usable := uint(0)
+			for _, core := range cell.cores {
+				if uint(len(core.cpus)) >= threads {
+					usable++
+				}
+			}
+			if usable < cores {
+				continue
+			}
+			candidates = append(candidates, cell)
+		}
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("No host NUMA node can hold guest NUMA cell %d with %d cores of %d threads and %d KiB: %s",
+				g, cores, threads, cellMemory, numaPlanDescribe(hostCells))
+		}
+
+		// Prefer nodes not hosting another guest cell yet, then the
+		// nodes closest to the ones picked so far, then the tightest
+		// fit to keep large nodes available
+		score := func(cell *numaPlanCell) uint {
+			var total uint
+			for _, other := range picked {
+				total += cell.distance(other)
+			}
+			return total
+		}
+		better := func(a, b *numaPlanCell) bool {
+			if a.guests != b.guests {
+				return a.guests < b.guests
+			}
+			if score(a) != score(b) {
+				return score(a) < score(b)
+			}
+			return len(a.cores) < len(b.cores)
+		}
+		cell := candidates[0]
+		for _, candidate := range candidates[1:] {
+			if better(candidate, cell) {
+				cell = candidate
+			}
+		}
+		cell.guests++
+		cell.memory -= cellMemory
+		picked = append(picked, cell)
+
+		// Reserve the cores right away, so that later guest cells
+		// placed on the same node only see what is left
+		var taken []*numaPlanCore
+		for i := 0; i < len(cell.cores) && uint(len(taken)) < cores; {
+			if uint(len(cell.cores[i].cpus)) >= threads {
+				taken = append(taken, cell.cores[i])
+				cell.cores = append(cell.cores[:i], cell.cores[i+1:]...)
+			} else {
+				i++
+			}
+		}
+		reserved = append(reserved, taken)
+	}
+
+	plan := &DomainNUMAPlan{
+		Memory: &DomainMemory{
+			Value: uint(req.Memory),
+			Unit:  "KiB",
+		},
+		IOThreads: req.IOThreads,
+		CPU: &DomainCPU{
+			Topology: &DomainCPUTopology{
+				Sockets: int(ncells),
+				Cores:   int(cores),
+				Threads: int(threads),
+			},
+			Numa: &DomainNuma{},
+		},
+		CPUTune: &DomainCPUTune{},
+		NUMATune: &DomainNUMATune{
+			Memory: &DomainNUMATuneMemory{
+				Mode: "strict",
+			},
+		},
+	}
+	if req.HugepageSize != 0 {
+		plan.MemoryBacking = &DomainMemoryBacking{
+			MemoryHugePages: &DomainMemoryHugepages{
+				Hugepages: []DomainMemoryHugepage{
+					DomainMemoryHugepage{
+						Size: uint(req.HugepageSize),
+						Unit: "KiB",
+					},
+				},
+			},
+		}
+	}
+
+	var allCPUs, allNodes []uint
+	cellCPUs := make([][]uint, ncells)
+	vcpu := uint(0)
+	for g, cell := range picked {
+		for _, core := range reserved[g] {
+			for t := uint(0); t < threads; t++ {
+				plan.CPUTune.VCPUPin = append(plan.CPUTune.VCPUPin, DomainCPUTuneVCPUPin{
+					VCPU:   vcpu,
+					CPUSet: fmt.Sprintf("%d", core.cpus[t]),
+				})
+				vcpu++
+			}
+			cellCPUs[g] = append(cellCPUs[g], core.cpus[:threads]...)
+		}
+		allCPUs = append(allCPUs, cellCPUs[g]...)
+		allNodes = append(allNodes, cell.id)
+
+		id := uint(g)
+		first := uint(g) * cellVCPUs
+		guestCell := DomainCell{
+			ID:     &id,
+			CPUs:   fmt.Sprintf("%d-%d", first, first+cellVCPUs-1),
+			Memory: fmt.Sprintf("%d", cellMemory),
+			Unit:   "KiB",
+		}
+		if cellVCPUs == 1 {
+			guestCell.CPUs = fmt.Sprintf("%d", first)
+		}
+		if ncells > 1 && len(cell.distances) != 0 {
+			guestCell.Distances = &DomainCellDistances{}
+			for j, other := range picked {
+				guestCell.Distances.Siblings = append(guestCell.Distances.Siblings, DomainCellSibling{
+					ID:    uint(j),
+					Value: cell.distance(other),
+				})
+			}
+		}
+		plan.CPU.Numa.Cell = append(plan.CPU.Numa.Cell, guestCell)
+
+		plan.NUMATune.MemNodes = append(plan.NUMATune.MemNodes, DomainNUMATuneMemNode{
+			CellID:  id,
+			Mode:    "strict",
+			Nodeset: fmt.Sprintf("%d", cell.id),
+		})
+	}
+
+	plan.HostCPUs = formatBitmap(allCPUs)
+	plan.HostNodes = formatBitmap(allNodes)
+	plan.NUMATune.Memory.Nodeset = plan.HostNodes
+	plan.VCPU = &DomainVCPU{
+		Placement: "static",
+		CPUSet:    plan.HostCPUs,
+		Value:     int(req.VCPUs),
+	}
+	plan.CPUTune.EmulatorPin = &DomainCPUTuneEmulatorPin{
+		CPUSet: plan.HostCPUs,
+	}
+	// I/O threads are spread over the guest cells round robin
+	for i := uint(0); i < req.IOThreads; i++ {
+		plan.CPUTune.IOThreadPin = append(plan.CPUTune.IOThreadPin, DomainCPUTuneIOThreadPin{
+			IOThread: i + 1,
+			CPUSet:   formatBitmap(cellCPUs[i%ncells]),
+		})
+	}
+
+	return plan, nil
+}
+
+// Apply stores the plan in dom, replacing any previous placement but
+// keeping unrelated settings such as the CPU model or scheduler tuning
+func (p *DomainNUMAPlan) Apply(dom *Domain) {
+	dom.Memory = p.Memory
+	dom.VCPU = p.VCPU
+	dom.IOThreads = p.IOThreads
+
+	if dom.CPU == nil {
+		dom.CPU = &DomainCPU{}
+	}
+	dom.CPU.Topology = p.CPU.Topology
+	dom.CPU.Numa = p.CPU.Numa
+
+	if dom.CPUTune == nil {
+		dom.CPUTune = &DomainCPUTune{}
+	}
+	dom.CPUTune.VCPUPin = p.CPUTune.VCPUPin
+	dom.CPUTune.EmulatorPin = p.CPUTune.EmulatorPin
+	dom.CPUTune.IOThreadPin = p.CPUTune.IOThreadPin
+
+	dom.NUMATune = p.NUMATune
+
+	if p.MemoryBacking != nil {
+		if dom.MemoryBacking == nil {
+			dom.MemoryBacking = &DomainMemoryBacking{}
+		}
+		dom.MemoryBacking.MemoryHugePages = p.MemoryBacking.MemoryHugePages
+	} else if dom.MemoryBacking != nil {
+		dom.MemoryBacking.MemoryHugePages = nil
+	}
+}
@@ -0,0 +1,241 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var numaPlanCaps = []string{
+	`<capabilities>`,
+	`  <host>`,
+	`    <topology>`,
+	`      <cells num="2">`,
+	`        <cell id="0">`,
+	`          <memory unit="KiB">8388608</memory>`,
+	`          <pages unit="KiB" size="4">2097152</pages>`,
+	`          <pages unit="KiB" size="1048576">4</pages>`,
+	`          <distances>`,
+	`            <sibling id="0" value="10"/>`,
+	`            <sibling id="1" value="21"/>`,
+	`          </distances>`,
+	`          <cpus num="4">`,
+	`            <cpu id="0" socket_id="0" core_id="0" siblings="0,2"/>`,
+	`            <cpu id="1" socket_id="0" core_id="1" siblings="1,3"/>`,
+	`            <cpu id="2" socket_id="0" core_id="0" siblings="0,2"/>`,
+	`            <cpu id="3" socket_id="0" core_id="1" siblings="1,3"/>`,
+	`          </cpus>`,
+	`        </cell>`,
+	`        <cell id="1">`,
+	`          <memory unit="KiB">8388608</memory>`,
+	`          <pages unit="KiB" size="4">2097152</pages>`,
+	`          <pages unit="KiB" size="1048576">2</pages>`,
+	`          <distances>`,
+	`            <sibling id="0" value="21"/>`,
+	`            <sibling id="1" value="10"/>`,
+	`          </distances>`,
+	`          <cpus num="4">`,
+	`            <cpu id="4" socket_id="1" core_id="0" siblings="4,6"/>`,
+	`            <cpu id="5" socket_id="1" core_id="1" siblings="5,7"/>`,
+	`            <cpu id="6" socket_id="1" core_id="0" siblings="4,6"/>`,
+	`            <cpu id="7" socket_id="1" core_id="1" siblings="5,7"/>`,
+	`          </cpus>`,
+	`        </cell>`,
+	`      </cells>`,
+	`    </topology>`,
+	`  </host>`,
+	`</capabilities>`,
+}
+
+var numaPlanTestData = []struct {
+	Request  *DomainNUMAPlanRequest
+	Expected []string
+}{
+	{
+		Request: &DomainNUMAPlanRequest{
+			VCPUs:        4,
+			Memory:       2097152,
+			Cells:        2,
+			IOThreads:    2,
+			ReservedCPUs: "1",
+		},
+		Expected: []string{
+			`<domain>`,
+			`  <name>demo</name>`,
+			`  <memory unit="KiB">2097152</memory>`,
+			`  <vcpu placement="static" cpuset="0,2,4,6">4</vcpu>`,
+			`  <iothreads>2</iothreads>`,
+			`  <cputune>`,
+			`    <vcpupin vcpu="0" cpuset="0"></vcpupin>`,
+			`    <vcpupin vcpu="1" cpuset="2"></vcpupin>`,
+			`    <vcpupin vcpu="2" cpuset="4"></vcpupin>`,
+			`    <vcpupin vcpu="3" cpuset="6"></vcpupin>`,
+			`    <emulatorpin cpuset="0,2,4,6"></emulatorpin>`,
+			`    <iothreadpin iothread="1" cpuset="0,2"></iothreadpin>`,
+			`    <iothreadpin iothread="2" cpuset="4,6"></iothreadpin>`,
+			`  </cputune>`,
+			`  <numatune>`,
+			`    <memory mode="strict" nodeset="0-1"></memory>`,
+			`    <memnode cellid="0" mode="strict" nodeset="0"></memnode>`,
+			`    <memnode cellid="1" mode="strict" nodeset="1"></memnode>`,
+			`  </numatune>`,
+			`  <cpu mode="host-passthrough">`,
+			`    <topology sockets="2" cores="1" threads="2"></topology>`,
+			`    <numa>`,
+			`      <cell id="0" cpus="0-1" memory="1048576" unit="KiB">`,
+			`        <distances>`,
+			`          <sibling id="0" value="10"></sibling>`,
+			`          <sibling id="1" value="21"></sibling>`,
+			`        </distances>`,
+			`      </cell>`,
+			`      <cell id="1" cpus="2-3" memory="1048576" unit="KiB">`,
+			`        <distances>`,
+			`          <sibling id="0" value="21"></sibling>`,
+			`          <sibling id="1" value="10"></sibling>`,
+			`        </distances>`,
+			`      </cell>`,
+			`    </numa>`,
+			`  </cpu>`,
+			`</domain>`,
+		},
+	},
+	{
+		Request: &DomainNUMAPlanRequest{
+			VCPUs:          4,
+			Memory:         3145728,
+			HugepageSize:   1048576,
+			ReservedCPUs:   "4-7",
+			ReservedMemory: map[uint]uint64{0: 1048576},
+		},
+		Expected: []string{
+			`<domain>`,
+			`  <name>demo</name>`,
+			`  <memory unit="KiB">3145728</memory>`,
+			`  <memoryBacking>`,
+			`    <hugepages>`,
+			`      <page size="1048576" unit="KiB"></page>`,
+			`    </hugepages>`,
+			`  </memoryBacking>`,
+			`  <vcpu placement="static" cpuset="0-3">4</vcpu>`,
+			`  <cputune>`,
+			`    <vcpupin vcpu="0" cpuset="0"></vcpupin>`,
+			`    <vcpupin vcpu="1" cpuset="2"></vcpupin>`,
+			`    <vcpupin vcpu="2" cpuset="1"></vcpupin>`,
+			`    <vcpupin vcpu="3" cpuset="3"></vcpupin>`,
+			`    <emulatorpin cpuset="0-3"></emulatorpin>`,
+			`  </cputune>`,
+			`  <numatune>`,
+			`    <memory mode="strict" nodeset="0"></memory>`,
+			`    <memnode cellid="0" mode="strict" nodeset="0"></memnode>`,
+			`  </numatune>`,
+			`  <cpu mode="host-passthrough">`,
+			`    <topology sockets="1" cores="2" threads="2"></topology>`,
+			`    <numa>`,
+			`      <cell id="0" cpus="0-3" memory="3145728" unit="KiB"></cell>`,
+			`    </numa>`,
+			`  </cpu>`,
+			`</domain>`,
+		},
+	},
+}
+
+func TestPlanDomainNUMA(t *testing.T) {
+	caps := &Caps{}
+	err := caps.Unmarshal(strings.Join(numaPlanCaps, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range numaPlanTestData {
+		plan, err := PlanDomainNUMA(caps, test.Request)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dom := &Domain{
+			Name: "demo",
+			CPU: &DomainCPU{
+				Mode: "host-passthrough",
+			},
+		}
+		plan.Apply(dom)
+
+		doc, err := dom.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := strings.Join(test.Expected, "\n")
+
+		if doc != expect {
+			t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestPlanDomainNUMAErrors(t *testing.T) {
+	caps := &Caps{}
+	err := caps.Unmarshal(strings.Join(numaPlanCaps, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := []*DomainNUMAPlanRequest{
+		&DomainNUMAPlanRequest{
+			VCPUs:  3,
+			Memory: 1048576,
+			Cells:  2,
+		},
+		&DomainNUMAPlanRequest{
+			VCPUs:        2,
+			Memory:       5242880,
+			HugepageSize: 1048576,
+		},
+		&DomainNUMAPlanRequest{
+			VCPUs:        6,
+			Memory:       1048576,
+			ReservedCPUs: "0",
+		},
+		&DomainNUMAPlanRequest{
+			VCPUs:  12,
+			Memory: 3145728,
+			Cells:  3,
+		},
+		&DomainNUMAPlanRequest{
+			VCPUs:  16,
+			Memory: 4194304,
+			Cells:  4,
+		},
+	}
+
+	for _, req := range requests {
+		_, err := PlanDomainNUMA(caps, req)
+		if err == nil {
+			t.Fatal("Expected error for request", req)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DomainPartialOptions selects the parts of a domain document which
+// UnmarshalDomainPartial decodes
+type DomainPartialOptions struct {
+	// Sections names the elements to decode, either children of
+	// <domain> such as "name" or "metadata", or a kind of device
+	// such as "devices/disk"
+	Sections []string
+	// KeepRaw retains the XML of the sections which were not decoded,
+	// so that DomainPartial.Load can decode them later. Devices are
+	// kept by kind, such as "devices/disk".
+	KeepRaw bool
+}
+
+// DomainPartial is a domain document of which only some sections
+// have been decoded
+type DomainPartial struct {
+	Domain *Domain
+	rootNS string
+	raw    map[string][]string
+}
+
+type domainPartialField struct {
+	index int
+	space string
+}
+
+// domainPartialFields returns the fields of a struct holding child
+// elements, keyed by element name, named as encoding/xml names them
+func domainPartialFields(typ reflect.Type) map[string]domainPartialField {
+	fields := make(map[string]domainPartialField)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("xml")
+		opts := strings.Split(tag, ",")
+		if field.Name == "XMLName" || opts[0] == "-" {
+			continue
+		}
+		element := true
+		for _, opt := range opts[1:] {
+			if opt != "omitempty" {
+				element = false
+			}
+		}
+		if !element {
+			continue
+		}
+		name := opts[0]
+		if name == "" {
+			ftyp := field.Type
+			for ftyp.Kind() == reflect.Ptr || ftyp.Kind() == reflect.Slice {
+				ftyp = ftyp.Elem()
+			}
+			name = field.Name
+			if ftyp.Kind() == reflect.Struct {
+				if xmlname, ok := ftyp.FieldByName("XMLName"); ok {
+					name = xmlname.Tag.Get("xml")
+				}
+			}
+		}
+		space := ""
+		if idx := strings.LastIndex(name, " "); idx != -1 {
+			space = name[0:idx]
+			name = name[idx+1:]
+		}
+		fields[name] = domainPartialField{i, space}
+	}
+	return fields
+}
+
+var domainPartialTypes = map[string]map[string]domainPartialField{
+	"":        domainPartialFields(reflect.TypeOf(Domain{})),
+	"devices": domainPartialFields(reflect.TypeOf(DomainDeviceList{})),
+}
+
+type domainPartialDecoder struct {
+	doc      string
+	d        *xml.Decoder
+	sections map[string]bool
+	keepRaw  bool
+	partial  *DomainPartial
+}
+
+// UnmarshalDomainPartial decodes the given sections of a domain
+// document, along with the attributes of the <domain> element. The
+// other sections are skipped, or kept as XML when opts.KeepRaw is set.
+// A nil opts decodes no sections at all.
+func UnmarshalDomainPartial(doc string, opts *DomainPartialOptions) (*DomainPartial, error) {
+	if opts == nil {
+		opts = &DomainPartialOptions{}
+	}
+	partial := &DomainPartial{
+		Domain: &Domain{},
+		raw:    make(map[string][]string),
+	}
+	err := partial.decode(doc, opts.Sections, opts.KeepRaw)
+	if err != nil {
+		return nil, err
+	}
+	return partial, nil
+}
+
+func (p *DomainPartial) decode(doc string, sections []string, keepRaw bool) error {
+	pd := &domainPartialDecoder{
+		doc:      doc,
+		d:        newDocumentDecoder(strings.NewReader(doc)),
+		sections: make(map[string]bool),
+		keepRaw:  keepRaw,
+		partial:  p,
+	}
+	for _, section := range sections {
+		parent := ""
+		name := section
+		if idx := strings.Index(section, "/"); idx != -1 {
+			parent = section[0:idx]
+			name = section[idx+1:]
+		}
+		fields, ok := domainPartialTypes[parent]
+		if !ok {
+			return fmt.Errorf("Unknown domain section '%s'", section)
+		}
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("Unknown domain section '%s'", section)
+		}
+		pd.sections[section] = true
+	}
+
+	for {
+		offset := pd.d.InputOffset()
+		tok, err := pd.d.Token()
+		if err != nil {
+			return err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return pd.root(start, doc[offset:pd.d.InputOffset()])
+		}
+	}
+}
+
+// root decodes the <domain> element whose start tag is given as
+// written in the document
+func (pd *domainPartialDecoder) root(start xml.StartElement, tag string) error {
+	if start.Name.Local != "domain" {
+		return fmt.Errorf("Expected <domain> element, got <%s>", start.Name.Local)
+	}
+
+	// Decode the attributes alone by closing the start tag right away
+	if !strings.HasSuffix(tag, "/>") {
+		tag = strings.TrimSuffix(tag, ">") + "/>"
+	}
+	err := xml.Unmarshal([]byte(tag), pd.partial.Domain)
+	if err != nil {
+		return err
+	}
+
+	if pd.partial.rootNS == "" {
+		for _, attr := range start.Attr {
+			if attr.Name.Space == "xmlns" {
+				pd.partial.rootNS += fmt.Sprintf(" xmlns:%s=\"%s\"", attr.Name.Local, attr.Value)
+			}
+		}
+	}
+
+	return pd.children(reflect.ValueOf(pd.partial.Domain).Elem(), "")
+}
+
+// children decodes the selected child elements of the current element
+// into the struct value
+func (pd *domainPartialDecoder) children(val reflect.Value, parent string) error {
+	fields := domainPartialTypes[parent]
+	for {
+		offset := pd.d.InputOffset()
+		tok, err := pd.d.Token()
+		if err != nil {
+			return err
+		}
+
+		var start xml.StartElement
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			start = tok
+		case xml.EndElement:
+			return nil
+		default:
+			continue
+		}
+
+		section := start.Name.Local
+		if parent != "" {
+			section = parent + "/" + section
+		}
+		field, ok := fields[start.Name.Local]
+		if ok && field.space != "" && field.space != start.Name.Space {
+			ok = false
+		}
+
+		if ok && pd.sections[section] {
+			err = pd.field(val.Field(field.index), start)
+		} else if ok && parent == "" && pd.hasChildSections(section) {
+			devices := val.Field(field.index)
+			if devices.IsNil() {
+				devices.Set(reflect.New(devices.Type().Elem()))
+			}
+			err = pd.children(devices.Elem(), section)
+		} else if ok && pd.keepRaw && domainPartialTypes[section] != nil {
+			// Keep each kind of device on its own, so that it can
+			// be loaded on its own later
+			err = pd.children(reflect.New(val.Field(field.index).Type().Elem()).Elem(), section)
+		} else {
+			err = pd.d.Skip()
+			if err == nil && pd.keepRaw {
+				raw := pd.doc[offset:pd.d.InputOffset()]
+				pd.partial.raw[section] = append(pd.partial.raw[section], raw)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (pd *domainPartialDecoder) hasChildSections(parent string) bool {
+	for section := range pd.sections {
+		if strings.HasPrefix(section, parent+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (pd *domainPartialDecoder) field(field reflect.Value, start xml.StartElement) error {
+	if field.Kind() == reflect.Slice {
+		elem := reflect.New(field.Type().Elem())
+		err := pd.d.DecodeElement(elem.Interface(), &start)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.Append(field, elem.Elem()))
+		return nil
+	}
+	return pd.d.DecodeElement(field.Addr().Interface(), &start)
+}
+
+// Sections returns the sections whose XML was kept rather than decoded
+func (p *DomainPartial) Sections() []string {
+	var sections []string
+	for section := range p.raw {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+// Raw returns the XML kept for a section, one string per element
+func (p *DomainPartial) Raw(section string) []string {
+	return p.raw[section]
+}
+
+// Load decodes kept sections into the domain. Naming "devices" loads
+// every kind of device which was kept. It fails for a section of which
+// no XML was kept.
+func (p *DomainPartial) Load(sections ...string) error {
+	var names []string
+	seen := make(map[string]bool)
+	for _, section := range sections {
+		found := false
+		for name := range p.raw {
+			if name == section || strings.HasPrefix(name, section+"/") {
+				found = true
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("No XML was kept for domain section '%s'", section)
+		}
+	}
+
+	var top, devices []string
+	for _, name := range names {
+		if strings.HasPrefix(name, "devices/") {
+			devices = append(devices, p.raw[name]...)
+		} else {
+			top = append(top, p.raw[name]...)
+		}
+	}
+
+	doc := "<domain" + p.rootNS + ">" + strings.Join(top, "")
+	if len(devices) > 0 {
+		doc += "<devices>" + strings.Join(devices, "") + "</devices>"
+	}
+	doc += "</domain>"
+
+	err := p.decode(doc, names, false)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		delete(p.raw, name)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var domainPartialSections = []string{"name", "uuid", "metadata", "devices/disk"}
+
+func domainPartialTestDocs() []string {
+	var docs []string
+	for _, test := range domainTestData {
+		if _, ok := test.Object.(*Domain); ok {
+			docs = append(docs, strings.Join(test.Expected, "\n"))
+		}
+	}
+	return docs
+}
+
+func TestDomainPartial(t *testing.T) {
+	for _, doc := range domainPartialTestDocs() {
+		full := &Domain{}
+		err := full.Unmarshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		partial, err := UnmarshalDomainPartial(doc, &DomainPartialOptions{
+			Sections: domainPartialSections,
+			KeepRaw:  true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dom := partial.Domain
+		if dom.Type != full.Type || dom.Name != full.Name || dom.UUID != full.UUID {
+			t.Fatalf("Wrong identity for domain %s", full.Name)
+		}
+		if (full.ID == nil) != (dom.ID == nil) || (full.ID != nil && *full.ID != *dom.ID) {
+			t.Fatalf("Wrong ID for domain %s", full.Name)
+		}
+		if !dom.Metadata.Equal(full.Metadata) {
+			t.Fatalf("Wrong metadata for domain %s", full.Name)
+		}
+		if dom.OS != nil || dom.Features != nil || dom.Memory != nil {
+			t.Fatalf("Unselected sections were decoded for domain %s", full.Name)
+		}
+		if full.Devices != nil {
+			if len(dom.Devices.Disks) != len(full.Devices.Disks) {
+				t.Fatalf("Wrong disks for domain %s", full.Name)
+			}
+			for i := range full.Devices.Disks {
+				if !dom.Devices.Disks[i].Equal(&full.Devices.Disks[i]) {
+					t.Fatalf("Wrong disk %d for domain %s", i, full.Name)
+				}
+			}
+			if len(dom.Devices.Interfaces) != 0 || dom.Devices.Emulator != "" {
+				t.Fatalf("Unselected devices were decoded for domain %s", full.Name)
+			}
+		}
+
+		for _, section := range partial.Sections() {
+			for _, selected := range domainPartialSections {
+				if section == selected {
+					t.Fatalf("Decoded section %s was kept", section)
+				}
+			}
+		}
+
+		keptDevices := false
+		for _, section := range partial.Sections() {
+			if strings.HasPrefix(section, "devices/") {
+				keptDevices = true
+			}
+		}
+		err = partial.Load("devices")
+		if keptDevices && err != nil {
+			t.Fatal(err)
+		} else if !keptDevices && err == nil {
+			t.Fatalf("Expected error loading devices for domain %s", full.Name)
+		}
+		for _, section := range partial.Sections() {
+			if strings.HasPrefix(section, "devices/") {
+				t.Fatalf("Section %s was not loaded", section)
+			}
+		}
+		err = partial.Load(partial.Sections()...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(partial.Sections()) != 0 {
+			t.Fatalf("Sections %v were not loaded", partial.Sections())
+		}
+
+		got, err := dom.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != doc {
+			t.Fatal("Bad xml:\n", got, "\n does not match\n", doc, "\n")
+		}
+	}
+}
+
+func TestDomainPartialSkip(t *testing.T) {
+	doc := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <interface type="network"></interface>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+
+	partial, err := UnmarshalDomainPartial(doc, &DomainPartialOptions{
+		Sections: []string{"uuid"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partial.Domain.Type != "kvm" || partial.Domain.Name != "" || partial.Domain.Devices != nil {
+		t.Fatal("Unexpected sections decoded")
+	}
+	if len(partial.Sections()) != 0 {
+		t.Fatalf("Unexpected sections %v kept", partial.Sections())
+	}
+
+	_, err = UnmarshalDomainPartial(doc, &DomainPartialOptions{
+		Sections: []string{"devices/nonsense"},
+	})
+	if err == nil {
+		t.Fatal("Expected error for unknown section")
+	}
+
+	_, err = UnmarshalDomainPartial("<network></network>", &DomainPartialOptions{})
+	if err == nil {
+		t.Fatal("Expected error for non-domain document")
+	}
+
+	partial, err = UnmarshalDomainPartial(doc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partial.Domain.Type != "kvm" || partial.Domain.Name != "" {
+		t.Fatal("Unexpected sections decoded without options")
+	}
+}
+
+func TestDomainPartialLoadDevices(t *testing.T) {
+	doc := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk">`,
+		`      <target dev="vda" bus="virtio"></target>`,
+		`    </disk>`,
+		`    <interface type="network"></interface>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+
+	partial, err := UnmarshalDomainPartial(doc, &DomainPartialOptions{
+		Sections: []string{"name"},
+		KeepRaw:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partial.Domain.Devices != nil {
+		t.Fatal("Unselected devices were decoded")
+	}
+	sections := strings.Join(partial.Sections(), ",")
+	if sections != "devices/disk,devices/interface" {
+		t.Fatalf("Unexpected sections %s kept", sections)
+	}
+
+	err = partial.Load("devices/disk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dom := partial.Domain
+	if dom.Devices == nil || len(dom.Devices.Disks) != 1 || dom.Devices.Disks[0].Target.Dev != "vda" {
+		t.Fatal("Disk was not loaded")
+	}
+	if len(dom.Devices.Interfaces) != 0 {
+		t.Fatal("Interface was loaded too")
+	}
+
+	err = partial.Load("devices/disk")
+	if err == nil {
+		t.Fatal("Expected error loading a section twice")
+	}
+	err = partial.Load("memory")
+	if err == nil {
+		t.Fatal("Expected error loading a section which was not kept")
+	}
+
+	err = partial.Load("devices")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dom.Devices.Interfaces) != 1 || len(dom.Devices.Disks) != 1 {
+		t.Fatal("Devices were not loaded")
+	}
+}
+
+func BenchmarkDomainPartialFull(b *testing.B) {
+	docs := domainPartialTestDocs()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, doc := range docs {
+			dom := &Domain{}
+			err := dom.Unmarshal(doc)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkDomainPartialSkip(b *testing.B) {
+	docs := domainPartialTestDocs()
+	opts := &DomainPartialOptions{Sections: domainPartialSections}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, doc := range docs {
+			_, err := UnmarshalDomainPartial(doc, opts)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkDomainPartialKeepRaw(b *testing.B) {
+	docs := domainPartialTestDocs()
+	opts := &DomainPartialOptions{Sections: domainPartialSections, KeepRaw: true}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, doc := range docs {
+			_, err := UnmarshalDomainPartial(doc, opts)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DomainSnapshotPlan describes how a snapshot of a domain should be
+// taken. Build turns it into a DomainSnapshot document which lists
+// every disk of the domain.
+type DomainSnapshotPlan struct {
+	Name        string
+	Description string
+
+	// Snapshot mode for disks which do not request one themselves,
+	// either "external" (the default) or "internal"
+	DiskMode string
+
+	// Memory snapshot mode, one of "no" (the default), "internal"
+	// or "external". The latter requires MemoryFile.
+	Memory     string
+	MemoryFile string
+
+	// OverlayName returns the path of the external overlay for a
+	// disk. When nil, or when it returns an empty string, the name
+	// is derived from the disk's source file the same way libvirt
+	// does, by replacing the file extension with the snapshot name.
+	OverlayName func(disk *DomainDisk, snapshot string) string
+}
+
+// DomainSnapshotPlanSkip records a disk which was left out of the
+// snapshot even though it holds writable data.
+type DomainSnapshotPlanSkip struct {
+	Disk   string
+	Reason string
+}
+
+func domainSnapshotDiskName(disk *DomainDisk, idx int) string {
+	if disk.Target != nil && disk.Target.Dev != "" {
+		return disk.Target.Dev
+	}
+	if disk.Source != nil {
+		if disk.Source.File != nil && disk.Source.File.File != "" {
+			return disk.Source.File.File
+		}
+		if disk.Source.Block != nil && disk.Source.Block.Dev != "" {
+			return disk.Source.Block.Dev
+		}
+	}
+	return fmt.Sprintf("disk%d", idx)
+}
+
+func domainSnapshotDefaultOverlay(disk *DomainDisk, snapshot string) string {
+	if disk.Source == nil || disk.Source.File == nil || disk.Source.File.File == "" {
+		return ""
+	}
+	path := disk.Source.File.File
+	dot := strings.LastIndex(path, ".")
+	if dot != -1 && !strings.Contains(path[dot:], "/") {
+		return path[:dot] + "." + snapshot
+	}
+	return path + "." + snapshot
+}
+
+func (p *DomainSnapshotPlan) overlay(disk *DomainDisk) string {
+	if p.OverlayName != nil {
+		path := p.OverlayName(disk, p.Name)
+		if path != "" {
+			return path
+		}
+	}
+	if p.Name == "" {
+		return ""
+	}
+	return domainSnapshotDefaultOverlay(disk, p.Name)
+}
+
+func (p *DomainSnapshotPlan) planDisk(disk *DomainDisk, name string) (DomainSnapshotDisk, string) {
+	snap := DomainSnapshotDisk{
+		Name:     name,
+		Snapshot: "no",
+	}
+
+	if disk.Source == nil ||
+		(disk.Source.File == nil && disk.Source.Block == nil &&
+			disk.Source.Dir == nil && disk.Source.Network == nil &&
+			disk.Source.Volume == nil) {
+		return snap, ""
+	}
+
+	mode := disk.Snapshot
+	if mode == "" {
+		if disk.ReadOnly != nil || disk.Device == "cdrom" || disk.Device == "floppy" {
+			return snap, ""
+		}
+		if disk.Shareable != nil {
+			return snap, "shareable disks cannot be snapshotted"
+		}
+		mode = p.DiskMode
+		if mode == "" {
+			mode = "external"
+		}
+	}
+
+	switch mode {
+	case "no":
+		return snap, ""
+	case "internal":
+		if disk.Source.Network != nil {
+			proto := disk.Source.Network.Protocol
+			if proto != "rbd" && proto != "sheepdog" {
+				return snap, fmt.Sprintf("internal snapshots are not supported on '%s' network disks", proto)
+			}
+		} else if disk.Source.Dir != nil {
+			return snap, "internal snapshots are not supported on directory disks"
+		} else if disk.Driver == nil || disk.Driver.Type != "qcow2" {
+			format := "raw"
+			if disk.Driver != nil && disk.Driver.Type != "" {
+				format = disk.Driver.Type
+			}
+			return snap, fmt.Sprintf("internal snapshots require qcow2, disk format is '%s'", format)
+		}
+		snap.Snapshot = "internal"
+		return snap, ""
+	case "external":
+		if disk.Source.Dir != nil {
+			return snap, "external snapshots are not supported on directory disks"
+		}
+		path := p.overlay(disk)
+		if path == "" {
+			if disk.Source.Network != nil {
+				return snap, "no overlay name for network disk"
+			}
+			if disk.Source.File == nil {
+				return snap, "no overlay name for non-file disk"
+			}
+			return snap, "no overlay name without a snapshot name"
+		}
+		snap.Snapshot = "external"
+		snap.Driver = &DomainSnapshotDiskDriver{
+			Type: "qcow2",
+		}
+		snap.Source = &DomainDiskSource{
+			File: &DomainDiskSourceFile{
+				File: path,
+			},
+		}
+		return snap, ""
+	default:
+		return snap, fmt.Sprintf("unknown snapshot mode '%s'", mode)
+	}
+}
+
+// Build generates the snapshot document for dom. Writable disks which
+// cannot be snapshotted with the requested mode are excluded from the
+// snapshot and reported, while conflicting modes are an error.
+func (p *DomainSnapshotPlan) Build(dom *Domain) (*DomainSnapshot, []DomainSnapshotPlanSkip, error) {
+	if p.DiskMode != "" && p.DiskMode != "internal" && p.DiskMode != "external" {
+		return nil, nil, fmt.Errorf("Unknown disk snapshot mode '%s'", p.DiskMode)
+	}
+
+	snapshot := &DomainSnapshot{
+		Name:        p.Name,
+		Description: p.Description,
+	}
+
+	switch p.Memory {
+	case "", "no":
+		snapshot.Memory = &DomainSnapshotMemory{
+			Snapshot: "no",
+		}
+	case "internal":
+		snapshot.Memory = &DomainSnapshotMemory{
+			Snapshot: "internal",
+		}
+	case "external":
+		if p.MemoryFile == "" {
+			return nil, nil, fmt.Errorf("External memory snapshot requires a memory file")
+		}
+		snapshot.Memory = &DomainSnapshotMemory{
+			Snapshot: "external",
+			File:     p.MemoryFile,
+		}
+	default:
+		return nil, nil, fmt.Errorf("Unknown memory snapshot mode '%s'", p.Memory)
+	}
+
+	var skipped []DomainSnapshotPlanSkip
+	internal := 0
+	external := 0
+	if dom.Devices != nil && len(dom.Devices.Disks) > 0 {
+		snapshot.Disks = &DomainSnapshotDisks{}
+		for i := range dom.Devices.Disks {
+			disk := &dom.Devices.Disks[i]
+			name := domainSnapshotDiskName(disk, i)
+			snap, reason := p.planDisk(disk, name)
+			if reason != "" {
+				skipped = append(skipped, DomainSnapshotPlanSkip{
+					Disk:   name,
+					Reason: reason,
+				})
+			}
+			if snap.Snapshot == "internal" {
+				internal++
+			} else if snap.Snapshot == "external" {
+				external++
+			}
+			snapshot.Disks.Disks = append(snapshot.Disks.Disks, snap)
+		}
+	}
+
+	if internal != 0 && external != 0 {
+		return nil, skipped, fmt.Errorf("Mixing internal and external disk snapshots is not supported")
+	}
+	if snapshot.Memory.Snapshot == "internal" && external != 0 {
+		return nil, skipped, fmt.Errorf("Internal memory snapshot requires internal disk snapshots")
+	}
+	if snapshot.Memory.Snapshot == "external" && internal != 0 {
+		return nil, skipped, fmt.Errorf("External memory snapshot requires external disk snapshots")
+	}
+
+	return snapshot, skipped, nil
+}
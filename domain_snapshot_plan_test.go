@@ -0,0 +1,235 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var domainSnapshotPlanDomain = &Domain{
+	Name: "demo",
+	Devices: &DomainDeviceList{
+		Disks: []DomainDisk{
+			DomainDisk{
+				Device: "disk",
+				Driver: &DomainDiskDriver{
+					Type: "qcow2",
+				},
+				Source: &DomainDiskSource{
+					File: &DomainDiskSourceFile{
+						File: "/var/lib/libvirt/images/demo.qcow2",
+					},
+				},
+				Target: &DomainDiskTarget{
+					Dev: "vda",
+				},
+			},
+			DomainDisk{
+				Device: "disk",
+				Driver: &DomainDiskDriver{
+					Type: "raw",
+				},
+				Source: &DomainDiskSource{
+					Block: &DomainDiskSourceBlock{
+						Dev: "/dev/sdb",
+					},
+				},
+				Target: &DomainDiskTarget{
+					Dev: "vdb",
+				},
+			},
+			DomainDisk{
+				Device: "disk",
+				Source: &DomainDiskSource{
+					Network: &DomainDiskSourceNetwork{
+						Protocol: "nbd",
+						Name:     "export",
+					},
+				},
+				Target: &DomainDiskTarget{
+					Dev: "vdc",
+				},
+			},
+			DomainDisk{
+				Device: "cdrom",
+				Source: &DomainDiskSource{
+					File: &DomainDiskSourceFile{
+						File: "/srv/install.iso",
+					},
+				},
+				Target: &DomainDiskTarget{
+					Dev: "hda",
+				},
+				ReadOnly: &DomainDiskReadOnly{},
+			},
+			DomainDisk{
+				Device: "disk",
+				Source: &DomainDiskSource{
+					File: &DomainDiskSourceFile{
+						File: "/srv/shared.img",
+					},
+				},
+				Target: &DomainDiskTarget{
+					Dev: "vdd",
+				},
+				Shareable: &DomainDiskShareable{},
+			},
+		},
+	},
+}
+
+var domainSnapshotPlanTestData = []struct {
+	Plan     *DomainSnapshotPlan
+	Skipped  []DomainSnapshotPlanSkip
+	Expected []string
+}{
+	{
+		Plan: &DomainSnapshotPlan{
+			Name:       "snap1",
+			Memory:     "external",
+			MemoryFile: "/var/lib/libvirt/images/demo.mem",
+			OverlayName: func(disk *DomainDisk, snapshot string) string {
+				if disk.Source.Block != nil {
+					return "/var/lib/libvirt/images/vdb." + snapshot
+				}
+				return ""
+			},
+		},
+		Skipped: []DomainSnapshotPlanSkip{
+			DomainSnapshotPlanSkip{
+				Disk:   "vdc",
+				Reason: "no overlay name for network disk",
+			},
+			DomainSnapshotPlanSkip{
+				Disk:   "vdd",
+				Reason: "shareable disks cannot be snapshotted",
+			},
+		},
+		Expected: []string{
+			`<domainsnapshot>`,
+			`  <name>snap1</name>`,
+			`  <memory snapshot="external" file="/var/lib/libvirt/images/demo.mem"></memory>`,
+			`  <disks>`,
+			`    <disk type="file" name="vda" snapshot="external">`,
+			`      <driver type="qcow2"></driver>`,
+			`      <source file="/var/lib/libvirt/images/demo.snap1"></source>`,
+			`    </disk>`,
+			`    <disk type="file" name="vdb" snapshot="external">`,
+			`      <driver type="qcow2"></driver>`,
+			`      <source file="/var/lib/libvirt/images/vdb.snap1"></source>`,
+			`    </disk>`,
+			`    <disk name="vdc" snapshot="no"></disk>`,
+			`    <disk name="hda" snapshot="no"></disk>`,
+			`    <disk name="vdd" snapshot="no"></disk>`,
+			`  </disks>`,
+			`</domainsnapshot>`,
+		},
+	},
+	{
+		Plan: &DomainSnapshotPlan{
+			Name:     "snap2",
+			DiskMode: "internal",
+			Memory:   "internal",
+		},
+		Skipped: []DomainSnapshotPlanSkip{
+			DomainSnapshotPlanSkip{
+				Disk:   "vdb",
+				Reason: "internal snapshots require qcow2, disk format is 'raw'",
+			},
+			DomainSnapshotPlanSkip{
+				Disk:   "vdc",
+				Reason: "internal snapshots are not supported on 'nbd' network disks",
+			},
+			DomainSnapshotPlanSkip{
+				Disk:   "vdd",
+				Reason: "shareable disks cannot be snapshotted",
+			},
+		},
+		Expected: []string{
+			`<domainsnapshot>`,
+			`  <name>snap2</name>`,
+			`  <memory snapshot="internal"></memory>`,
+			`  <disks>`,
+			`    <disk name="vda" snapshot="internal"></disk>`,
+			`    <disk name="vdb" snapshot="no"></disk>`,
+			`    <disk name="vdc" snapshot="no"></disk>`,
+			`    <disk name="hda" snapshot="no"></disk>`,
+			`    <disk name="vdd" snapshot="no"></disk>`,
+			`  </disks>`,
+			`</domainsnapshot>`,
+		},
+	},
+}
+
+func TestDomainSnapshotPlan(t *testing.T) {
+	for _, test := range domainSnapshotPlanTestData {
+		snapshot, skipped, err := test.Plan.Build(domainSnapshotPlanDomain)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(skipped, test.Skipped) {
+			t.Fatal("Bad skipped disks:\n", skipped, "\n does not match\n", test.Skipped)
+		}
+
+		doc, err := snapshot.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := strings.Join(test.Expected, "\n")
+
+		if doc != expect {
+			t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestDomainSnapshotPlanConflict(t *testing.T) {
+	plans := []*DomainSnapshotPlan{
+		&DomainSnapshotPlan{
+			Name:   "snap",
+			Memory: "internal",
+		},
+		&DomainSnapshotPlan{
+			Name:   "snap",
+			Memory: "external",
+		},
+		&DomainSnapshotPlan{
+			Name:     "snap",
+			DiskMode: "bogus",
+		},
+	}
+
+	for _, plan := range plans {
+		_, _, err := plan.Build(domainSnapshotPlanDomain)
+		if err == nil {
+			t.Fatal("Expected error for plan", plan)
+		}
+	}
+}
@@ -0,0 +1,615 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DomainSysInfoHost builds a domain's SMBIOS system information from
+// the data of the host
+type DomainSysInfoHost struct {
+	// Directory with the DMI attributes exported by the kernel,
+	// usually "/sys/class/dmi/id". It only provides the BIOS, system,
+	// base board and chassis sections.
+	DMIDir string
+	// Raw SMBIOS data, used instead of DMIDir when set. It is either
+	// a bare structure table such as /sys/firmware/dmi/tables/DMI, or
+	// an entry point followed by the table as written by
+	// "dmidecode --dump-bin".
+	SMBIOS []byte
+	// Fields to copy from the host, such as "system.uuid" or
+	// "baseBoard.serial", with "oemStrings" selecting all OEM strings.
+	// Guests whose licenses are bound to the host hardware only need a
+	// few of them. All fields are copied when empty. Note libvirt
+	// requires a copied system.uuid to match the domain's UUID.
+	Fields []string
+}
+
+type sysInfoField struct {
+	name  string
+	value string
+}
+
+type sysInfoData struct {
+	bios       []sysInfoField
+	system     []sysInfoField
+	baseBoards [][]sysInfoField
+	chassis    []sysInfoField
+	processors [][]sysInfoField
+	memory     [][]sysInfoField
+	oemStrings []string
+}
+
+var sysInfoDMIFiles = []struct {
+	section string
+	name    string
+	file    string
+}{
+	{"bios", "vendor", "bios_vendor"},
+	{"bios", "version", "bios_version"},
+	{"bios", "date", "bios_date"},
+	{"bios", "release", "bios_release"},
+	{"system", "manufacturer", "sys_vendor"},
+	{"system", "product", "product_name"},
+	{"system", "version", "product_version"},
+	{"system", "serial", "product_serial"},
+	{"system", "uuid", "product_uuid"},
+	{"system", "sku", "product_sku"},
+	{"system", "family", "product_family"},
+	{"baseBoard", "manufacturer", "board_vendor"},
+	{"baseBoard", "product", "board_name"},
+	{"baseBoard", "version", "board_version"},
+	{"baseBoard", "serial", "board_serial"},
+	{"baseBoard", "asset", "board_asset_tag"},
+	{"chassis", "manufacturer", "chassis_vendor"},
+	{"chassis", "version", "chassis_version"},
+	{"chassis", "serial", "chassis_serial"},
+	{"chassis", "asset", "chassis_asset_tag"},
+}
+
+var smbiosProcessorTypes = map[byte]string{
+	1: "Other",
+	2: "Unknown",
+	3: "Central Processor",
+	4: "Math Processor",
+	5: "DSP Processor",
+	6: "Video Processor",
+}
+
+var smbiosProcessorStatus = map[byte]string{
+	0: "Unknown",
+	1: "Enabled",
+	2: "Disabled By User",
+	3: "Disabled By BIOS",
+	4: "Idle",
+	7: "Other",
+}
+
+var smbiosMemoryFormFactors = map[byte]string{
+	1:  "Other",
+	2:  "Unknown",
+	3:  "SIMM",
+	4:  "SIP",
+	5:  "Chip",
+	6:  "DIP",
+	7:  "ZIP",
+	8:  "Proprietary Card",
+	9:  "DIMM",
+	10: "TSOP",
+	11: "Row Of Chips",
+	12: "RIMM",
+	13: "SODIMM",
+	14: "SRIMM",
+	15: "FB-DIMM",
+}
+
+var smbiosMemoryTypes = map[byte]string{
+	1:  "Other",
+	2:  "Unknown",
+	3:  "DRAM",
+	7:  "RAM",
+	15: "SDRAM",
+	18: "DDR",
+	19: "DDR2",
+	20: "DDR2 FB-DIMM",
+	24: "DDR3",
+	26: "DDR4",
+	27: "LPDDR",
+	28: "LPDDR2",
+	29: "LPDDR3",
+	30: "LPDDR4",
+}
+
+var smbiosMemoryTypeDetails = []string{
+	1:  "Other",
+	2:  "Unknown",
+	3:  "Fast-paged",
+	4:  "Static Column",
+	5:  "Pseudo-static",
+	6:  "RAMBus",
+	7:  "Synchronous",
+	8:  "CMOS",
+	9:  "EDO",
+	10: "Window DRAM",
+	11: "Cache DRAM",
+	12: "Non-Volatile",
+	13: "Registered (Buffered)",
+	14: "Unbuffered (Unregistered)",
+	15: "LRDIMM",
+}
+
+func sysInfoAppend(fields []sysInfoField, name, value string) []sysInfoField {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return fields
+	}
+	return append(fields, sysInfoField{
+		name:  name,
+		value: value,
+	})
+}
+
+func readSysInfoDMIDir(dir string) (*sysInfoData, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	data := &sysInfoData{}
+	var board, chassis []sysInfoField
+	for _, file := range sysInfoDMIFiles {
+		// Some attributes, such as serial numbers, are only readable
+		// by root and are left out otherwise
+		value, err := ioutil.ReadFile(filepath.Join(dir, file.file))
+		if err != nil {
+			continue
+		}
+		switch file.section {
+		case "bios":
+			data.bios = sysInfoAppend(data.bios, file.name, string(value))
+		case "system":
+			data.system = sysInfoAppend(data.system, file.name, string(value))
+		case "baseBoard":
+			board = sysInfoAppend(board, file.name, string(value))
+		case "chassis":
+			chassis = sysInfoAppend(chassis, file.name, string(value))
+		}
+	}
+	if board != nil {
+		data.baseBoards = append(data.baseBoards, board)
+	}
+	data.chassis = chassis
+	return data, nil
+}
+
+type smbiosStructure struct {
+	typ       byte
+	formatted []byte
+	strings   []string
+}
+
+func (s *smbiosStructure) byteAt(offset int) (byte, bool) {
+	if offset >= len(s.formatted) {
+		return 0, false
+	}
+	return s.formatted[offset], true
+}
+
+func (s *smbiosStructure) wordAt(offset int) (uint16, bool) {
+	if offset+2 > len(s.formatted) {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint16(s.formatted[offset:]), true
+}
+
+func (s *smbiosStructure) dwordAt(offset int) (uint32, bool) {
+	if offset+4 > len(s.formatted) {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(s.formatted[offset:]), true
+}
+
+func (s *smbiosStructure) stringAt(offset int) string {
+	idx, ok := s.byteAt(offset)
+	if !ok || idx == 0 || int(idx) > len(s.strings) {
+		return ""
+	}
+	return s.strings[idx-1]
+}
+
+func smbiosChecksum(data []byte) bool {
+	sum := byte(0)
+	for _, b := range data {
+		sum += b
+	}
+	return sum == 0
+}
+
+// smbiosTable finds the structure table in data, which may start
+// with an entry point
+func smbiosTable(data []byte) ([]byte, error) {
+	var offset, length uint64
+	if len(data) >= 0x1f && string(data[0:4]) == "_SM_" {
+		eplen := int(data[5])
+		if eplen > len(data) || !smbiosChecksum(data[:eplen]) {
+			return nil, fmt.Errorf("Invalid SMBIOS entry point checksum")
+		}
+		length = uint64(binary.LittleEndian.Uint16(data[0x16:]))
+		offset = uint64(binary.LittleEndian.Uint32(data[0x18:]))
+	} else if len(data) >= 0x18 && string(data[0:5]) == "_SM3_" {
+		eplen := int(data[6])
+		if eplen > len(data) || !smbiosChecksum(data[:eplen]) {
+			return nil, fmt.Errorf("Invalid SMBIOS entry point checksum")
+		}
+		length = uint64(binary.LittleEndian.Uint32(data[0x0c:]))
+		offset = binary.LittleEndian.Uint64(data[0x10:])
+	} else {
+		return data, nil
+	}
+
+	if offset >= uint64(len(data)) {
+		return nil, fmt.Errorf("SMBIOS table at 0x%x is not part of the data", offset)
+	}
+	// For 64-bit entry points the length is only an upper bound
+	if offset+length > uint64(len(data)) {
+		length = uint64(len(data)) - offset
+	}
+	return data[offset : offset+length], nil
+}
+
+func parseSMBIOSStructures(table []byte) ([]smbiosStructure, error) {
+	var structures []smbiosStructure
+	for len(table) >= 4 {
+		typ := table[0]
+		length := int(table[1])
+		if length < 4 || length > len(table) {
+			return nil, fmt.Errorf("Truncated SMBIOS structure of type %d", typ)
+		}
+		s := smbiosStructure{
+			typ:       typ,
+			formatted: table[:length],
+		}
+
+		// The string set ends with two NUL bytes
+		rest := table[length:]
+		end := -1
+		for i := 0; i+1 < len(rest); i++ {
+			if rest[i] == 0 && rest[i+1] == 0 {
+				end = i
+				break
+			}
+		}
+		if end == -1 {
+			return nil, fmt.Errorf("Unterminated strings in SMBIOS structure of type %d", typ)
+		}
+		if end > 0 {
+			s.strings = strings.Split(string(rest[:end]), "\x00")
+		}
+		structures = append(structures, s)
+		table = rest[end+2:]
+
+		if typ == 127 {
+			break
+		}
+	}
+	return structures, nil
+}
+
+func smbiosUUID(s *smbiosStructure, offset int) string {
+	if offset+16 > len(s.formatted) {
+		return ""
+	}
+	raw := s.formatted[offset : offset+16]
+	zero, ones := true, true
+	for _, b := range raw {
+		if b != 0 {
+			zero = false
+		}
+		if b != 0xff {
+			ones = false
+		}
+	}
+	if zero || ones {
+		return ""
+	}
+	// The first three fields are little endian since SMBIOS 2.6
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(raw[0:]),
+		binary.LittleEndian.Uint16(raw[4:]),
+		binary.LittleEndian.Uint16(raw[6:]),
+		raw[8:10], raw[10:16])
+}
+
+func smbiosBIOS(s *smbiosStructure) []sysInfoField {
+	var fields []sysInfoField
+	fields = sysInfoAppend(fields, "vendor", s.stringAt(0x04))
+	fields = sysInfoAppend(fields, "version", s.stringAt(0x05))
+	fields = sysInfoAppend(fields, "date", s.stringAt(0x08))
+	major, ok1 := s.byteAt(0x14)
+	minor, ok2 := s.byteAt(0x15)
+	if ok1 && ok2 && major != 0xff && minor != 0xff {
+		fields = sysInfoAppend(fields, "release", fmt.Sprintf("%d.%d", major, minor))
+	}
+	return fields
+}
+
+func smbiosSystem(s *smbiosStructure) []sysInfoField {
+	var fields []sysInfoField
+	fields = sysInfoAppend(fields, "manufacturer", s.stringAt(0x04))
+	fields = sysInfoAppend(fields, "product", s.stringAt(0x05))
+	fields = sysInfoAppend(fields, "version", s.stringAt(0x06))
+	fields = sysInfoAppend(fields, "serial", s.stringAt(0x07))
+	fields = sysInfoAppend(fields, "uuid", smbiosUUID(s, 0x08))
+	fields = sysInfoAppend(fields, "sku", s.stringAt(0x19))
+	fields = sysInfoAppend(fields, "family", s.stringAt(0x1a))
+	return fields
+}
+
+func smbiosBaseBoard(s *smbiosStructure) []sysInfoField {
+	var fields []sysInfoField
+	fields = sysInfoAppend(fields, "manufacturer", s.stringAt(0x04))
+	fields = sysInfoAppend(fields, "product", s.stringAt(0x05))
+	fields = sysInfoAppend(fields, "version", s.stringAt(0x06))
+	fields = sysInfoAppend(fields, "serial", s.stringAt(0x07))
+	fields = sysInfoAppend(fields, "asset", s.stringAt(0x08))
+	fields = sysInfoAppend(fields, "location", s.stringAt(0x0a))
+	return fields
+}
+
+func smbiosChassis(s *smbiosStructure) []sysInfoField {
+	var fields []sysInfoField
+	fields = sysInfoAppend(fields, "manufacturer", s.stringAt(0x04))
+	fields = sysInfoAppend(fields, "version", s.stringAt(0x06))
+	fields = sysInfoAppend(fields, "serial", s.stringAt(0x07))
+	fields = sysInfoAppend(fields, "asset", s.stringAt(0x08))
+	// The SKU follows the variable sized contained element records
+	count, ok1 := s.byteAt(0x13)
+	size, ok2 := s.byteAt(0x14)
+	if ok1 && ok2 {
+		fields = sysInfoAppend(fields, "sku", s.stringAt(0x15+int(count)*int(size)))
+	}
+	return fields
+}
+
+func smbiosProcessor(s *smbiosStructure) []sysInfoField {
+	var fields []sysInfoField
+	fields = sysInfoAppend(fields, "socket_destination", s.stringAt(0x04))
+	if typ, ok := s.byteAt(0x05); ok {
+		fields = sysInfoAppend(fields, "type", smbiosProcessorTypes[typ])
+	}
+	fields = sysInfoAppend(fields, "manufacturer", s.stringAt(0x07))
+	if eax, ok := s.dwordAt(0x08); ok && eax != 0 {
+		family := (eax >> 8) & 0xf
+		model := (eax >> 4) & 0xf
+		if family == 0xf {
+			family += (eax >> 20) & 0xff
+		}
+		if family == 0x6 || family >= 0xf {
+			model += ((eax >> 16) & 0xf) << 4
+		}
+		fields = sysInfoAppend(fields, "signature", fmt.Sprintf("Type %d, Family %d, Model %d, Stepping %d",
+			(eax>>12)&0x3, family, model, eax&0xf))
+	}
+	fields = sysInfoAppend(fields, "version", s.stringAt(0x10))
+	if clock, ok := s.wordAt(0x12); ok && clock != 0 {
+		fields = sysInfoAppend(fields, "external_clock", fmt.Sprintf("%d MHz", clock))
+	}
+	if speed, ok := s.wordAt(0x14); ok && speed != 0 {
+		fields = sysInfoAppend(fields, "max_speed", fmt.Sprintf("%d MHz", speed))
+	}
+	if status, ok := s.byteAt(0x18); ok {
+		if status&0x40 == 0 {
+			fields = sysInfoAppend(fields, "status", "Unpopulated")
+		} else if desc, ok := smbiosProcessorStatus[status&0x7]; ok {
+			fields = sysInfoAppend(fields, "status", "Populated, "+desc)
+		}
+	}
+	fields = sysInfoAppend(fields, "serial_number", s.stringAt(0x20))
+	fields = sysInfoAppend(fields, "part_number", s.stringAt(0x22))
+	return fields
+}
+
+func smbiosMemory(s *smbiosStructure) []sysInfoField {
+	size, ok := s.wordAt(0x0c)
+	if !ok || size == 0 {
+		// No module is installed
+		return nil
+	}
+
+	var fields []sysInfoField
+	if size == 0x7fff {
+		if ext, ok := s.dwordAt(0x1c); ok {
+			fields = sysInfoAppend(fields, "size", fmt.Sprintf("%d MB", ext&0x7fffffff))
+		}
+	} else if size != 0xffff {
+		if size&0x8000 != 0 {
+			fields = sysInfoAppend(fields, "size", fmt.Sprintf("%d kB", size&0x7fff))
+		} else {
+			fields = sysInfoAppend(fields, "size", fmt.Sprintf("%d MB", size))
+		}
+	}
+	if form, ok := s.byteAt(0x0e); ok {
+		fields = sysInfoAppend(fields, "form_factor", smbiosMemoryFormFactors[form])
+	}
+	fields = sysInfoAppend(fields, "locator", s.stringAt(0x10))
+	fields = sysInfoAppend(fields, "bank_locator", s.stringAt(0x11))
+	if typ, ok := s.byteAt(0x12); ok {
+		fields = sysInfoAppend(fields, "type", smbiosMemoryTypes[typ])
+	}
+	if detail, ok := s.wordAt(0x13); ok {
+		var details []string
+		for bit, name := range smbiosMemoryTypeDetails {
+			if name != "" && detail&(1<<uint(bit)) != 0 {
+				details = append(details, name)
+			}
+		}
+		fields = sysInfoAppend(fields, "type_detail", strings.Join(details, " "))
+	}
+	if speed, ok := s.wordAt(0x15); ok && speed != 0 {
+		fields = sysInfoAppend(fields, "speed", fmt.Sprintf("%d MT/s", speed))
+	}
+	fields = sysInfoAppend(fields, "manufacturer", s.stringAt(0x17))
+	fields = sysInfoAppend(fields, "serial_number", s.stringAt(0x18))
+	fields = sysInfoAppend(fields, "part_number", s.stringAt(0x1a))
+	return fields
+}
+
+func readSysInfoSMBIOS(raw []byte) (*sysInfoData, error) {
+	table, err := smbiosTable(raw)
+	if err != nil {
+		return nil, err
+	}
+	structures, err := parseSMBIOSStructures(table)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &sysInfoData{}
+	for i := range structures {
+		s := &structures[i]
+		switch s.typ {
+		case 0:
+			if data.bios == nil {
+				data.bios = smbiosBIOS(s)
+			}
+		case 1:
+			if data.system == nil {
+				data.system = smbiosSystem(s)
+			}
+		case 2:
+			if fields := smbiosBaseBoard(s); fields != nil {
+				data.baseBoards = append(data.baseBoards, fields)
+			}
+		case 3:
+			if data.chassis == nil {
+				data.chassis = smbiosChassis(s)
+			}
+		case 4:
+			if fields := smbiosProcessor(s); fields != nil {
+				data.processors = append(data.processors, fields)
+			}
+		case 11:
+			data.oemStrings = append(data.oemStrings, s.strings...)
+		case 17:
+			if fields := smbiosMemory(s); fields != nil {
+				data.memory = append(data.memory, fields)
+			}
+		}
+	}
+	return data, nil
+}
+
+func sysInfoEntries(section string, fields []sysInfoField, selected map[string]bool) []DomainSysInfoEntry {
+	var entries []DomainSysInfoEntry
+	for _, field := range fields {
+		if selected != nil && !selected[section+"."+field.name] {
+			continue
+		}
+		entries = append(entries, DomainSysInfoEntry{
+			Name:  field.name,
+			Value: field.value,
+		})
+	}
+	return entries
+}
+
+// SysInfo returns the system information of the host along with the
+// SMBIOS setting making the guest use it
+func (h *DomainSysInfoHost) SysInfo() (*DomainSysInfo, *DomainSMBios, error) {
+	var data *sysInfoData
+	var err error
+	if h.SMBIOS != nil {
+		data, err = readSysInfoSMBIOS(h.SMBIOS)
+	} else {
+		dir := h.DMIDir
+		if dir == "" {
+			dir = "/sys/class/dmi/id"
+		}
+		data, err = readSysInfoDMIDir(dir)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var selected map[string]bool
+	if len(h.Fields) != 0 {
+		selected = make(map[string]bool)
+		for _, field := range h.Fields {
+			selected[field] = true
+		}
+	}
+
+	sysinfo := &DomainSysInfo{
+		Type: "smbios",
+	}
+	if entries := sysInfoEntries("bios", data.bios, selected); entries != nil {
+		sysinfo.BIOS = &DomainSysInfoBIOS{
+			Entry: entries,
+		}
+	}
+	if entries := sysInfoEntries("system", data.system, selected); entries != nil {
+		sysinfo.System = &DomainSysInfoSystem{
+			Entry: entries,
+		}
+	}
+	for _, board := range data.baseBoards {
+		if entries := sysInfoEntries("baseBoard", board, selected); entries != nil {
+			sysinfo.BaseBoard = append(sysinfo.BaseBoard, DomainSysInfoBaseBoard{
+				Entry: entries,
+			})
+		}
+	}
+	if entries := sysInfoEntries("chassis", data.chassis, selected); entries != nil {
+		sysinfo.Chassis = &DomainSysInfoChassis{
+			Entry: entries,
+		}
+	}
+	for _, processor := range data.processors {
+		if entries := sysInfoEntries("processor", processor, selected); entries != nil {
+			sysinfo.Processor = append(sysinfo.Processor, DomainSysInfoProcessor{
+				Entry: entries,
+			})
+		}
+	}
+	for _, memory := range data.memory {
+		if entries := sysInfoEntries("memory", memory, selected); entries != nil {
+			sysinfo.Memory = append(sysinfo.Memory, DomainSysInfoMemory{
+				Entry: entries,
+			})
+		}
+	}
+	if len(data.oemStrings) != 0 && (selected == nil || selected["oemStrings"]) {
+		sysinfo.OEMStrings = &DomainSysInfoOEMStrings{
+			Entry: data.oemStrings,
+		}
+	}
+
+	return sysinfo, &DomainSMBios{Mode: "sysinfo"}, nil
+}
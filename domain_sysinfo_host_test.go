@@ -0,0 +1,224 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+var sysInfoHostSMBIOSXML = []string{
+	`<domain>`,
+	`  <sysinfo type="smbios">`,
+	`    <bios>`,
+	`      <entry name="vendor">SeaBIOS</entry>`,
+	`      <entry name="version">1.11.0-2.el7</entry>`,
+	`      <entry name="date">04/01/2014</entry>`,
+	`      <entry name="release">0.0</entry>`,
+	`    </bios>`,
+	`    <system>`,
+	`      <entry name="manufacturer">Red Hat</entry>`,
+	`      <entry name="product">KVM</entry>`,
+	`      <entry name="version">RHEL 7.6.0 PC (i440FX + PIIX, 1996)</entry>`,
+	`      <entry name="serial">0000-0000-0000</entry>`,
+	`      <entry name="uuid">c7a5fdbd-edaf-9455-926a-d65c16db1809</entry>`,
+	`      <entry name="sku">8.2.0</entry>`,
+	`      <entry name="family">Red Hat Enterprise Linux</entry>`,
+	`    </system>`,
+	`    <baseBoard>`,
+	`      <entry name="manufacturer">Red Hat</entry>`,
+	`      <entry name="product">RHEL-AV</entry>`,
+	`      <entry name="version">RHEL 7.6.0 PC (i440FX + PIIX, 1996)</entry>`,
+	`      <entry name="serial">BB-1234</entry>`,
+	`      <entry name="location">Slot 0</entry>`,
+	`    </baseBoard>`,
+	`    <chassis>`,
+	`      <entry name="manufacturer">Red Hat</entry>`,
+	`      <entry name="version">RHEL 7.6.0 PC (i440FX + PIIX, 1996)</entry>`,
+	`      <entry name="sku">SKU-42</entry>`,
+	`    </chassis>`,
+	`    <processor>`,
+	`      <entry name="socket_destination">CPU 0</entry>`,
+	`      <entry name="type">Central Processor</entry>`,
+	`      <entry name="manufacturer">Intel</entry>`,
+	`      <entry name="signature">Type 0, Family 6, Model 63, Stepping 2</entry>`,
+	`      <entry name="version">Intel(R) Xeon(R) CPU E5-2650 v3 @ 2.30GHz</entry>`,
+	`      <entry name="external_clock">100 MHz</entry>`,
+	`      <entry name="max_speed">2300 MHz</entry>`,
+	`      <entry name="status">Populated, Enabled</entry>`,
+	`    </processor>`,
+	`    <memory>`,
+	`      <entry name="size">16384 MB</entry>`,
+	`      <entry name="form_factor">DIMM</entry>`,
+	`      <entry name="locator">DIMM 0</entry>`,
+	`      <entry name="bank_locator">BANK 0</entry>`,
+	`      <entry name="type">DDR3</entry>`,
+	`      <entry name="type_detail">Synchronous Registered (Buffered)</entry>`,
+	`      <entry name="speed">1600 MT/s</entry>`,
+	`      <entry name="manufacturer">Samsung</entry>`,
+	`      <entry name="serial_number">12345678</entry>`,
+	`      <entry name="part_number">M393B2G70QH0-YK0</entry>`,
+	`    </memory>`,
+	`    <oemStrings>`,
+	`      <entry>Hello</entry>`,
+	`      <entry>World</entry>`,
+	`    </oemStrings>`,
+	`  </sysinfo>`,
+	`  <os>`,
+	`    <smbios mode="sysinfo"></smbios>`,
+	`  </os>`,
+	`</domain>`,
+}
+
+var sysInfoHostDMIXML = []string{
+	`<domain>`,
+	`  <sysinfo type="smbios">`,
+	`    <bios>`,
+	`      <entry name="vendor">SeaBIOS</entry>`,
+	`      <entry name="version">1.11.0-2.el7</entry>`,
+	`      <entry name="date">04/01/2014</entry>`,
+	`    </bios>`,
+	`    <system>`,
+	`      <entry name="manufacturer">QEMU</entry>`,
+	`      <entry name="product">Standard PC (Q35 + ICH9, 2009)</entry>`,
+	`      <entry name="version">pc-q35-2.11</entry>`,
+	`      <entry name="uuid">c7a5fdbd-edaf-9455-926a-d65c16db1809</entry>`,
+	`    </system>`,
+	`  </sysinfo>`,
+	`  <os>`,
+	`    <smbios mode="sysinfo"></smbios>`,
+	`  </os>`,
+	`</domain>`,
+}
+
+var sysInfoHostFieldsXML = []string{
+	`<domain>`,
+	`  <sysinfo type="smbios">`,
+	`    <system>`,
+	`      <entry name="serial">0000-0000-0000</entry>`,
+	`      <entry name="uuid">c7a5fdbd-edaf-9455-926a-d65c16db1809</entry>`,
+	`    </system>`,
+	`    <baseBoard>`,
+	`      <entry name="serial">BB-1234</entry>`,
+	`    </baseBoard>`,
+	`    <oemStrings>`,
+	`      <entry>Hello</entry>`,
+	`      <entry>World</entry>`,
+	`    </oemStrings>`,
+	`  </sysinfo>`,
+	`  <os>`,
+	`    <smbios mode="sysinfo"></smbios>`,
+	`  </os>`,
+	`</domain>`,
+}
+
+// smbios3Dump replaces the 32-bit entry point of a dmidecode dump
+// with a 64-bit one
+func smbios3Dump(dump []byte) []byte {
+	table := dump[32:]
+	ep := make([]byte, 32)
+	copy(ep, "_SM3_")
+	ep[6] = 0x18
+	ep[7] = 3
+	ep[8] = 0
+	ep[10] = 1
+	binary.LittleEndian.PutUint32(ep[0x0c:], uint32(len(table)))
+	binary.LittleEndian.PutUint64(ep[0x10:], 32)
+	sum := byte(0)
+	for _, b := range ep[:0x18] {
+		sum += b
+	}
+	ep[5] = -sum
+	return append(ep, table...)
+}
+
+func TestDomainSysInfoHost(t *testing.T) {
+	dump, err := ioutil.ReadFile("testdata/smbios/dmidecode-dump.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Host     *DomainSysInfoHost
+		Expected []string
+	}{
+		{&DomainSysInfoHost{SMBIOS: dump}, sysInfoHostSMBIOSXML},
+		{&DomainSysInfoHost{SMBIOS: dump[32:]}, sysInfoHostSMBIOSXML},
+		{&DomainSysInfoHost{SMBIOS: smbios3Dump(dump)}, sysInfoHostSMBIOSXML},
+		{&DomainSysInfoHost{DMIDir: "testdata/sysfs/class/dmi/id"}, sysInfoHostDMIXML},
+		{
+			&DomainSysInfoHost{
+				SMBIOS: dump,
+				Fields: []string{"system.uuid", "system.serial", "baseBoard.serial", "oemStrings"},
+			},
+			sysInfoHostFieldsXML,
+		},
+	}
+
+	for _, test := range tests {
+		sysinfo, smbios, err := test.Host.SysInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dom := &Domain{
+			OS: &DomainOS{
+				SMBios: smbios,
+			},
+			SysInfo: sysinfo,
+		}
+		doc, err := dom.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := strings.Join(test.Expected, "\n")
+		if doc != expect {
+			t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestDomainSysInfoHostErrors(t *testing.T) {
+	dump, err := ioutil.ReadFile("testdata/smbios/dmidecode-dump.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	corrupt := append([]byte{}, dump...)
+	corrupt[4]++
+	truncated := dump[:len(dump)-40]
+
+	for _, data := range [][]byte{corrupt, truncated[32:]} {
+		host := &DomainSysInfoHost{
+			SMBIOS: data,
+		}
+		_, _, err = host.SysInfo()
+		if err == nil {
+			t.Fatal("Expected error for bad SMBIOS data")
+		}
+	}
+}
@@ -0,0 +1,286 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Applications keep their own data in the <metadata> element of
+// domains and networks, as one element in a namespace they own.
+// Registering a struct for the namespace lets GetMetadata and
+// SetMetadata convert that element, leaving the elements of other
+// namespaces exactly as they were.
+
+type metadataType struct {
+	typ   reflect.Type
+	local string
+}
+
+var metadataRegistry = struct {
+	sync.RWMutex
+	types map[string]metadataType
+}{
+	types: make(map[string]metadataType),
+}
+
+// RegisterMetadata associates the type of v, which must be a struct
+// or pointer to struct with an XMLName field naming its element, with
+// the namespace URI
+func RegisterMetadata(ns string, v interface{}) error {
+	if ns == "" {
+		return fmt.Errorf("Metadata namespace must not be empty")
+	}
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return fmt.Errorf("Metadata type %T is not a struct", v)
+	}
+	field, ok := typ.FieldByName("XMLName")
+	if !ok || field.Type != reflect.TypeOf(xml.Name{}) {
+		return fmt.Errorf("Metadata type %s has no XMLName field", typ)
+	}
+	local := strings.Split(field.Tag.Get("xml"), ",")[0]
+	if idx := strings.LastIndex(local, " "); idx != -1 {
+		if local[0:idx] != ns {
+			return fmt.Errorf("Metadata type %s is in namespace '%s', not '%s'", typ, local[0:idx], ns)
+		}
+		local = local[idx+1:]
+	}
+	if local == "" {
+		return fmt.Errorf("Metadata type %s has no element name", typ)
+	}
+
+	metadataRegistry.Lock()
+	defer metadataRegistry.Unlock()
+	if other, ok := metadataRegistry.types[ns]; ok && other.typ != typ {
+		return fmt.Errorf("Metadata namespace '%s' is already registered for %s", ns, other.typ)
+	}
+	metadataRegistry.types[ns] = metadataType{typ, local}
+	return nil
+}
+
+func lookupMetadata(ns string, v interface{}) (metadataType, error) {
+	metadataRegistry.RLock()
+	mt, ok := metadataRegistry.types[ns]
+	metadataRegistry.RUnlock()
+	if !ok {
+		return mt, fmt.Errorf("No metadata type registered for namespace '%s'", ns)
+	}
+	typ := reflect.TypeOf(v)
+	if typ != mt.typ && typ != reflect.PtrTo(mt.typ) {
+		return mt, fmt.Errorf("Metadata namespace '%s' holds %s, not %T", ns, mt.typ, v)
+	}
+	return mt, nil
+}
+
+type metadataElement struct {
+	start int
+	end   int
+	ns    string
+}
+
+// metadataElements locates the top level elements in the content
+// of a <metadata> element
+func metadataElements(doc string) ([]metadataElement, error) {
+	var elems []metadataElement
+	d := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		offset := d.InputOffset()
+		tok, err := d.Token()
+		if err == io.EOF {
+			return elems, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		err = d.Skip()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, metadataElement{int(offset), int(d.InputOffset()), start.Name.Space})
+	}
+}
+
+func findMetadata(doc string, ns string) (*metadataElement, error) {
+	elems, err := metadataElements(doc)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		if elem.ns == ns {
+			return &elem, nil
+		}
+	}
+	return nil, nil
+}
+
+func getMetadata(doc string, ns string, v interface{}) (bool, error) {
+	_, err := lookupMetadata(ns, v)
+	if err != nil {
+		return false, err
+	}
+	if reflect.TypeOf(v).Kind() != reflect.Ptr {
+		return false, fmt.Errorf("Metadata must be read into a pointer, not %T", v)
+	}
+	elem, err := findMetadata(doc, ns)
+	if err != nil || elem == nil {
+		return false, err
+	}
+	err = xml.Unmarshal([]byte(doc[elem.start:elem.end]), v)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func setMetadata(doc string, ns string, v interface{}) (string, error) {
+	mt, err := lookupMetadata(ns, v)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = xml.NewEncoder(&buf).EncodeElement(v, xml.StartElement{
+		Name: xml.Name{Space: ns, Local: mt.local},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	elem, err := findMetadata(doc, ns)
+	if err != nil {
+		return "", err
+	}
+	if elem == nil {
+		return doc + buf.String(), nil
+	}
+	return doc[0:elem.start] + buf.String() + doc[elem.end:], nil
+}
+
+func removeMetadata(doc string, ns string) (string, error) {
+	elem, err := findMetadata(doc, ns)
+	if err != nil || elem == nil {
+		return doc, err
+	}
+	return doc[0:elem.start] + doc[elem.end:], nil
+}
+
+// GetMetadata decodes the metadata element of the namespace into v,
+// a pointer to the type registered for it, returning false if the
+// domain has no such element
+func (d *Domain) GetMetadata(ns string, v interface{}) (bool, error) {
+	doc := ""
+	if d.Metadata != nil {
+		doc = d.Metadata.XML
+	}
+	return getMetadata(doc, ns, v)
+}
+
+// SetMetadata stores v as the metadata element of the namespace,
+// replacing any existing element of that namespace
+func (d *Domain) SetMetadata(ns string, v interface{}) error {
+	doc := ""
+	if d.Metadata != nil {
+		doc = d.Metadata.XML
+	}
+	doc, err := setMetadata(doc, ns, v)
+	if err != nil {
+		return err
+	}
+	d.Metadata = &DomainMetadata{XML: doc}
+	return nil
+}
+
+// RemoveMetadata deletes the metadata element of the namespace
+func (d *Domain) RemoveMetadata(ns string) error {
+	if d.Metadata == nil {
+		return nil
+	}
+	doc, err := removeMetadata(d.Metadata.XML, ns)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(doc) == "" {
+		d.Metadata = nil
+	} else {
+		d.Metadata.XML = doc
+	}
+	return nil
+}
+
+// GetMetadata decodes the metadata element of the namespace into v,
+// a pointer to the type registered for it, returning false if the
+// network has no such element
+func (n *Network) GetMetadata(ns string, v interface{}) (bool, error) {
+	doc := ""
+	if n.Metadata != nil {
+		doc = n.Metadata.XML
+	}
+	return getMetadata(doc, ns, v)
+}
+
+// SetMetadata stores v as the metadata element of the namespace,
+// replacing any existing element of that namespace
+func (n *Network) SetMetadata(ns string, v interface{}) error {
+	doc := ""
+	if n.Metadata != nil {
+		doc = n.Metadata.XML
+	}
+	doc, err := setMetadata(doc, ns, v)
+	if err != nil {
+		return err
+	}
+	n.Metadata = &NetworkMetadata{XML: doc}
+	return nil
+}
+
+// RemoveMetadata deletes the metadata element of the namespace
+func (n *Network) RemoveMetadata(ns string) error {
+	if n.Metadata == nil {
+		return nil
+	}
+	doc, err := removeMetadata(n.Metadata.XML, ns)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(doc) == "" {
+		n.Metadata = nil
+	} else {
+		n.Metadata.XML = doc
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+const metadataTestNS = "http://myapp.com/schemeas/my/1.0"
+
+type metadataTestWidget struct {
+	XMLName xml.Name `xml:"myvalue"`
+	Widget  struct {
+		Name string `xml:"name,attr"`
+	} `xml:"widget"`
+}
+
+type metadataTestOther struct {
+	XMLName xml.Name `xml:"other"`
+}
+
+func TestDomainMetadata(t *testing.T) {
+	err := RegisterMetadata(metadataTestNS, &metadataTestWidget{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RegisterMetadata(metadataTestNS, metadataTestWidget{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RegisterMetadata(metadataTestNS, &metadataTestOther{})
+	if err == nil {
+		t.Fatal("Expected error registering a second type")
+	}
+
+	other := "<myothervalue xmlns='http://myotherapp.com/schemeas/my/1.0'><gizmo name='foo'/></myothervalue>"
+	dom := &Domain{
+		Metadata: &DomainMetadata{
+			XML: "<myvalue xmlns='http://myapp.com/schemeas/my/1.0'><widget name='foo'/></myvalue>" + other,
+		},
+	}
+
+	var widget metadataTestWidget
+	found, err := dom.GetMetadata(metadataTestNS, &widget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || widget.Widget.Name != "foo" {
+		t.Fatalf("Expected widget 'foo', got %v", widget)
+	}
+
+	widget.Widget.Name = "bar"
+	err = dom.SetMetadata(metadataTestNS, &widget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `<myvalue xmlns="http://myapp.com/schemeas/my/1.0"><widget name="bar"></widget></myvalue>` + other
+	if dom.Metadata.XML != expect {
+		t.Fatalf("Expected metadata %s, got %s", expect, dom.Metadata.XML)
+	}
+
+	var reread metadataTestWidget
+	found, err = dom.GetMetadata(metadataTestNS, &reread)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || reread.Widget.Name != "bar" {
+		t.Fatalf("Expected widget 'bar', got %v", reread)
+	}
+
+	err = dom.RemoveMetadata(metadataTestNS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dom.Metadata.XML != other {
+		t.Fatalf("Expected metadata %s, got %s", other, dom.Metadata.XML)
+	}
+
+	found, err = dom.GetMetadata(metadataTestNS, &reread)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Fatal("Expected no metadata after removal")
+	}
+
+	_, err = dom.GetMetadata("http://myotherapp.com/schemeas/my/1.0", &reread)
+	if err == nil {
+		t.Fatal("Expected error for unregistered namespace")
+	}
+
+	err = dom.SetMetadata(metadataTestNS, &metadataTestOther{})
+	if err == nil {
+		t.Fatal("Expected error for wrong metadata type")
+	}
+}
+
+func TestNetworkMetadata(t *testing.T) {
+	err := RegisterMetadata(metadataTestNS, &metadataTestWidget{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	net := &Network{Name: "default"}
+	widget := &metadataTestWidget{}
+	widget.Widget.Name = "foo"
+	err = net.SetMetadata(metadataTestNS, widget)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := net.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join([]string{
+		`<network>`,
+		`  <name>default</name>`,
+		`  <metadata><myvalue xmlns="http://myapp.com/schemeas/my/1.0"><widget name="foo"></widget></myvalue></metadata>`,
+		`</network>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+
+	parsed := &Network{}
+	err = parsed.Unmarshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reread metadataTestWidget
+	found, err := parsed.GetMetadata(metadataTestNS, &reread)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || reread.Widget.Name != "foo" {
+		t.Fatalf("Expected widget 'foo', got %v", reread)
+	}
+
+	err = parsed.RemoveMetadata(metadataTestNS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Metadata != nil {
+		t.Fatal("Expected empty metadata to be dropped")
+	}
+}
@@ -64,6 +64,8 @@ type NodeDeviceCapability struct {
 	Storage    *NodeDeviceStorageCapability
 	DRM        *NodeDeviceDRMCapability
 	CCW        *NodeDeviceCCWCapability
+	CSS        *NodeDeviceCSSCapability
+	APMatrix   *NodeDeviceAPMatrixCapability
 	MDev       *NodeDeviceMDevCapability
 }
 
@@ -283,8 +285,28 @@ type NodeDeviceCCWCapability struct {
 	DevNo *uint `xml:"devno"`
 }
 
+type NodeDeviceCSSCapability struct {
+	CSSID        *uint
+	SSID         *uint
+	DevNo        *uint
+	Capabilities []NodeDeviceCSSSubCapability
+}
+
+type NodeDeviceCSSSubCapability struct {
+	MDevTypes *NodeDevicePCIMDevTypesCapability
+}
+
+type NodeDeviceAPMatrixCapability struct {
+	Capabilities []NodeDeviceAPMatrixSubCapability `xml:"capability"`
+}
+
+type NodeDeviceAPMatrixSubCapability struct {
+	MDevTypes *NodeDevicePCIMDevTypesCapability
+}
+
 type NodeDeviceMDevCapability struct {
 	Type       *NodeDeviceMDevCapabilityType `xml:"type"`
+	UUID       string                        `xml:"uuid,omitempty"`
 	IOMMUGroup *NodeDeviceIOMMUGroup         `xml:"iommuGroup"`
 }
 
@@ -403,6 +425,152 @@ func (c *NodeDeviceCCWCapability) UnmarshalXML(d *xml.Decoder, start xml.StartEl
 	return nil
 }
 
+func (c *NodeDeviceCSSCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	e.EncodeToken(start)
+	if c.CSSID != nil {
+		cssid := xml.StartElement{
+			Name: xml.Name{Local: "cssid"},
+		}
+		e.EncodeToken(cssid)
+		e.EncodeToken(xml.CharData(fmt.Sprintf("0x%x", *c.CSSID)))
+		e.EncodeToken(cssid.End())
+	}
+	if c.SSID != nil {
+		ssid := xml.StartElement{
+			Name: xml.Name{Local: "ssid"},
+		}
+		e.EncodeToken(ssid)
+		e.EncodeToken(xml.CharData(fmt.Sprintf("0x%x", *c.SSID)))
+		e.EncodeToken(ssid.End())
+	}
+	if c.DevNo != nil {
+		devno := xml.StartElement{
+			Name: xml.Name{Local: "devno"},
+		}
+		e.EncodeToken(devno)
+		e.EncodeToken(xml.CharData(fmt.Sprintf("0x%04x", *c.DevNo)))
+		e.EncodeToken(devno.End())
+	}
+	for i := range c.Capabilities {
+		capability := xml.StartElement{
+			Name: xml.Name{Local: "capability"},
+		}
+		if err := e.EncodeElement(&c.Capabilities[i], capability); err != nil {
+			return err
+		}
+	}
+	e.EncodeToken(start.End())
+	return nil
+}
+
+func (c *NodeDeviceCSSCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		tokStart, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if tokStart.Name.Local == "capability" {
+			var subcap NodeDeviceCSSSubCapability
+			if err := d.DecodeElement(&subcap, &tokStart); err != nil {
+				return err
+			}
+			c.Capabilities = append(c.Capabilities, subcap)
+			continue
+		}
+
+		if tokStart.Name.Local != "cssid" &&
+			tokStart.Name.Local != "ssid" &&
+			tokStart.Name.Local != "devno" {
+			d.Skip()
+			continue
+		}
+
+		var valstr string
+		if err := d.DecodeElement(&valstr, &tokStart); err != nil {
+			return err
+		}
+		val, err := strconv.ParseUint(strings.TrimPrefix(valstr, "0x"), 16, 64)
+		if err != nil {
+			return err
+		}
+
+		vali := uint(val)
+		if tokStart.Name.Local == "cssid" {
+			c.CSSID = &vali
+		} else if tokStart.Name.Local == "ssid" {
+			c.SSID = &vali
+		} else if tokStart.Name.Local == "devno" {
+			c.DevNo = &vali
+		}
+	}
+	return nil
+}
+
+func (c *NodeDeviceCSSSubCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	typ, ok := getAttr(start.Attr, "type")
+	if !ok {
+		return fmt.Errorf("Missing node device capability type")
+	}
+
+	switch typ {
+	case "mdev_types":
+		var mdevTypeCaps NodeDevicePCIMDevTypesCapability
+		if err := d.DecodeElement(&mdevTypeCaps, &start); err != nil {
+			return err
+		}
+		c.MDevTypes = &mdevTypeCaps
+	}
+	d.Skip()
+	return nil
+}
+
+func (c *NodeDeviceCSSSubCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if c.MDevTypes != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			xml.Name{Local: "type"}, "mdev_types",
+		})
+		return e.EncodeElement(c.MDevTypes, start)
+	}
+	return nil
+}
+
+func (c *NodeDeviceAPMatrixSubCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	typ, ok := getAttr(start.Attr, "type")
+	if !ok {
+		return fmt.Errorf("Missing node device capability type")
+	}
+
+	switch typ {
+	case "mdev_types":
+		var mdevTypeCaps NodeDevicePCIMDevTypesCapability
+		if err := d.DecodeElement(&mdevTypeCaps, &start); err != nil {
+			return err
+		}
+		c.MDevTypes = &mdevTypeCaps
+	}
+	d.Skip()
+	return nil
+}
+
+func (c *NodeDeviceAPMatrixSubCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if c.MDevTypes != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			xml.Name{Local: "type"}, "mdev_types",
+		})
+		return e.EncodeElement(c.MDevTypes, start)
+	}
+	return nil
+}
+
 func (c *NodeDevicePCISubCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	typ, ok := getAttr(start.Attr, "type")
 	if !ok {
@@ -598,6 +766,41 @@ func (c *NodeDeviceNetSubCapability) MarshalXML(e *xml.Encoder, start xml.StartE
 	return nil
 }
 
+// Type returns the libvirt name of the capability type, such as
+// "pci" or "scsi_host", or an empty string if no capability is set
+func (c *NodeDeviceCapability) Type() string {
+	if c.PCI != nil {
+		return "pci"
+	} else if c.System != nil {
+		return "system"
+	} else if c.USB != nil {
+		return "usb"
+	} else if c.USBDevice != nil {
+		return "usb_device"
+	} else if c.Net != nil {
+		return "net"
+	} else if c.SCSI != nil {
+		return "scsi"
+	} else if c.SCSIHost != nil {
+		return "scsi_host"
+	} else if c.SCSITarget != nil {
+		return "scsi_target"
+	} else if c.Storage != nil {
+		return "storage"
+	} else if c.DRM != nil {
+		return "drm"
+	} else if c.CCW != nil {
+		return "ccw"
+	} else if c.CSS != nil {
+		return "css"
+	} else if c.APMatrix != nil {
+		return "ap_matrix"
+	} else if c.MDev != nil {
+		return "mdev"
+	}
+	return ""
+}
+
 func (c *NodeDeviceCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	typ, ok := getAttr(start.Attr, "type")
 	if !ok {
@@ -671,6 +874,18 @@ func (c *NodeDeviceCapability) UnmarshalXML(d *xml.Decoder, start xml.StartEleme
 			return err
 		}
 		c.CCW = &ccwCaps
+	case "css":
+		var cssCaps NodeDeviceCSSCapability
+		if err := d.DecodeElement(&cssCaps, &start); err != nil {
+			return err
+		}
+		c.CSS = &cssCaps
+	case "ap_matrix":
+		var apMatrixCaps NodeDeviceAPMatrixCapability
+		if err := d.DecodeElement(&apMatrixCaps, &start); err != nil {
+			return err
+		}
+		c.APMatrix = &apMatrixCaps
 	case "mdev":
 		var mdevCaps NodeDeviceMDevCapability
 		if err := d.DecodeElement(&mdevCaps, &start); err != nil {
@@ -738,6 +953,16 @@ func (c *NodeDeviceCapability) MarshalXML(e *xml.Encoder, start xml.StartElement
 			xml.Name{Local: "type"}, "ccw",
 		})
 		return e.EncodeElement(c.CCW, start)
+	} else if c.CSS != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			xml.Name{Local: "type"}, "css",
+		})
+		return e.EncodeElement(c.CSS, start)
+	} else if c.APMatrix != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			xml.Name{Local: "type"}, "ap_matrix",
+		})
+		return e.EncodeElement(c.APMatrix, start)
 	} else if c.MDev != nil {
 		start.Attr = append(start.Attr, xml.Attr{
 			xml.Name{Local: "type"}, "mdev",
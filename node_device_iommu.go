@@ -0,0 +1,262 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IOMMUGroupStatus describes an IOMMU group which is only partly
+// assigned to the domain
+type IOMMUGroupStatus struct {
+	Number int
+	// PCI addresses of the group members which are assigned
+	Assigned []string
+	// PCI addresses of the group members which would also have to be
+	// assigned. Bridges are never listed.
+	Missing []string
+}
+
+// IOMMUDeviceInUse is a member of an IOMMU group touched by the
+// request which is already assigned to another domain
+type IOMMUDeviceInUse struct {
+	Address string
+	Group   int
+	Domain  string
+}
+
+// IOMMUAnalysis is the result of AnalyzeIOMMUGroups
+type IOMMUAnalysis struct {
+	Partial []IOMMUGroupStatus
+	InUse   []IOMMUDeviceInUse
+	// PCI addresses of requested devices without a node device
+	Unknown []string
+	// The requested hostdevs plus the ones needed to assign every
+	// group completely. Nil if no valid set exists.
+	Suggested []DomainHostdev
+}
+
+type iommuGroupMember struct {
+	address string
+	pci     *NodeDevicePCICapability
+	bridge  bool
+}
+
+func pciAddressString(domain, bus, slot, function *uint) string {
+	if domain == nil || bus == nil || slot == nil || function == nil {
+		return ""
+	}
+	return fmt.Sprintf("%04x:%02x:%02x.%x", *domain, *bus, *slot, *function)
+}
+
+func parsePCIAddress(addr string) (uint, uint, uint, uint, error) {
+	var domain, bus, slot, function uint
+	_, err := fmt.Sscanf(addr, "%x:%x:%x.%x", &domain, &bus, &slot, &function)
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("Invalid PCI address '%s'", addr)
+	}
+	return domain, bus, slot, function, nil
+}
+
+func nodeDevicePCIAddressString(addr *NodeDevicePCIAddress) string {
+	return pciAddressString(addr.Domain, addr.Bus, addr.Slot, addr.Function)
+}
+
+func domainPCIAddressString(addr *DomainAddressPCI) string {
+	if addr == nil {
+		return ""
+	}
+	return pciAddressString(addr.Domain, addr.Bus, addr.Slot, addr.Function)
+}
+
+func hostdevPCIAddress(hostdev *DomainHostdev) string {
+	if hostdev.SubsysPCI == nil || hostdev.SubsysPCI.Source == nil {
+		return ""
+	}
+	return domainPCIAddressString(hostdev.SubsysPCI.Source.Address)
+}
+
+// domainAssignedPCI returns the host PCI addresses assigned to dom,
+// either as hostdevs or as hostdev interfaces
+func domainAssignedPCI(dom *Domain) []string {
+	var addrs []string
+	if dom.Devices == nil {
+		return addrs
+	}
+	for i := range dom.Devices.Hostdevs {
+		addr := hostdevPCIAddress(&dom.Devices.Hostdevs[i])
+		if addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	for _, iface := range dom.Devices.Interfaces {
+		if iface.Source == nil || iface.Source.Hostdev == nil || iface.Source.Hostdev.PCI == nil {
+			continue
+		}
+		addr := domainPCIAddressString(iface.Source.Hostdev.PCI.Address)
+		if addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// AnalyzeIOMMUGroups checks whether the PCI devices requested by
+// hostdevs can be assigned to a domain, given the host's node devices
+// and the other domains defined on the host. Since all devices of an
+// IOMMU group must be assigned to the same domain, it reports groups
+// which the request does not cover completely and members used by
+// other domains.
+func AnalyzeIOMMUGroups(devs []NodeDevice, hostdevs []DomainHostdev, others []Domain) (*IOMMUAnalysis, error) {
+	members := make(map[string]*iommuGroupMember)
+	groups := make(map[int][]string)
+	groupOf := make(map[string]int)
+	addMember := func(group int, addr string, member *iommuGroupMember) {
+		if _, ok := members[addr]; ok {
+			if member.pci != nil {
+				members[addr] = member
+			}
+			return
+		}
+		members[addr] = member
+		groups[group] = append(groups[group], addr)
+		groupOf[addr] = group
+	}
+
+	for i := range devs {
+		pci := devs[i].Capability.PCI
+		if pci == nil || pci.IOMMUGroup == nil {
+			continue
+		}
+		addr := pciAddressString(pci.Domain, pci.Bus, pci.Slot, pci.Function)
+		if addr == "" {
+			return nil, fmt.Errorf("Node device '%s' has an incomplete PCI address", devs[i].Name)
+		}
+		member := &iommuGroupMember{
+			address: addr,
+			pci:     pci,
+		}
+		for _, subcap := range pci.Capabilities {
+			if subcap.Bridge != nil {
+				member.bridge = true
+			}
+		}
+		addMember(pci.IOMMUGroup.Number, addr, member)
+		for j := range pci.IOMMUGroup.Address {
+			other := nodeDevicePCIAddressString(&pci.IOMMUGroup.Address[j])
+			if other != "" {
+				addMember(pci.IOMMUGroup.Number, other, &iommuGroupMember{address: other})
+			}
+		}
+	}
+
+	users := make(map[string]string)
+	for i := range others {
+		for _, addr := range domainAssignedPCI(&others[i]) {
+			users[addr] = others[i].Name
+		}
+	}
+
+	analysis := &IOMMUAnalysis{}
+	requested := make(map[string]bool)
+	var touched []int
+	for i := range hostdevs {
+		addr := hostdevPCIAddress(&hostdevs[i])
+		if addr == "" {
+			continue
+		}
+		requested[addr] = true
+		group, ok := groupOf[addr]
+		if !ok {
+			analysis.Unknown = append(analysis.Unknown, addr)
+			continue
+		}
+		seen := false
+		for _, number := range touched {
+			if number == group {
+				seen = true
+			}
+		}
+		if !seen {
+			touched = append(touched, group)
+		}
+	}
+	sort.Ints(touched)
+
+	var extra []string
+	for _, number := range touched {
+		addrs := groups[number]
+		sort.Strings(addrs)
+		status := IOMMUGroupStatus{
+			Number: number,
+		}
+		for _, addr := range addrs {
+			if domain, ok := users[addr]; ok {
+				analysis.InUse = append(analysis.InUse, IOMMUDeviceInUse{
+					Address: addr,
+					Group:   number,
+					Domain:  domain,
+				})
+			}
+			if requested[addr] {
+				status.Assigned = append(status.Assigned, addr)
+			} else if !members[addr].bridge {
+				status.Missing = append(status.Missing, addr)
+			}
+		}
+		if len(status.Missing) != 0 {
+			analysis.Partial = append(analysis.Partial, status)
+			extra = append(extra, status.Missing...)
+		}
+	}
+
+	if len(analysis.Unknown) != 0 || len(analysis.InUse) != 0 {
+		return analysis, nil
+	}
+
+	analysis.Suggested = append([]DomainHostdev{}, hostdevs...)
+	for _, addr := range extra {
+		domain, bus, slot, function, err := parsePCIAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		analysis.Suggested = append(analysis.Suggested, DomainHostdev{
+			Managed: "yes",
+			SubsysPCI: &DomainHostdevSubsysPCI{
+				Source: &DomainHostdevSubsysPCISource{
+					Address: &DomainAddressPCI{
+						Domain:   &domain,
+						Bus:      &bus,
+						Slot:     &slot,
+						Function: &function,
+					},
+				},
+			},
+		})
+	}
+	return analysis, nil
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"testing"
+)
+
+func iommuTestDevice(bus, slot, function uint, group int, bridge bool) NodeDevice {
+	var domain uint
+	dev := NodeDevice{
+		Name: pciAddressString(&domain, &bus, &slot, &function),
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{
+				Domain:   &domain,
+				Bus:      &bus,
+				Slot:     &slot,
+				Function: &function,
+				IOMMUGroup: &NodeDeviceIOMMUGroup{
+					Number: group,
+				},
+			},
+		},
+	}
+	if bridge {
+		dev.Capability.PCI.Capabilities = []NodeDevicePCISubCapability{
+			NodeDevicePCISubCapability{
+				Bridge: &NodeDevicePCIBridgeCapability{},
+			},
+		}
+	}
+	return dev
+}
+
+func iommuTestHostdev(bus, slot, function uint) DomainHostdev {
+	var domain uint
+	return DomainHostdev{
+		Managed: "yes",
+		SubsysPCI: &DomainHostdevSubsysPCI{
+			Source: &DomainHostdevSubsysPCISource{
+				Address: &DomainAddressPCI{
+					Domain:   &domain,
+					Bus:      &bus,
+					Slot:     &slot,
+					Function: &function,
+				},
+			},
+		},
+	}
+}
+
+var iommuTestDevices = []NodeDevice{
+	iommuTestDevice(0x81, 0, 0, 3, false),
+	iommuTestDevice(0x81, 0, 1, 3, false),
+	iommuTestDevice(0x82, 0, 0, 4, true),
+	iommuTestDevice(0x82, 1, 0, 4, false),
+	iommuTestDevice(0x83, 0, 0, 5, false),
+}
+
+var iommuTestDomains = []Domain{
+	Domain{
+		Name: "web",
+		Devices: &DomainDeviceList{
+			Hostdevs: []DomainHostdev{
+				iommuTestHostdev(0x83, 0, 0),
+			},
+		},
+	},
+}
+
+var iommuTestData = []struct {
+	Hostdevs []DomainHostdev
+	Expected *IOMMUAnalysis
+}{
+	{
+		Hostdevs: []DomainHostdev{
+			iommuTestHostdev(0x82, 1, 0),
+		},
+		Expected: &IOMMUAnalysis{
+			Suggested: []DomainHostdev{
+				iommuTestHostdev(0x82, 1, 0),
+			},
+		},
+	},
+	{
+		Hostdevs: []DomainHostdev{
+			iommuTestHostdev(0x81, 0, 0),
+			iommuTestHostdev(0x82, 1, 0),
+		},
+		Expected: &IOMMUAnalysis{
+			Partial: []IOMMUGroupStatus{
+				IOMMUGroupStatus{
+					Number:   3,
+					Assigned: []string{"0000:81:00.0"},
+					Missing:  []string{"0000:81:00.1"},
+				},
+			},
+			Suggested: []DomainHostdev{
+				iommuTestHostdev(0x81, 0, 0),
+				iommuTestHostdev(0x82, 1, 0),
+				iommuTestHostdev(0x81, 0, 1),
+			},
+		},
+	},
+	{
+		Hostdevs: []DomainHostdev{
+			iommuTestHostdev(0x83, 0, 0),
+			iommuTestHostdev(0x90, 0, 0),
+		},
+		Expected: &IOMMUAnalysis{
+			InUse: []IOMMUDeviceInUse{
+				IOMMUDeviceInUse{
+					Address: "0000:83:00.0",
+					Group:   5,
+					Domain:  "web",
+				},
+			},
+			Unknown: []string{"0000:90:00.0"},
+		},
+	},
+}
+
+func TestAnalyzeIOMMUGroups(t *testing.T) {
+	for _, test := range iommuTestData {
+		analysis, err := AnalyzeIOMMUGroups(iommuTestDevices, test.Hostdevs, iommuTestDomains)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(analysis, test.Expected) {
+			t.Fatalf("Bad analysis:\n%+v\n does not match\n%+v", analysis, test.Expected)
+		}
+	}
+}
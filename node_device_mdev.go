@@ -0,0 +1,187 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MDevRequest asks for a number of mediated devices of one type
+type MDevRequest struct {
+	// mdev type ID, such as "nvidia-11"
+	Type  string
+	Count uint
+	// Only use parents attached to this NUMA node when set
+	NUMANode *int
+	// Display attribute of the hostdevs, such as "on"
+	Display string
+}
+
+// MDevAllocation is a mediated device placed on a parent device
+type MDevAllocation struct {
+	// Node device name of the parent
+	Parent string
+	UUID   string
+	// The hostdev assigning the device to a guest
+	Hostdev DomainHostdev
+	// The node device definition to create the device with
+	NodeDevice NodeDevice
+}
+
+type mdevParent struct {
+	name      string
+	available uint
+	api       string
+}
+
+func newUUID(r io.Reader) (string, error) {
+	var buf [16]byte
+	_, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return "", err
+	}
+	buf[6] = (buf[6] & 0x0f) | 0x40
+	buf[8] = (buf[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buf[0:4], buf[4:6], buf[6:8], buf[8:10], buf[10:16]), nil
+}
+
+// mdevParentTypes returns the mdev types offered by a PCI, CSS or AP
+// matrix parent device, along with its NUMA node if known
+func mdevParentTypes(c *NodeDeviceCapability) ([]NodeDevicePCIMDevType, *NodeDeviceNUMA) {
+	var types []NodeDevicePCIMDevType
+	var numa *NodeDeviceNUMA
+	if c.PCI != nil {
+		numa = c.PCI.NUMA
+		for _, subcap := range c.PCI.Capabilities {
+			if subcap.MDevTypes != nil {
+				types = append(types, subcap.MDevTypes.Types...)
+			}
+		}
+	} else if c.CSS != nil {
+		for _, subcap := range c.CSS.Capabilities {
+			if subcap.MDevTypes != nil {
+				types = append(types, subcap.MDevTypes.Types...)
+			}
+		}
+	} else if c.APMatrix != nil {
+		for _, subcap := range c.APMatrix.Capabilities {
+			if subcap.MDevTypes != nil {
+				types = append(types, subcap.MDevTypes.Types...)
+			}
+		}
+	}
+	return types, numa
+}
+
+// AllocateMDevs chooses parent devices for the mediated devices of
+// req, spreading them over the parents with the most available
+// instances, and generates a random UUID for each of them. PCI
+// (vGPU), CSS (vfio-ccw) and AP matrix (vfio-ap) parents are
+// considered; only PCI parents report a NUMA node.
+func AllocateMDevs(devs []NodeDevice, req *MDevRequest) ([]MDevAllocation, error) {
+	if req.Count == 0 {
+		return nil, nil
+	}
+
+	var parents []*mdevParent
+	total := uint(0)
+	for i := range devs {
+		types, numa := mdevParentTypes(&devs[i].Capability)
+		if types == nil {
+			continue
+		}
+		if req.NUMANode != nil && (numa == nil || numa.Node != *req.NUMANode) {
+			continue
+		}
+		for _, typ := range types {
+			if typ.ID != req.Type || typ.AvailableInstances == 0 {
+				continue
+			}
+			parents = append(parents, &mdevParent{
+				name:      devs[i].Name,
+				available: typ.AvailableInstances,
+				api:       typ.DeviceAPI,
+			})
+			total += typ.AvailableInstances
+		}
+	}
+	if total < req.Count {
+		return nil, fmt.Errorf("Only %d instances of mdev type '%s' are available, %d requested",
+			total, req.Type, req.Count)
+	}
+
+	var allocs []MDevAllocation
+	for len(allocs) < int(req.Count) {
+		var best *mdevParent
+		for _, parent := range parents {
+			if best == nil || parent.available > best.available ||
+				(parent.available == best.available && parent.name < best.name) {
+				best = parent
+			}
+		}
+		best.available--
+
+		uuid, err := newUUID(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		model := best.api
+		if model == "" {
+			model = "vfio-pci"
+		}
+		allocs = append(allocs, MDevAllocation{
+			Parent: best.name,
+			UUID:   uuid,
+			Hostdev: DomainHostdev{
+				SubsysMDev: &DomainHostdevSubsysMDev{
+					Model:   model,
+					Display: req.Display,
+					Source: &DomainHostdevSubsysMDevSource{
+						Address: &DomainAddressMDev{
+							UUID: uuid,
+						},
+					},
+				},
+			},
+			NodeDevice: NodeDevice{
+				Name:   "mdev_" + strings.Replace(uuid, "-", "_", -1),
+				Parent: best.name,
+				Capability: NodeDeviceCapability{
+					MDev: &NodeDeviceMDevCapability{
+						Type: &NodeDeviceMDevCapabilityType{
+							ID: req.Type,
+						},
+						UUID: uuid,
+					},
+				},
+			},
+		})
+	}
+	return allocs, nil
+}
@@ -0,0 +1,224 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func mdevTestDevice(name string, node int, typ string, available uint) NodeDevice {
+	return NodeDevice{
+		Name: name,
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{
+				NUMA: &NodeDeviceNUMA{
+					Node: node,
+				},
+				Capabilities: []NodeDevicePCISubCapability{
+					NodeDevicePCISubCapability{
+						MDevTypes: &NodeDevicePCIMDevTypesCapability{
+							Types: []NodeDevicePCIMDevType{
+								NodeDevicePCIMDevType{
+									ID:                 typ,
+									DeviceAPI:          "vfio-pci",
+									AvailableInstances: available,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var mdevTestDevices = []NodeDevice{
+	mdevTestDevice("pci_0000_06_00_0", 0, "nvidia-11", 2),
+	mdevTestDevice("pci_0000_86_00_0", 1, "nvidia-11", 4),
+	mdevTestDevice("pci_0000_07_00_0", 0, "nvidia-12", 8),
+}
+
+var mdevNode0 = 0
+
+var mdevTestData = []struct {
+	Request *MDevRequest
+	Parents []string
+}{
+	{
+		Request: &MDevRequest{
+			Type:  "nvidia-11",
+			Count: 3,
+		},
+		Parents: []string{"pci_0000_86_00_0", "pci_0000_86_00_0", "pci_0000_06_00_0"},
+	},
+	{
+		Request: &MDevRequest{
+			Type:     "nvidia-11",
+			Count:    2,
+			NUMANode: &mdevNode0,
+		},
+		Parents: []string{"pci_0000_06_00_0", "pci_0000_06_00_0"},
+	},
+	{
+		Request: &MDevRequest{
+			Type:     "nvidia-11",
+			Count:    3,
+			NUMANode: &mdevNode0,
+		},
+	},
+	{
+		Request: &MDevRequest{
+			Type:  "nvidia-13",
+			Count: 1,
+		},
+	},
+}
+
+var mdevUUIDRegexp = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+
+func TestAllocateMDevs(t *testing.T) {
+	for _, test := range mdevTestData {
+		allocs, err := AllocateMDevs(mdevTestDevices, test.Request)
+		if test.Parents == nil {
+			if err == nil {
+				t.Fatalf("Expected error allocating %+v", test.Request)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(allocs) != len(test.Parents) {
+			t.Fatalf("Expected %d allocations, got %d", len(test.Parents), len(allocs))
+		}
+		uuids := make(map[string]bool)
+		for i, alloc := range allocs {
+			if alloc.Parent != test.Parents[i] {
+				t.Fatalf("Expected parent %s, got %s", test.Parents[i], alloc.Parent)
+			}
+			if !mdevUUIDRegexp.MatchString(alloc.UUID) || uuids[alloc.UUID] {
+				t.Fatalf("Bad UUID %s", alloc.UUID)
+			}
+			uuids[alloc.UUID] = true
+		}
+	}
+}
+
+func TestAllocateMDevsXML(t *testing.T) {
+	allocs, err := AllocateMDevs(mdevTestDevices, &MDevRequest{
+		Type:    "nvidia-12",
+		Count:   1,
+		Display: "on",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alloc := allocs[0]
+
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	err = enc.Encode(&alloc.Hostdev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join([]string{
+		`<hostdev mode="subsystem" type="mdev" model="vfio-pci" display="on">`,
+		`  <source>`,
+		`    <address uuid="` + alloc.UUID + `"></address>`,
+		`  </source>`,
+		`</hostdev>`,
+	}, "\n")
+	if buf.String() != expect {
+		t.Fatal("Bad xml:\n", buf.String(), "\n does not match\n", expect, "\n")
+	}
+
+	doc, err := alloc.NodeDevice.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = strings.Join([]string{
+		`<device>`,
+		`  <name>mdev_` + strings.Replace(alloc.UUID, "-", "_", -1) + `</name>`,
+		`  <parent>pci_0000_07_00_0</parent>`,
+		`  <capability type="mdev">`,
+		`    <type id="nvidia-12"></type>`,
+		`    <uuid>` + alloc.UUID + `</uuid>`,
+		`  </capability>`,
+		`</device>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestAllocateMDevsNonPCI(t *testing.T) {
+	var devs []NodeDevice
+	for _, test := range NodeDeviceTestData {
+		dev := NodeDevice{}
+		err := dev.Unmarshal(strings.Join(test.XML, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		devs = append(devs, dev)
+	}
+
+	for _, test := range []struct {
+		Type   string
+		Parent string
+		Model  string
+	}{
+		{"vfio_ap-passthrough", "ap_matrix", "vfio-ap"},
+		{"vfio_ccw-io", "css_0_0_0052", "vfio-ccw"},
+	} {
+		allocs, err := AllocateMDevs(devs, &MDevRequest{
+			Type:  test.Type,
+			Count: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allocs[0].Parent != test.Parent {
+			t.Fatalf("Expected parent %s, got %s", test.Parent, allocs[0].Parent)
+		}
+		if allocs[0].Hostdev.SubsysMDev.Model != test.Model {
+			t.Fatalf("Expected model %s, got %s", test.Model, allocs[0].Hostdev.SubsysMDev.Model)
+		}
+	}
+
+	_, err := AllocateMDevs(devs, &MDevRequest{
+		Type:     "vfio_ap-passthrough",
+		Count:    1,
+		NUMANode: &mdevNode0,
+	})
+	if err == nil {
+		t.Fatal("Expected error for AP matrix parent on NUMA node")
+	}
+}
@@ -0,0 +1,239 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SRIOVVirtFunction is a virtual function of an SR-IOV capable device
+type SRIOVVirtFunction struct {
+	// PCI address, such as "0000:81:10.0"
+	Address string
+	// Node device name, empty if there is no node device for it
+	Device string
+	// Network interface name, empty if the function is not bound to
+	// a network driver
+	Netdev string
+}
+
+// SRIOVPhysFunction is an SR-IOV capable device and its virtual
+// functions, sorted by address
+type SRIOVPhysFunction struct {
+	Address string
+	Device  string
+	Netdev  string
+	VFs     []SRIOVVirtFunction
+}
+
+// SRIOVMap maps the SR-IOV physical functions of a host to their
+// virtual functions and network interfaces
+type SRIOVMap struct {
+	PFs []SRIOVPhysFunction
+}
+
+type sriovVirtFunctionList []SRIOVVirtFunction
+
+func (l sriovVirtFunctionList) Len() int           { return len(l) }
+func (l sriovVirtFunctionList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l sriovVirtFunctionList) Less(i, j int) bool { return l[i].Address < l[j].Address }
+
+// NewSRIOVMap collects the physical and virtual functions described
+// by devs. Network interfaces are found through net devices whose
+// parent is the PCI device.
+func NewSRIOVMap(devs []NodeDevice) (*SRIOVMap, error) {
+	netdevs := make(map[string]string)
+	names := make(map[string]string)
+	for i := range devs {
+		dev := &devs[i]
+		if dev.Capability.Net != nil && dev.Parent != "" {
+			netdevs[dev.Parent] = dev.Capability.Net.Interface
+		}
+		pci := dev.Capability.PCI
+		if pci != nil {
+			addr := pciAddressString(pci.Domain, pci.Bus, pci.Slot, pci.Function)
+			if addr == "" {
+				return nil, fmt.Errorf("Node device '%s' has an incomplete PCI address", dev.Name)
+			}
+			names[addr] = dev.Name
+		}
+	}
+
+	vfs := make(map[string]map[string]bool)
+	var pfs []string
+	addVF := func(pf, vf string) {
+		if vfs[pf] == nil {
+			vfs[pf] = make(map[string]bool)
+			pfs = append(pfs, pf)
+		}
+		if vf != "" {
+			vfs[pf][vf] = true
+		}
+	}
+	for i := range devs {
+		pci := devs[i].Capability.PCI
+		if pci == nil {
+			continue
+		}
+		addr := pciAddressString(pci.Domain, pci.Bus, pci.Slot, pci.Function)
+		for _, subcap := range pci.Capabilities {
+			if subcap.VirtFunctions != nil {
+				addVF(addr, "")
+				for j := range subcap.VirtFunctions.Address {
+					vf := nodeDevicePCIAddressString(&subcap.VirtFunctions.Address[j])
+					if vf == "" {
+						return nil, fmt.Errorf("Node device '%s' has an incomplete virtual function address", devs[i].Name)
+					}
+					addVF(addr, vf)
+				}
+			}
+			if subcap.PhysFunction != nil {
+				pf := nodeDevicePCIAddressString(&subcap.PhysFunction.Address)
+				if pf == "" {
+					return nil, fmt.Errorf("Node device '%s' has an incomplete physical function address", devs[i].Name)
+				}
+				addVF(pf, addr)
+			}
+		}
+	}
+	sort.Strings(pfs)
+
+	m := &SRIOVMap{}
+	for _, pf := range pfs {
+		phys := SRIOVPhysFunction{
+			Address: pf,
+			Device:  names[pf],
+			Netdev:  netdevs[names[pf]],
+		}
+		for vf := range vfs[pf] {
+			phys.VFs = append(phys.VFs, SRIOVVirtFunction{
+				Address: vf,
+				Device:  names[vf],
+				Netdev:  netdevs[names[vf]],
+			})
+		}
+		sort.Sort(sriovVirtFunctionList(phys.VFs))
+		m.PFs = append(m.PFs, phys)
+	}
+	return m, nil
+}
+
+// PF returns the physical function with network interface netdev, or
+// nil
+func (m *SRIOVMap) PF(netdev string) *SRIOVPhysFunction {
+	for i := range m.PFs {
+		if m.PFs[i].Netdev == netdev {
+			return &m.PFs[i]
+		}
+	}
+	return nil
+}
+
+// VF returns the virtual function with the given PCI address and its
+// physical function, or nil if there is none
+func (m *SRIOVMap) VF(address string) (*SRIOVPhysFunction, *SRIOVVirtFunction) {
+	for i := range m.PFs {
+		for j := range m.PFs[i].VFs {
+			if m.PFs[i].VFs[j].Address == address {
+				return &m.PFs[i], &m.PFs[i].VFs[j]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NetworkForward returns a hostdev forward for a network pooling the
+// virtual functions of pf. With usePF the pool is described by the
+// physical function's network interface and libvirt looks up the
+// virtual functions itself, otherwise every virtual function is listed
+// by address.
+func (pf *SRIOVPhysFunction) NetworkForward(usePF bool) (*NetworkForward, error) {
+	forward := &NetworkForward{
+		Mode:    "hostdev",
+		Managed: "yes",
+	}
+	if usePF {
+		if pf.Netdev == "" {
+			return nil, fmt.Errorf("Physical function '%s' has no network interface", pf.Address)
+		}
+		forward.PFs = []NetworkForwardPF{
+			NetworkForwardPF{
+				Dev: pf.Netdev,
+			},
+		}
+		return forward, nil
+	}
+
+	if len(pf.VFs) == 0 {
+		return nil, fmt.Errorf("Physical function '%s' has no virtual functions", pf.Address)
+	}
+	for _, vf := range pf.VFs {
+		domain, bus, slot, function, err := parsePCIAddress(vf.Address)
+		if err != nil {
+			return nil, err
+		}
+		forward.Addresses = append(forward.Addresses, NetworkForwardAddress{
+			PCI: &NetworkForwardAddressPCI{
+				Domain:   &domain,
+				Bus:      &bus,
+				Slot:     &slot,
+				Function: &function,
+			},
+		})
+	}
+	return forward, nil
+}
+
+// DomainInterface returns a hostdev interface assigning vf to a
+// guest. The MAC address is optional.
+func (vf *SRIOVVirtFunction) DomainInterface(mac string) (*DomainInterface, error) {
+	domain, bus, slot, function, err := parsePCIAddress(vf.Address)
+	if err != nil {
+		return nil, err
+	}
+	iface := &DomainInterface{
+		Managed: "yes",
+		Source: &DomainInterfaceSource{
+			Hostdev: &DomainInterfaceSourceHostdev{
+				PCI: &DomainHostdevSubsysPCISource{
+					Address: &DomainAddressPCI{
+						Domain:   &domain,
+						Bus:      &bus,
+						Slot:     &slot,
+						Function: &function,
+					},
+				},
+			},
+		},
+	}
+	if mac != "" {
+		iface.MAC = &DomainInterfaceMAC{
+			Address: mac,
+		}
+	}
+	return iface, nil
+}
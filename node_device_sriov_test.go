@@ -0,0 +1,201 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var pciDomain0 uint = 0
+var sriovPFBus uint = 0x81
+var sriovPFSlot uint = 0
+var sriovVFSlot uint = 0x10
+var sriovVFFunction0 uint = 0
+var sriovVFFunction2 uint = 2
+
+var sriovTestDevices = []NodeDevice{
+	NodeDevice{
+		Name: "pci_0000_81_00_0",
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{
+				Domain:   &pciDomain0,
+				Bus:      &sriovPFBus,
+				Slot:     &sriovPFSlot,
+				Function: &sriovVFFunction0,
+				Capabilities: []NodeDevicePCISubCapability{
+					NodeDevicePCISubCapability{
+						VirtFunctions: &NodeDevicePCIVirtFunctionsCapability{
+							MaxCount: 63,
+							Address: []NodeDevicePCIAddress{
+								NodeDevicePCIAddress{
+									Domain:   &pciDomain0,
+									Bus:      &sriovPFBus,
+									Slot:     &sriovVFSlot,
+									Function: &sriovVFFunction2,
+								},
+								NodeDevicePCIAddress{
+									Domain:   &pciDomain0,
+									Bus:      &sriovPFBus,
+									Slot:     &sriovVFSlot,
+									Function: &sriovVFFunction0,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	NodeDevice{
+		Name:   "net_eth0_00_1b_21_aa_bb_cc",
+		Parent: "pci_0000_81_00_0",
+		Capability: NodeDeviceCapability{
+			Net: &NodeDeviceNetCapability{
+				Interface: "eth0",
+			},
+		},
+	},
+	NodeDevice{
+		Name: "pci_0000_81_10_0",
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{
+				Domain:   &pciDomain0,
+				Bus:      &sriovPFBus,
+				Slot:     &sriovVFSlot,
+				Function: &sriovVFFunction0,
+				Capabilities: []NodeDevicePCISubCapability{
+					NodeDevicePCISubCapability{
+						PhysFunction: &NodeDevicePCIPhysFunctionCapability{
+							Address: NodeDevicePCIAddress{
+								Domain:   &pciDomain0,
+								Bus:      &sriovPFBus,
+								Slot:     &sriovPFSlot,
+								Function: &sriovVFFunction0,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	NodeDevice{
+		Name:   "net_enp129s16_aa_bb_cc_dd_ee_00",
+		Parent: "pci_0000_81_10_0",
+		Capability: NodeDeviceCapability{
+			Net: &NodeDeviceNetCapability{
+				Interface: "enp129s16",
+			},
+		},
+	},
+}
+
+func TestSRIOVMap(t *testing.T) {
+	m, err := NewSRIOVMap(sriovTestDevices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []SRIOVPhysFunction{
+		SRIOVPhysFunction{
+			Address: "0000:81:00.0",
+			Device:  "pci_0000_81_00_0",
+			Netdev:  "eth0",
+			VFs: []SRIOVVirtFunction{
+				SRIOVVirtFunction{
+					Address: "0000:81:10.0",
+					Device:  "pci_0000_81_10_0",
+					Netdev:  "enp129s16",
+				},
+				SRIOVVirtFunction{
+					Address: "0000:81:10.2",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(m.PFs, expect) {
+		t.Fatalf("Bad SR-IOV map:\n%+v\n does not match\n%+v", m.PFs, expect)
+	}
+
+	pf := m.PF("eth0")
+	if pf == nil {
+		t.Fatal("Missing physical function eth0")
+	}
+	if m.PF("eth1") != nil {
+		t.Fatal("Unexpected physical function eth1")
+	}
+	pf2, vf := m.VF("0000:81:10.2")
+	if pf2 != pf || vf == nil || vf.Address != "0000:81:10.2" {
+		t.Fatal("Failed to look up virtual function 0000:81:10.2")
+	}
+
+	forward, err := pf.NetworkForward(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSRIOVXML(t, forward, []string{
+		`<NetworkForward mode="hostdev" managed="yes">`,
+		`  <address type="pci" domain="0x0000" bus="0x81" slot="0x10" function="0x0"></address>`,
+		`  <address type="pci" domain="0x0000" bus="0x81" slot="0x10" function="0x2"></address>`,
+		`</NetworkForward>`,
+	})
+
+	forward, err = pf.NetworkForward(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSRIOVXML(t, forward, []string{
+		`<NetworkForward mode="hostdev" managed="yes">`,
+		`  <pf dev="eth0"></pf>`,
+		`</NetworkForward>`,
+	})
+
+	iface, err := vf.DomainInterface("52:54:00:11:22:33")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSRIOVXML(t, iface, []string{
+		`<interface type="hostdev" managed="yes">`,
+		`  <mac address="52:54:00:11:22:33"></mac>`,
+		`  <source>`,
+		`    <address type="pci" domain="0x0000" bus="0x81" slot="0x10" function="0x2"></address>`,
+		`  </source>`,
+		`</interface>`,
+	})
+}
+
+func checkSRIOVXML(t *testing.T, obj interface{}, lines []string) {
+	doc, err := xml.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join(lines, "\n")
+	if string(doc) != expect {
+		t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+	}
+}
@@ -0,0 +1,750 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NodeDeviceSysfs reads node devices from sysfs the way libvirt's udev
+// backend reports them, without needing libvirtd on the host.
+type NodeDeviceSysfs struct {
+	// Root of the sysfs tree, "/sys" when empty
+	Root string
+	// NetFeatures returns the offload features enabled on a network
+	// interface, such as "rx" or "tso". Sysfs does not expose them, so
+	// they are omitted when this is nil.
+	NetFeatures func(iface string) ([]string, error)
+}
+
+type sysfsDevice struct {
+	// Resolved path used to find the parent of other devices
+	key string
+	dev *NodeDevice
+}
+
+type sysfsReader struct {
+	root    string
+	devices []sysfsDevice
+}
+
+var sysfsNameRegexp = regexp.MustCompile("[^a-zA-Z0-9]")
+var sysfsSCSIRegexp = regexp.MustCompile(`^(\d+):(\d+):(\d+):(\d+)$`)
+var sysfsDRMRegexp = regexp.MustCompile(`^(card|renderD|controlD)\d+$`)
+
+var sysfsSCSITypes = map[string]string{
+	"0":  "disk",
+	"1":  "tape",
+	"2":  "printer",
+	"3":  "processor",
+	"4":  "worm",
+	"5":  "cdrom",
+	"6":  "scanner",
+	"7":  "optical_disk",
+	"8":  "changer",
+	"9":  "comm",
+	"12": "raid",
+	"13": "enclosure",
+	"14": "rbc",
+}
+
+func sysfsDeviceName(prefix string, parts ...string) string {
+	name := prefix
+	for _, part := range parts {
+		if part != "" {
+			name += "_" + sysfsNameRegexp.ReplaceAllString(part, "_")
+		}
+	}
+	return name
+}
+
+func (r *sysfsReader) path(elem ...string) string {
+	return filepath.Join(append([]string{r.root}, elem...)...)
+}
+
+// sysPath returns the path of a resolved file as seen on the host
+func (r *sysfsReader) sysPath(path string) string {
+	return "/sys" + strings.TrimPrefix(path, r.root)
+}
+
+func (r *sysfsReader) list(elem ...string) ([]string, error) {
+	dir, err := os.Open(r.path(elem...))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer dir.Close()
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (r *sysfsReader) exists(elem ...string) bool {
+	_, err := os.Stat(r.path(elem...))
+	return err == nil
+}
+
+// read returns the trimmed content of an attribute, or an empty string
+// if it cannot be read, as with attributes restricted to root
+func (r *sysfsReader) read(elem ...string) string {
+	data, err := ioutil.ReadFile(r.path(elem...))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func (r *sysfsReader) readUint(base int, elem ...string) (uint, bool) {
+	str := r.read(elem...)
+	if base == 16 {
+		str = strings.TrimPrefix(str, "0x")
+	}
+	val, err := strconv.ParseUint(str, base, 0)
+	if err != nil {
+		return 0, false
+	}
+	return uint(val), true
+}
+
+func (r *sysfsReader) resolve(elem ...string) (string, error) {
+	return filepath.EvalSymlinks(r.path(elem...))
+}
+
+func (r *sysfsReader) link(elem ...string) string {
+	path, err := r.resolve(elem...)
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(path)
+}
+
+func (r *sysfsReader) add(key string, dev *NodeDevice) {
+	r.devices = append(r.devices, sysfsDevice{
+		key: key,
+		dev: dev,
+	})
+}
+
+func (r *sysfsReader) newDevice(name string, path string) *NodeDevice {
+	return &NodeDevice{
+		Name: name,
+		Path: r.sysPath(path),
+	}
+}
+
+func (r *sysfsReader) driver(rel string) *NodeDeviceDriver {
+	name := r.link(rel, "driver")
+	if name == "" {
+		return nil
+	}
+	return &NodeDeviceDriver{
+		Name: name,
+	}
+}
+
+func (r *sysfsReader) readSystem() {
+	dev := &NodeDevice{
+		Name: "computer",
+		Capability: NodeDeviceCapability{
+			System: &NodeDeviceSystemCapability{},
+		},
+	}
+	if r.exists("class", "dmi", "id") {
+		dmi := func(name string) string {
+			return r.read("class", "dmi", "id", name)
+		}
+		dev.Capability.System.Product = dmi("product_name")
+		dev.Capability.System.Hardware = &NodeDeviceSystemHardware{
+			Vendor:  dmi("sys_vendor"),
+			Version: dmi("product_version"),
+			Serial:  dmi("product_serial"),
+			UUID:    dmi("product_uuid"),
+		}
+		dev.Capability.System.Firmware = &NodeDeviceSystemFirmware{
+			Vendor:      dmi("bios_vendor"),
+			Version:     dmi("bios_version"),
+			ReleaseData: dmi("bios_date"),
+		}
+	}
+	r.add("", dev)
+}
+
+func sysfsPCIAddress(name string) (*NodeDevicePCIAddress, error) {
+	domain, bus, slot, function, err := parsePCIAddress(name)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeDevicePCIAddress{
+		Domain:   &domain,
+		Bus:      &bus,
+		Slot:     &slot,
+		Function: &function,
+	}, nil
+}
+
+func (r *sysfsReader) readPCIExpressLink(validity, prefix, path string) *NodeDevicePCIExpressLink {
+	speed := strings.Fields(r.read(path, prefix+"_link_speed"))
+	width, ok := r.readUint(10, path, prefix+"_link_width")
+	if len(speed) == 0 || !ok {
+		return nil
+	}
+	link := &NodeDevicePCIExpressLink{
+		Validity: validity,
+		Width:    &width,
+	}
+	link.Speed, _ = strconv.ParseFloat(speed[0], 64)
+	return link
+}
+
+func (r *sysfsReader) readPCI() error {
+	names, err := r.list("bus", "pci", "devices")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		addr, err := sysfsPCIAddress(name)
+		if err != nil {
+			return err
+		}
+		path, err := r.resolve("bus", "pci", "devices", name)
+		if err != nil {
+			return err
+		}
+		rel := path[len(r.root):]
+
+		dev := r.newDevice(sysfsDeviceName("pci", name), path)
+		dev.Driver = r.driver(rel)
+		pci := &NodeDevicePCICapability{
+			Domain:   addr.Domain,
+			Bus:      addr.Bus,
+			Slot:     addr.Slot,
+			Function: addr.Function,
+			Product: NodeDeviceIDName{
+				ID: r.read(rel, "device"),
+			},
+			Vendor: NodeDeviceIDName{
+				ID: r.read(rel, "vendor"),
+			},
+		}
+		dev.Capability.PCI = pci
+
+		group := r.link(rel, "iommu_group")
+		if group != "" {
+			number, err := strconv.Atoi(group)
+			if err != nil {
+				return fmt.Errorf("Invalid IOMMU group '%s' of PCI device %s", group, name)
+			}
+			pci.IOMMUGroup = &NodeDeviceIOMMUGroup{
+				Number: number,
+			}
+			members, err := r.list("kernel", "iommu_groups", group, "devices")
+			if err != nil {
+				return err
+			}
+			for _, member := range members {
+				addr, err := sysfsPCIAddress(member)
+				if err != nil {
+					return err
+				}
+				pci.IOMMUGroup.Address = append(pci.IOMMUGroup.Address, *addr)
+			}
+		}
+
+		node := r.read(rel, "numa_node")
+		if node != "" && node != "-1" {
+			number, err := strconv.Atoi(node)
+			if err != nil {
+				return fmt.Errorf("Invalid NUMA node '%s' of PCI device %s", node, name)
+			}
+			pci.NUMA = &NodeDeviceNUMA{
+				Node: number,
+			}
+		}
+
+		links := []*NodeDevicePCIExpressLink{
+			r.readPCIExpressLink("cap", "max", rel),
+			r.readPCIExpressLink("sta", "current", rel),
+		}
+		for _, link := range links {
+			if link == nil {
+				continue
+			}
+			if pci.PCIExpress == nil {
+				pci.PCIExpress = &NodeDevicePCIExpress{}
+			}
+			pci.PCIExpress.Links = append(pci.PCIExpress.Links, *link)
+		}
+
+		physfn := r.link(rel, "physfn")
+		if physfn != "" {
+			addr, err := sysfsPCIAddress(physfn)
+			if err != nil {
+				return err
+			}
+			pci.Capabilities = append(pci.Capabilities, NodeDevicePCISubCapability{
+				PhysFunction: &NodeDevicePCIPhysFunctionCapability{
+					Address: *addr,
+				},
+			})
+		}
+
+		maxvfs, _ := r.readUint(10, rel, "sriov_totalvfs")
+		if maxvfs != 0 {
+			vfs := &NodeDevicePCIVirtFunctionsCapability{
+				MaxCount: int(maxvfs),
+			}
+			for i := uint(0); i < maxvfs; i++ {
+				vf := r.link(rel, fmt.Sprintf("virtfn%d", i))
+				if vf == "" {
+					continue
+				}
+				addr, err := sysfsPCIAddress(vf)
+				if err != nil {
+					return err
+				}
+				vfs.Address = append(vfs.Address, *addr)
+			}
+			pci.Capabilities = append(pci.Capabilities, NodeDevicePCISubCapability{
+				VirtFunctions: vfs,
+			})
+		}
+
+		types, err := r.list(rel, "mdev_supported_types")
+		if err != nil {
+			return err
+		}
+		if len(types) != 0 {
+			mdevs := &NodeDevicePCIMDevTypesCapability{}
+			for _, typ := range types {
+				available, _ := r.readUint(10, rel, "mdev_supported_types", typ, "available_instances")
+				mdevs.Types = append(mdevs.Types, NodeDevicePCIMDevType{
+					ID:                 typ,
+					Name:               r.read(rel, "mdev_supported_types", typ, "name"),
+					DeviceAPI:          r.read(rel, "mdev_supported_types", typ, "device_api"),
+					AvailableInstances: available,
+				})
+			}
+			pci.Capabilities = append(pci.Capabilities, NodeDevicePCISubCapability{
+				MDevTypes: mdevs,
+			})
+		}
+
+		class, _ := r.readUint(16, rel, "class")
+		if class>>8 == 0x0604 {
+			pci.Capabilities = append(pci.Capabilities, NodeDevicePCISubCapability{
+				Bridge: &NodeDevicePCIBridgeCapability{},
+			})
+		}
+
+		r.add(path, dev)
+	}
+	return nil
+}
+
+func (r *sysfsReader) readUSB() error {
+	names, err := r.list("bus", "usb", "devices")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		path, err := r.resolve("bus", "usb", "devices", name)
+		if err != nil {
+			return err
+		}
+		rel := path[len(r.root):]
+		dev := r.newDevice(sysfsDeviceName("usb", name), path)
+		dev.Driver = r.driver(rel)
+
+		if strings.Contains(name, ":") {
+			number, _ := r.readUint(16, rel, "bInterfaceNumber")
+			class, _ := r.readUint(16, rel, "bInterfaceClass")
+			subclass, _ := r.readUint(16, rel, "bInterfaceSubClass")
+			protocol, _ := r.readUint(16, rel, "bInterfaceProtocol")
+			dev.Capability.USB = &NodeDeviceUSBCapability{
+				Number:      int(number),
+				Class:       int(class),
+				Subclass:    int(subclass),
+				Protocol:    int(protocol),
+				Description: r.read(rel, "interface"),
+			}
+		} else {
+			bus, _ := r.readUint(10, rel, "busnum")
+			device, _ := r.readUint(10, rel, "devnum")
+			dev.DevNodes = []NodeDeviceDevNode{
+				NodeDeviceDevNode{
+					Type: "dev",
+					Path: fmt.Sprintf("/dev/bus/usb/%03d/%03d", bus, device),
+				},
+			}
+			dev.Capability.USBDevice = &NodeDeviceUSBDeviceCapability{
+				Bus:    int(bus),
+				Device: int(device),
+				Product: NodeDeviceIDName{
+					ID:   "0x" + r.read(rel, "idProduct"),
+					Name: r.read(rel, "product"),
+				},
+				Vendor: NodeDeviceIDName{
+					ID:   "0x" + r.read(rel, "idVendor"),
+					Name: r.read(rel, "manufacturer"),
+				},
+			}
+		}
+		r.add(path, dev)
+	}
+	return nil
+}
+
+func (r *sysfsReader) readNet(features func(iface string) ([]string, error)) error {
+	names, err := r.list("class", "net")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		path, err := r.resolve("class", "net", name)
+		if err != nil {
+			return err
+		}
+		rel := path[len(r.root):]
+		address := r.read(rel, "address")
+		dev := r.newDevice(sysfsDeviceName("net", name, address), path)
+
+		net := &NodeDeviceNetCapability{
+			Interface: name,
+			Address:   address,
+		}
+		state := r.read(rel, "operstate")
+		if state != "" {
+			net.Link = &NodeDeviceNetLink{
+				State: state,
+			}
+			speed := r.read(rel, "speed")
+			if state == "up" && speed != "" && speed != "-1" {
+				net.Link.Speed = speed
+			}
+		}
+		if features != nil {
+			names, err := features(name)
+			if err != nil {
+				return err
+			}
+			for _, feature := range names {
+				net.Features = append(net.Features, NodeDeviceNetOffloadFeatures{
+					Name: feature,
+				})
+			}
+		}
+		if r.exists(rel, "wireless") || r.exists(rel, "phy80211") {
+			net.Capability = append(net.Capability, NodeDeviceNetSubCapability{
+				Wireless80211: &NodeDeviceNet80211Capability{},
+			})
+		} else if r.read(rel, "type") == "1" {
+			net.Capability = append(net.Capability, NodeDeviceNetSubCapability{
+				Ethernet80203: &NodeDeviceNet80203Capability{},
+			})
+		}
+		dev.Capability.Net = net
+		r.add(path, dev)
+	}
+	return nil
+}
+
+func (r *sysfsReader) readSCSIHost() error {
+	names, err := r.list("class", "scsi_host")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		host, err := strconv.ParseUint(strings.TrimPrefix(name, "host"), 10, 0)
+		if err != nil {
+			return fmt.Errorf("Invalid SCSI host '%s'", name)
+		}
+		path, err := r.resolve("class", "scsi_host", name)
+		if err != nil {
+			return err
+		}
+		rel := path[len(r.root):]
+		dev := r.newDevice("scsi_"+name, path)
+
+		scsi := &NodeDeviceSCSIHostCapability{
+			Host: uint(host),
+		}
+		if id, ok := r.readUint(10, rel, "unique_id"); ok {
+			scsi.UniqueID = &id
+		}
+		if r.exists("class", "fc_host", name) {
+			wwn := func(attr string) string {
+				return strings.TrimPrefix(r.read("class", "fc_host", name, attr), "0x")
+			}
+			scsi.Capability = append(scsi.Capability, NodeDeviceSCSIHostSubCapability{
+				FCHost: &NodeDeviceSCSIFCHostCapability{
+					WWNN:      wwn("node_name"),
+					WWPN:      wwn("port_name"),
+					FabricWWN: wwn("fabric_name"),
+				},
+			})
+			if r.exists("class", "fc_host", name, "vport_create") {
+				max, _ := r.readUint(10, "class", "fc_host", name, "max_npiv_vports")
+				inuse, _ := r.readUint(10, "class", "fc_host", name, "npiv_vports_inuse")
+				scsi.Capability = append(scsi.Capability, NodeDeviceSCSIHostSubCapability{
+					VPortOps: &NodeDeviceSCSIVPortOpsCapability{
+						VPorts:    int(inuse),
+						MaxVPorts: int(max),
+					},
+				})
+			}
+		}
+		dev.Capability.SCSIHost = scsi
+
+		// Devices of the host hang off the hostN directory rather
+		// than the scsi_host class device
+		r.add(filepath.Dir(filepath.Dir(path)), dev)
+	}
+	return nil
+}
+
+func (r *sysfsReader) readSCSI() error {
+	names, err := r.list("bus", "scsi", "devices")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		var dev *NodeDevice
+		path, err := r.resolve("bus", "scsi", "devices", name)
+		if err != nil {
+			return err
+		}
+		rel := path[len(r.root):]
+
+		if strings.HasPrefix(name, "target") {
+			dev = r.newDevice(sysfsDeviceName("scsi", name), path)
+			dev.Capability.SCSITarget = &NodeDeviceSCSITargetCapability{
+				Target: name,
+			}
+		} else if match := sysfsSCSIRegexp.FindStringSubmatch(name); match != nil {
+			dev = r.newDevice(sysfsDeviceName("scsi", name), path)
+			scsi := &NodeDeviceSCSICapability{}
+			scsi.Host, _ = strconv.Atoi(match[1])
+			scsi.Bus, _ = strconv.Atoi(match[2])
+			scsi.Target, _ = strconv.Atoi(match[3])
+			scsi.Lun, _ = strconv.Atoi(match[4])
+			scsi.Type = sysfsSCSITypes[r.read(rel, "type")]
+			dev.Capability.SCSI = scsi
+		} else {
+			continue
+		}
+		r.add(path, dev)
+	}
+	return nil
+}
+
+func (r *sysfsReader) readStorage() error {
+	names, err := r.list("class", "block")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if r.exists("class", "block", name, "partition") || !r.exists("class", "block", name, "device") {
+			continue
+		}
+		path, err := r.resolve("class", "block", name)
+		if err != nil {
+			return err
+		}
+		rel := path[len(r.root):]
+
+		serial := r.read(rel, "serial")
+		if serial == "" {
+			serial = r.read(rel, "device", "serial")
+		}
+		dev := r.newDevice(sysfsDeviceName("block", name, serial), path)
+		dev.DevNodes = []NodeDeviceDevNode{
+			NodeDeviceDevNode{
+				Type: "dev",
+				Path: "/dev/" + name,
+			},
+		}
+
+		storage := &NodeDeviceStorageCapability{
+			Block:      "/dev/" + name,
+			DriverType: "disk",
+			Model:      r.read(rel, "device", "model"),
+			Vendor:     r.read(rel, "device", "vendor"),
+			Serial:     serial,
+		}
+		if r.read(rel, "device", "type") == "5" {
+			storage.DriverType = "cdrom"
+		}
+
+		sectors, _ := r.readUint(10, rel, "size")
+		blocksize, ok := r.readUint(10, rel, "queue", "logical_block_size")
+		if !ok || blocksize == 0 {
+			blocksize = 512
+		}
+		size := sectors * 512
+		blocks := size / blocksize
+		if r.read(rel, "removable") == "1" {
+			available := uint(0)
+			if size != 0 {
+				available = 1
+			}
+			storage.Capability = append(storage.Capability, NodeDeviceStorageSubCapability{
+				Removable: &NodeDeviceStorageRemovableCapability{
+					MediaAvailable:   &available,
+					MediaSize:        &size,
+					LogicalBlockSize: &blocksize,
+					NumBlocks:        &blocks,
+				},
+			})
+		} else {
+			storage.Size = &size
+			storage.LogicalBlockSize = &blocksize
+			storage.NumBlocks = &blocks
+		}
+		dev.Capability.Storage = storage
+		r.add(path, dev)
+	}
+	return nil
+}
+
+func (r *sysfsReader) readDRM() error {
+	names, err := r.list("class", "drm")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		match := sysfsDRMRegexp.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+		path, err := r.resolve("class", "drm", name)
+		if err != nil {
+			return err
+		}
+		dev := r.newDevice(sysfsDeviceName("drm", name), path)
+		dev.DevNodes = []NodeDeviceDevNode{
+			NodeDeviceDevNode{
+				Type: "dev",
+				Path: "/dev/dri/" + name,
+			},
+		}
+		typ := "primary"
+		if match[1] == "renderD" {
+			typ = "render"
+		} else if match[1] == "controlD" {
+			typ = "control"
+		}
+		dev.Capability.DRM = &NodeDeviceDRMCapability{
+			Type: typ,
+		}
+		r.add(path, dev)
+	}
+	return nil
+}
+
+// setParents links every device to the device with the nearest
+// ancestor sysfs path, or the computer device if there is none
+func (r *sysfsReader) setParents() {
+	keys := make(map[string]string)
+	for _, dev := range r.devices {
+		if dev.key != "" {
+			keys[dev.key] = dev.dev.Name
+		}
+	}
+	for _, dev := range r.devices {
+		if dev.key == "" {
+			continue
+		}
+		dev.dev.Parent = "computer"
+		for path := filepath.Dir(dev.key); len(path) > len(r.root); path = filepath.Dir(path) {
+			if name, ok := keys[path]; ok {
+				dev.dev.Parent = name
+				break
+			}
+		}
+	}
+}
+
+// Devices reads all supported node devices, sorted by name
+func (s *NodeDeviceSysfs) Devices() ([]NodeDevice, error) {
+	root := s.Root
+	if root == "" {
+		root = "/sys"
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &sysfsReader{
+		root: root,
+	}
+	r.readSystem()
+	readers := []func() error{
+		r.readPCI,
+		r.readUSB,
+		func() error {
+			return r.readNet(s.NetFeatures)
+		},
+		r.readSCSIHost,
+		r.readSCSI,
+		r.readStorage,
+		r.readDRM,
+	}
+	for _, reader := range readers {
+		err := reader()
+		if err != nil {
+			return nil, err
+		}
+	}
+	r.setParents()
+
+	devs := make([]NodeDevice, len(r.devices))
+	list := make(nodeDeviceList, len(r.devices))
+	for i := range r.devices {
+		list[i] = r.devices[i].dev
+	}
+	sort.Sort(list)
+	for i := range list {
+		devs[i] = *list[i]
+	}
+	return devs, nil
+}
@@ -0,0 +1,230 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var nodeDeviceSysfsTestData = map[string][]string{
+	"pci_0000_01_00_0": []string{
+		`<device>`,
+		`  <name>pci_0000_01_00_0</name>`,
+		`  <path>/sys/devices/pci0000:00/0000:00:01.0/0000:01:00.0</path>`,
+		`  <parent>pci_0000_00_01_0</parent>`,
+		`  <driver>`,
+		`    <name>ixgbe</name>`,
+		`  </driver>`,
+		`  <capability type="pci">`,
+		`    <domain>0</domain>`,
+		`    <bus>1</bus>`,
+		`    <slot>0</slot>`,
+		`    <function>0</function>`,
+		`    <product id="0x1528"></product>`,
+		`    <vendor id="0x8086"></vendor>`,
+		`    <iommuGroup number="1">`,
+		`      <address domain="0x0000" bus="0x00" slot="0x01" function="0x0"></address>`,
+		`      <address domain="0x0000" bus="0x01" slot="0x00" function="0x0"></address>`,
+		`      <address domain="0x0000" bus="0x01" slot="0x10" function="0x0"></address>`,
+		`    </iommuGroup>`,
+		`    <numa node="0"></numa>`,
+		`    <pci-express>`,
+		`      <link validity="cap" speed="5" width="8"></link>`,
+		`      <link validity="sta" speed="5" width="4"></link>`,
+		`    </pci-express>`,
+		`    <capability type="virt_functions" maxCount="63">`,
+		`      <address domain="0x0000" bus="0x01" slot="0x10" function="0x0"></address>`,
+		`    </capability>`,
+		`  </capability>`,
+		`</device>`,
+	},
+	"pci_0000_00_02_0": []string{
+		`<device>`,
+		`  <name>pci_0000_00_02_0</name>`,
+		`  <path>/sys/devices/pci0000:00/0000:00:02.0</path>`,
+		`  <parent>computer</parent>`,
+		`  <driver>`,
+		`    <name>i915</name>`,
+		`  </driver>`,
+		`  <capability type="pci">`,
+		`    <domain>0</domain>`,
+		`    <bus>0</bus>`,
+		`    <slot>2</slot>`,
+		`    <function>0</function>`,
+		`    <product id="0x1912"></product>`,
+		`    <vendor id="0x8086"></vendor>`,
+		`    <iommuGroup number="2">`,
+		`      <address domain="0x0000" bus="0x00" slot="0x02" function="0x0"></address>`,
+		`    </iommuGroup>`,
+		`    <capability type="mdev_types">`,
+		`      <type id="i915-GVTg_V5_4">`,
+		`        <name>GVTg_V5_4</name>`,
+		`        <deviceAPI>vfio-pci</deviceAPI>`,
+		`        <availableInstances>2</availableInstances>`,
+		`      </type>`,
+		`    </capability>`,
+		`  </capability>`,
+		`</device>`,
+	},
+	"net_eth0_00_1b_21_aa_bb_cc": []string{
+		`<device>`,
+		`  <name>net_eth0_00_1b_21_aa_bb_cc</name>`,
+		`  <path>/sys/devices/pci0000:00/0000:00:01.0/0000:01:00.0/net/eth0</path>`,
+		`  <parent>pci_0000_01_00_0</parent>`,
+		`  <capability type="net">`,
+		`    <interface>eth0</interface>`,
+		`    <address>00:1b:21:aa:bb:cc</address>`,
+		`    <link state="up" speed="10000"></link>`,
+		`    <feature name="rx"></feature>`,
+		`    <feature name="tso"></feature>`,
+		`    <capability type="80203"></capability>`,
+		`  </capability>`,
+		`</device>`,
+	},
+	"usb_usb1": []string{
+		`<device>`,
+		`  <name>usb_usb1</name>`,
+		`  <path>/sys/devices/pci0000:00/0000:00:14.0/usb1</path>`,
+		`  <devnode type="dev">/dev/bus/usb/001/001</devnode>`,
+		`  <parent>pci_0000_00_14_0</parent>`,
+		`  <driver>`,
+		`    <name>usb</name>`,
+		`  </driver>`,
+		`  <capability type="usb_device">`,
+		`    <bus>1</bus>`,
+		`    <device>1</device>`,
+		`    <product id="0x0002">xHCI Host Controller</product>`,
+		`    <vendor id="0x1d6b">Linux 4.15.0 xhci-hcd</vendor>`,
+		`  </capability>`,
+		`</device>`,
+	},
+	"block_sda": []string{
+		`<device>`,
+		`  <name>block_sda</name>`,
+		`  <path>/sys/devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda</path>`,
+		`  <devnode type="dev">/dev/sda</devnode>`,
+		`  <parent>scsi_0_0_0_0</parent>`,
+		`  <capability type="storage">`,
+		`    <block>/dev/sda</block>`,
+		`    <drive_type>disk</drive_type>`,
+		`    <model>QEMU HARDDISK</model>`,
+		`    <vendor>ATA</vendor>`,
+		`    <size>21474836480</size>`,
+		`    <logical_block_size>512</logical_block_size>`,
+		`    <num_blocks>41943040</num_blocks>`,
+		`  </capability>`,
+		`</device>`,
+	},
+}
+
+func TestNodeDeviceSysfs(t *testing.T) {
+	sysfs := &NodeDeviceSysfs{
+		Root: "testdata/sysfs",
+		NetFeatures: func(iface string) ([]string, error) {
+			if iface == "eth0" {
+				return []string{"rx", "tso"}, nil
+			}
+			return nil, nil
+		},
+	}
+	devs, err := sysfs.Devices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree, err := NewNodeDeviceTree(devs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := strings.Join([]string{
+		"computer",
+		"  |",
+		"  +- net_lo_00_00_00_00_00_00",
+		"  +- pci_0000_00_00_0",
+		"  +- pci_0000_00_01_0",
+		"  |   |",
+		"  |   +- pci_0000_01_00_0",
+		"  |   |   |",
+		"  |   |   +- net_eth0_00_1b_21_aa_bb_cc",
+		"  |   |     ",
+		"  |   +- pci_0000_01_10_0",
+		"  |       |",
+		"  |       +- net_eth1_52_54_00_12_34_56",
+		"  |         ",
+		"  +- pci_0000_00_02_0",
+		"  |   |",
+		"  |   +- drm_card0",
+		"  |   +- drm_renderD128",
+		"  |     ",
+		"  +- pci_0000_00_14_0",
+		"  |   |",
+		"  |   +- usb_usb1",
+		"  |       |",
+		"  |       +- usb_1_0_1_0",
+		"  |         ",
+		"  +- pci_0000_00_1f_2",
+		"      |",
+		"      +- scsi_host0",
+		"          |",
+		"          +- scsi_target0_0_0",
+		"              |",
+		"              +- scsi_0_0_0_0",
+		"                  |",
+		"                  +- block_sda",
+		"                    ",
+		"",
+	}, "\n")
+	if tree.String() != expect {
+		t.Fatal("Bad tree:\n", tree.String(), "\n does not match\n", expect, "\n")
+	}
+
+	for name, lines := range nodeDeviceSysfsTestData {
+		dev := tree.Lookup(name)
+		if dev == nil {
+			t.Fatalf("Missing node device %s", name)
+		}
+		doc, err := dev.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := strings.Join(lines, "\n")
+		if doc != expect {
+			t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestNodeDeviceSysfsMissingRoot(t *testing.T) {
+	sysfs := &NodeDeviceSysfs{
+		Root: "testdata/nonexistent",
+	}
+	_, err := sysfs.Devices()
+	if err == nil {
+		t.Fatal("Expected error for missing sysfs root")
+	}
+}
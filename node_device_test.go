@@ -35,6 +35,10 @@ var pciBus uint = 21
 var pciSlot uint = 10
 var pciFunc uint = 50
 
+var cssID uint = 0
+var cssSSID uint = 0
+var cssDevNo uint = 0x52
+
 var NodeDeviceTestData = []struct {
 	Object *NodeDevice
 	XML    []string
@@ -97,6 +101,88 @@ var NodeDeviceTestData = []struct {
 			`</device>`,
 		},
 	},
+	{
+		Object: &NodeDevice{
+			Name:   "css_0_0_0052",
+			Parent: "computer",
+			Capability: NodeDeviceCapability{
+				CSS: &NodeDeviceCSSCapability{
+					CSSID: &cssID,
+					SSID:  &cssSSID,
+					DevNo: &cssDevNo,
+					Capabilities: []NodeDeviceCSSSubCapability{
+						NodeDeviceCSSSubCapability{
+							MDevTypes: &NodeDevicePCIMDevTypesCapability{
+								Types: []NodeDevicePCIMDevType{
+									NodeDevicePCIMDevType{
+										ID:                 "vfio_ccw-io",
+										DeviceAPI:          "vfio-ccw",
+										AvailableInstances: 1,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		XML: []string{
+			`<device>`,
+			`  <name>css_0_0_0052</name>`,
+			`  <parent>computer</parent>`,
+			`  <capability type="css">`,
+			`    <cssid>0x0</cssid>`,
+			`    <ssid>0x0</ssid>`,
+			`    <devno>0x0052</devno>`,
+			`    <capability type="mdev_types">`,
+			`      <type id="vfio_ccw-io">`,
+			`        <name></name>`,
+			`        <deviceAPI>vfio-ccw</deviceAPI>`,
+			`        <availableInstances>1</availableInstances>`,
+			`      </type>`,
+			`    </capability>`,
+			`  </capability>`,
+			`</device>`,
+		},
+	},
+	{
+		Object: &NodeDevice{
+			Name:   "ap_matrix",
+			Parent: "computer",
+			Capability: NodeDeviceCapability{
+				APMatrix: &NodeDeviceAPMatrixCapability{
+					Capabilities: []NodeDeviceAPMatrixSubCapability{
+						NodeDeviceAPMatrixSubCapability{
+							MDevTypes: &NodeDevicePCIMDevTypesCapability{
+								Types: []NodeDevicePCIMDevType{
+									NodeDevicePCIMDevType{
+										ID:                 "vfio_ap-passthrough",
+										DeviceAPI:          "vfio-ap",
+										AvailableInstances: 65536,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		XML: []string{
+			`<device>`,
+			`  <name>ap_matrix</name>`,
+			`  <parent>computer</parent>`,
+			`  <capability type="ap_matrix">`,
+			`    <capability type="mdev_types">`,
+			`      <type id="vfio_ap-passthrough">`,
+			`        <name></name>`,
+			`        <deviceAPI>vfio-ap</deviceAPI>`,
+			`        <availableInstances>65536</availableInstances>`,
+			`      </type>`,
+			`    </capability>`,
+			`  </capability>`,
+			`</device>`,
+		},
+	},
 }
 
 func TestNodeDevice(t *testing.T) {
@@ -0,0 +1,177 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// NodeDeviceTree links a flat list of node devices into the hierarchy
+// described by their Parent fields. Devices whose parent is not part
+// of the list are roots of the tree.
+type NodeDeviceTree struct {
+	devices  map[string]*NodeDevice
+	children map[string][]*NodeDevice
+	roots    []*NodeDevice
+}
+
+type nodeDeviceList []*NodeDevice
+
+func (l nodeDeviceList) Len() int           { return len(l) }
+func (l nodeDeviceList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l nodeDeviceList) Less(i, j int) bool { return l[i].Name < l[j].Name }
+
+// NewNodeDeviceTree builds the tree of devs. The devices are
+// referenced, not copied.
+func NewNodeDeviceTree(devs []NodeDevice) (*NodeDeviceTree, error) {
+	tree := &NodeDeviceTree{
+		devices:  make(map[string]*NodeDevice),
+		children: make(map[string][]*NodeDevice),
+	}
+	for i := range devs {
+		dev := &devs[i]
+		if dev.Name == "" {
+			return nil, fmt.Errorf("Node device without a name")
+		}
+		if _, ok := tree.devices[dev.Name]; ok {
+			return nil, fmt.Errorf("Duplicate node device '%s'", dev.Name)
+		}
+		tree.devices[dev.Name] = dev
+	}
+
+	for i := range devs {
+		dev := &devs[i]
+		if dev.Parent == "" || tree.devices[dev.Parent] == nil {
+			tree.roots = append(tree.roots, dev)
+		} else {
+			tree.children[dev.Parent] = append(tree.children[dev.Parent], dev)
+		}
+	}
+
+	sort.Sort(nodeDeviceList(tree.roots))
+	for _, children := range tree.children {
+		sort.Sort(nodeDeviceList(children))
+	}
+
+	// A parent cycle leaves its devices unreachable from the roots
+	seen := 0
+	var visit func(devs []*NodeDevice)
+	visit = func(devs []*NodeDevice) {
+		for _, dev := range devs {
+			seen++
+			visit(tree.children[dev.Name])
+		}
+	}
+	visit(tree.roots)
+	if seen != len(tree.devices) {
+		return nil, fmt.Errorf("Node device parents form a cycle")
+	}
+
+	return tree, nil
+}
+
+// Lookup returns the device called name, or nil
+func (t *NodeDeviceTree) Lookup(name string) *NodeDevice {
+	return t.devices[name]
+}
+
+// Parent returns the parent of the device called name, or nil for
+// roots and unknown devices
+func (t *NodeDeviceTree) Parent(name string) *NodeDevice {
+	dev := t.devices[name]
+	if dev == nil || dev.Parent == "" {
+		return nil
+	}
+	return t.devices[dev.Parent]
+}
+
+// Children returns the direct children of the device called name,
+// sorted by name
+func (t *NodeDeviceTree) Children(name string) []*NodeDevice {
+	return t.children[name]
+}
+
+// Roots returns the devices without a parent, sorted by name
+func (t *NodeDeviceTree) Roots() []*NodeDevice {
+	return t.roots
+}
+
+// Filter returns the devices whose capability type is capType, such
+// as "pci" or "scsi_host", sorted by name
+func (t *NodeDeviceTree) Filter(capType string) []*NodeDevice {
+	var devs []*NodeDevice
+	for _, dev := range t.devices {
+		if dev.Capability.Type() == capType {
+			devs = append(devs, dev)
+		}
+	}
+	sort.Sort(nodeDeviceList(devs))
+	return devs
+}
+
+func (t *NodeDeviceTree) format(buf *bytes.Buffer, dev *NodeDevice, last bool, root bool, indent []byte) []byte {
+	if root {
+		fmt.Fprintf(buf, "%s%s\n", indent, dev.Name)
+	} else {
+		fmt.Fprintf(buf, "%s+- %s\n", indent, dev.Name)
+		if last {
+			indent = append(indent, ' ', ' ')
+		} else {
+			indent = append(indent, '|', ' ')
+		}
+	}
+
+	children := t.children[dev.Name]
+	if len(children) != 0 {
+		fmt.Fprintf(buf, "%s  |\n", indent)
+	}
+
+	indent = append(indent, ' ', ' ')
+	for i, child := range children {
+		indent = t.format(buf, child, i == len(children)-1, false, indent)
+	}
+	indent = indent[:len(indent)-2]
+
+	if len(children) == 0 && last {
+		fmt.Fprintf(buf, "%s\n", indent)
+	}
+
+	if !root {
+		indent = indent[:len(indent)-2]
+	}
+	return indent
+}
+
+// String renders the tree the same way as "virsh nodedev-list --tree"
+func (t *NodeDeviceTree) String() string {
+	var buf bytes.Buffer
+	for _, dev := range t.roots {
+		t.format(&buf, dev, true, true, nil)
+	}
+	return buf.String()
+}
@@ -0,0 +1,161 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var nodeDeviceTreeTestData = []NodeDevice{
+	NodeDevice{
+		Name:   "pci_0000_01_00_0",
+		Parent: "pci_0000_00_01_0",
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{},
+		},
+	},
+	NodeDevice{
+		Name: "computer",
+		Capability: NodeDeviceCapability{
+			System: &NodeDeviceSystemCapability{},
+		},
+	},
+	NodeDevice{
+		Name:   "net_eth0_52_54_00_12_34_56",
+		Parent: "pci_0000_01_00_0",
+		Capability: NodeDeviceCapability{
+			Net: &NodeDeviceNetCapability{},
+		},
+	},
+	NodeDevice{
+		Name:   "pci_0000_00_00_0",
+		Parent: "computer",
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{},
+		},
+	},
+	NodeDevice{
+		Name:   "pci_0000_00_01_0",
+		Parent: "computer",
+		Capability: NodeDeviceCapability{
+			PCI: &NodeDevicePCICapability{},
+		},
+	},
+	NodeDevice{
+		Name:   "net_lo_00_00_00_00_00_00",
+		Parent: "computer",
+		Capability: NodeDeviceCapability{
+			Net: &NodeDeviceNetCapability{},
+		},
+	},
+	NodeDevice{
+		Name:   "scsi_host0",
+		Parent: "pci_0000_00_01_0",
+		Capability: NodeDeviceCapability{
+			SCSIHost: &NodeDeviceSCSIHostCapability{},
+		},
+	},
+}
+
+func nodeDeviceNames(devs []*NodeDevice) string {
+	var names []string
+	for _, dev := range devs {
+		names = append(names, dev.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func TestNodeDeviceTree(t *testing.T) {
+	tree, err := NewNodeDeviceTree(nodeDeviceTreeTestData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dev := tree.Lookup("scsi_host0"); dev == nil || dev.Capability.Type() != "scsi_host" {
+		t.Fatal("Failed to look up scsi_host0")
+	}
+	if tree.Lookup("bogus") != nil {
+		t.Fatal("Unexpected device found")
+	}
+	if dev := tree.Parent("net_eth0_52_54_00_12_34_56"); dev == nil || dev.Name != "pci_0000_01_00_0" {
+		t.Fatal("Bad parent of net_eth0_52_54_00_12_34_56")
+	}
+	if tree.Parent("computer") != nil {
+		t.Fatal("Unexpected parent of computer")
+	}
+
+	names := nodeDeviceNames(tree.Roots())
+	if names != "computer" {
+		t.Fatalf("Bad roots %s", names)
+	}
+	names = nodeDeviceNames(tree.Children("pci_0000_00_01_0"))
+	if names != "pci_0000_01_00_0,scsi_host0" {
+		t.Fatalf("Bad children %s", names)
+	}
+	names = nodeDeviceNames(tree.Filter("net"))
+	if names != "net_eth0_52_54_00_12_34_56,net_lo_00_00_00_00_00_00" {
+		t.Fatalf("Bad net devices %s", names)
+	}
+
+	expect := strings.Join([]string{
+		"computer",
+		"  |",
+		"  +- net_lo_00_00_00_00_00_00",
+		"  +- pci_0000_00_00_0",
+		"  +- pci_0000_00_01_0",
+		"      |",
+		"      +- pci_0000_01_00_0",
+		"      |   |",
+		"      |   +- net_eth0_52_54_00_12_34_56",
+		"      |     ",
+		"      +- scsi_host0",
+		"        ",
+		"",
+	}, "\n")
+	if tree.String() != expect {
+		t.Fatal("Bad tree:\n", tree.String(), "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestNodeDeviceTreeErrors(t *testing.T) {
+	_, err := NewNodeDeviceTree([]NodeDevice{
+		NodeDevice{Name: "computer"},
+		NodeDevice{Name: "computer"},
+	})
+	if err == nil {
+		t.Fatal("Expected error for duplicate device")
+	}
+
+	_, err = NewNodeDeviceTree([]NodeDevice{
+		NodeDevice{Name: "computer"},
+		NodeDevice{Name: "a", Parent: "b"},
+		NodeDevice{Name: "b", Parent: "a"},
+	})
+	if err == nil {
+		t.Fatal("Expected error for parent cycle")
+	}
+}
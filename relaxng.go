@@ -0,0 +1,1479 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A RelaxNG validator covering the XML syntax of the specification
+// as used by libvirt's schemas. Validation follows James Clark's
+// derivative algorithm: the schema pattern is repeatedly replaced by
+// its derivative with respect to each start tag, attribute, text node
+// and end tag of the document, which fails once NotAllowed is reached.
+
+const (
+	rngNamespace = "http://relaxng.org/ns/structure/1.0"
+	xsdDatatypes = "http://www.w3.org/2001/XMLSchema-datatypes"
+)
+
+const (
+	rngEmpty = iota
+	rngNotAllowed
+	rngText
+	rngChoice
+	rngInterleave
+	rngGroup
+	rngOneOrMore
+	rngList
+	rngData
+	rngValue
+	rngAttribute
+	rngElement
+	rngAfter
+	rngRef
+)
+
+type rngPattern struct {
+	kind     int
+	a        *rngPattern
+	b        *rngPattern
+	names    *rngNameClass
+	datatype *rngDatatype
+	value    string
+	define   *rngDefine
+}
+
+var (
+	rngEmptyPattern      = &rngPattern{kind: rngEmpty}
+	rngNotAllowedPattern = &rngPattern{kind: rngNotAllowed}
+	rngTextPattern       = &rngPattern{kind: rngText}
+)
+
+const (
+	rngAnyName = iota
+	rngNsName
+	rngName
+	rngNameChoice
+)
+
+type rngNameClass struct {
+	kind   int
+	ns     string
+	local  string
+	a      *rngNameClass
+	b      *rngNameClass
+	except *rngNameClass
+}
+
+func (n *rngNameClass) contains(name xml.Name) bool {
+	switch n.kind {
+	case rngAnyName:
+		return n.except == nil || !n.except.contains(name)
+	case rngNsName:
+		return n.ns == name.Space && (n.except == nil || !n.except.contains(name))
+	case rngName:
+		return n.ns == name.Space && n.local == name.Local
+	case rngNameChoice:
+		return n.a.contains(name) || n.b.contains(name)
+	}
+	return false
+}
+
+type rngDefine struct {
+	name    string
+	combine string
+	parts   []*rngPattern
+	plain   bool
+	pattern *rngPattern
+}
+
+func (d *rngDefine) add(combine string, p *rngPattern) error {
+	if combine == "" {
+		if d.plain {
+			return fmt.Errorf("Duplicate definition of '%s'", d.name)
+		}
+		d.plain = true
+	} else {
+		if combine != "choice" && combine != "interleave" {
+			return fmt.Errorf("Unknown combine method '%s' for '%s'", combine, d.name)
+		}
+		if d.combine != "" && d.combine != combine {
+			return fmt.Errorf("Conflicting combine methods for '%s'", d.name)
+		}
+		d.combine = combine
+	}
+	d.parts = append(d.parts, p)
+	return nil
+}
+
+func (d *rngDefine) finish() error {
+	if len(d.parts) == 0 {
+		return fmt.Errorf("Reference to undefined pattern '%s'", d.name)
+	}
+	if len(d.parts) > 1 && !d.plain && d.combine == "" {
+		return fmt.Errorf("Missing combine method for '%s'", d.name)
+	}
+	p := d.parts[0]
+	for _, part := range d.parts[1:] {
+		if d.combine == "interleave" {
+			p = &rngPattern{kind: rngInterleave, a: p, b: part}
+		} else {
+			p = &rngPattern{kind: rngChoice, a: p, b: part}
+		}
+	}
+	d.pattern = p
+	return nil
+}
+
+type rngGrammar struct {
+	parent  *rngGrammar
+	start   *rngDefine
+	defines map[string]*rngDefine
+}
+
+func (g *rngGrammar) lookup(name string) *rngDefine {
+	def, ok := g.defines[name]
+	if !ok {
+		def = &rngDefine{name: name}
+		g.defines[name] = def
+	}
+	return def
+}
+
+// rngNode is a RelaxNG schema element, with the inherited ns and
+// datatypeLibrary attributes and namespace prefixes resolved
+type rngNode struct {
+	name     string
+	attrs    map[string]string
+	ns       string
+	library  string
+	prefixes map[string]string
+	text     string
+	children []*rngNode
+	file     string
+	line     int
+}
+
+func (n *rngNode) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s:%d: %s", n.file, n.line, fmt.Sprintf(format, args...))
+}
+
+// rngLines maps decoder offsets to line numbers, counting the
+// newlines from the previous offset asked for
+type rngLines struct {
+	data   string
+	offset int
+	line   int
+}
+
+func (l *rngLines) at(offset int64) int {
+	end := int(offset)
+	if end > len(l.data) {
+		end = len(l.data)
+	}
+	if end < l.offset {
+		l.offset, l.line = 0, 0
+	}
+	l.line += strings.Count(l.data[l.offset:end], "\n")
+	l.offset = end
+	return l.line + 1
+}
+
+// rngLoader returns the contents of the schema file with the given
+// slash separated path
+type rngLoader func(name string) ([]byte, error)
+
+func parseRNGFile(load rngLoader, file string) (*rngNode, error) {
+	data, err := load(file)
+	if err != nil {
+		return nil, err
+	}
+
+	d := xml.NewDecoder(strings.NewReader(string(data)))
+	lines := &rngLines{data: string(data)}
+	var root *rngNode
+	var stack []*rngNode
+	skip := 0
+	for {
+		line := lines.at(d.InputOffset())
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 || tok.Name.Space != rngNamespace {
+				skip++
+				continue
+			}
+			node := &rngNode{
+				name:     tok.Name.Local,
+				attrs:    make(map[string]string),
+				prefixes: make(map[string]string),
+				file:     file,
+				line:     line,
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				node.ns = parent.ns
+				node.library = parent.library
+				for prefix, uri := range parent.prefixes {
+					node.prefixes[prefix] = uri
+				}
+				parent.children = append(parent.children, node)
+			} else {
+				root = node
+			}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space == "xmlns" {
+					node.prefixes[attr.Name.Local] = attr.Value
+				} else if attr.Name.Space == "" && attr.Name.Local != "xmlns" {
+					node.attrs[attr.Name.Local] = attr.Value
+				}
+			}
+			if ns, ok := node.attrs["ns"]; ok {
+				node.ns = ns
+			}
+			if library, ok := node.attrs["datatypeLibrary"]; ok {
+				node.library = library
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if skip == 0 && len(stack) > 0 {
+				stack[len(stack)-1].text += string(tok)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("%s: No RelaxNG pattern found", file)
+	}
+	return root, nil
+}
+
+type rngCompiler struct {
+	load    rngLoader
+	defines []*rngDefine
+	loading map[string]bool
+	top     *rngGrammar
+}
+
+// compileRNG compiles the schema in file. Given a define, validation
+// starts from that define of the top level grammar rather than from
+// its start pattern, so that parts of a document can be validated.
+func compileRNG(load rngLoader, file string, define string) (*rngPattern, error) {
+	c := &rngCompiler{
+		load:    load,
+		loading: make(map[string]bool),
+	}
+
+	root, err := c.parse(file)
+	if err != nil {
+		return nil, err
+	}
+	start, err := c.pattern(root, nil)
+	if err != nil {
+		return nil, err
+	}
+	if define != "" {
+		var def *rngDefine
+		if c.top != nil {
+			def = c.top.defines[define]
+		}
+		if def == nil {
+			return nil, fmt.Errorf("%s: No define named '%s'", file, define)
+		}
+		start = &rngPattern{kind: rngRef, define: def}
+	}
+
+	for _, def := range c.defines {
+		err = def.finish()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.resolve(start)
+}
+
+func (c *rngCompiler) parse(file string) (*rngNode, error) {
+	if c.loading[file] {
+		return nil, fmt.Errorf("%s: Recursive inclusion", file)
+	}
+	return parseRNGFile(c.load, file)
+}
+
+func (c *rngCompiler) grammar(node *rngNode, parent *rngGrammar) (*rngPattern, error) {
+	g := &rngGrammar{
+		parent:  parent,
+		defines: make(map[string]*rngDefine),
+	}
+	g.start = &rngDefine{name: "start"}
+	c.defines = append(c.defines, g.start)
+	if parent == nil && c.top == nil {
+		c.top = g
+	}
+
+	err := c.grammarContent(g, node.children, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(g.start.parts) == 0 {
+		return nil, node.errorf("Grammar has no start pattern")
+	}
+	return &rngPattern{kind: rngRef, define: g.start}, nil
+}
+
+func (c *rngCompiler) grammarContent(g *rngGrammar, nodes []*rngNode, overrides map[string]bool) error {
+	for _, node := range nodes {
+		switch node.name {
+		case "start":
+			if overrides["start"] {
+				continue
+			}
+			p, err := c.group(node.children, g)
+			if err != nil {
+				return err
+			}
+			err = g.start.add(node.attrs["combine"], p)
+			if err != nil {
+				return node.errorf("%s", err)
+			}
+		case "define":
+			name := strings.TrimSpace(node.attrs["name"])
+			if overrides[name] {
+				continue
+			}
+			p, err := c.group(node.children, g)
+			if err != nil {
+				return err
+			}
+			_, exists := g.defines[name]
+			def := g.lookup(name)
+			if !exists {
+				c.defines = append(c.defines, def)
+			}
+			err = def.add(node.attrs["combine"], p)
+			if err != nil {
+				return node.errorf("%s", err)
+			}
+		case "div":
+			err := c.grammarContent(g, node.children, overrides)
+			if err != nil {
+				return err
+			}
+		case "include":
+			err := c.include(g, node, overrides)
+			if err != nil {
+				return err
+			}
+		default:
+			return node.errorf("Unexpected '%s' in grammar", node.name)
+		}
+	}
+	return nil
+}
+
+func (c *rngCompiler) include(g *rngGrammar, node *rngNode, overrides map[string]bool) error {
+	file := path.Join(path.Dir(node.file), node.attrs["href"])
+	root, err := c.parse(file)
+	if err != nil {
+		return err
+	}
+	if root.name != "grammar" {
+		return root.errorf("Included schema is not a grammar")
+	}
+
+	replaced := make(map[string]bool)
+	for name := range overrides {
+		replaced[name] = true
+	}
+	var collect func(nodes []*rngNode)
+	collect = func(nodes []*rngNode) {
+		for _, child := range nodes {
+			if child.name == "start" {
+				replaced["start"] = true
+			} else if child.name == "define" {
+				replaced[strings.TrimSpace(child.attrs["name"])] = true
+			} else if child.name == "div" {
+				collect(child.children)
+			}
+		}
+	}
+	collect(node.children)
+
+	c.loading[node.file] = true
+	err = c.grammarContent(g, root.children, replaced)
+	delete(c.loading, node.file)
+	if err != nil {
+		return err
+	}
+	return c.grammarContent(g, node.children, overrides)
+}
+
+func (c *rngCompiler) group(nodes []*rngNode, g *rngGrammar) (*rngPattern, error) {
+	var res *rngPattern
+	for _, node := range nodes {
+		p, err := c.pattern(node, g)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = p
+		} else {
+			res = &rngPattern{kind: rngGroup, a: res, b: p}
+		}
+	}
+	if res == nil {
+		return rngEmptyPattern, nil
+	}
+	return res, nil
+}
+
+func (c *rngCompiler) fold(kind int, nodes []*rngNode, g *rngGrammar) (*rngPattern, error) {
+	var res *rngPattern
+	for _, node := range nodes {
+		p, err := c.pattern(node, g)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = p
+		} else {
+			res = &rngPattern{kind: kind, a: res, b: p}
+		}
+	}
+	if res == nil {
+		return rngEmptyPattern, nil
+	}
+	return res, nil
+}
+
+func (c *rngCompiler) pattern(node *rngNode, g *rngGrammar) (*rngPattern, error) {
+	switch node.name {
+	case "element":
+		names, children, err := c.nameClassFor(node, node.ns)
+		if err != nil {
+			return nil, err
+		}
+		content, err := c.group(children, g)
+		if err != nil {
+			return nil, err
+		}
+		return &rngPattern{kind: rngElement, names: names, a: content}, nil
+	case "attribute":
+		ns := ""
+		if val, ok := node.attrs["ns"]; ok {
+			ns = val
+		}
+		names, children, err := c.nameClassFor(node, ns)
+		if err != nil {
+			return nil, err
+		}
+		content := rngTextPattern
+		if len(children) > 0 {
+			content, err = c.group(children, g)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &rngPattern{kind: rngAttribute, names: names, a: content}, nil
+	case "group":
+		return c.group(node.children, g)
+	case "interleave":
+		return c.fold(rngInterleave, node.children, g)
+	case "choice":
+		return c.fold(rngChoice, node.children, g)
+	case "mixed":
+		p, err := c.group(node.children, g)
+		if err != nil {
+			return nil, err
+		}
+		return &rngPattern{kind: rngInterleave, a: p, b: rngTextPattern}, nil
+	case "optional":
+		p, err := c.group(node.children, g)
+		if err != nil {
+			return nil, err
+		}
+		return &rngPattern{kind: rngChoice, a: p, b: rngEmptyPattern}, nil
+	case "zeroOrMore":
+		p, err := c.group(node.children, g)
+		if err != nil {
+			return nil, err
+		}
+		more := &rngPattern{kind: rngOneOrMore, a: p}
+		return &rngPattern{kind: rngChoice, a: more, b: rngEmptyPattern}, nil
+	case "oneOrMore":
+		p, err := c.group(node.children, g)
+		if err != nil {
+			return nil, err
+		}
+		return &rngPattern{kind: rngOneOrMore, a: p}, nil
+	case "list":
+		p, err := c.group(node.children, g)
+		if err != nil {
+			return nil, err
+		}
+		return &rngPattern{kind: rngList, a: p}, nil
+	case "empty":
+		return rngEmptyPattern, nil
+	case "text":
+		return rngTextPattern, nil
+	case "notAllowed":
+		return rngNotAllowedPattern, nil
+	case "ref", "parentRef":
+		if node.name == "parentRef" {
+			g = g.parent
+		}
+		if g == nil {
+			return nil, node.errorf("Reference to '%s' outside of a grammar", node.attrs["name"])
+		}
+		name := strings.TrimSpace(node.attrs["name"])
+		_, exists := g.defines[name]
+		def := g.lookup(name)
+		if !exists {
+			c.defines = append(c.defines, def)
+		}
+		return &rngPattern{kind: rngRef, define: def}, nil
+	case "grammar":
+		return c.grammar(node, g)
+	case "externalRef":
+		file := path.Join(path.Dir(node.file), node.attrs["href"])
+		root, err := c.parse(file)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := root.attrs["ns"]; !ok {
+			root.ns = node.ns
+		}
+		c.loading[node.file] = true
+		defer delete(c.loading, node.file)
+		return c.pattern(root, nil)
+	case "data":
+		datatype, err := newRNGDatatype(node.library, node.attrs["type"])
+		if err != nil {
+			return nil, node.errorf("%s", err)
+		}
+		var except *rngPattern
+		for _, child := range node.children {
+			if child.name == "param" {
+				err = datatype.addParam(strings.TrimSpace(child.attrs["name"]), child.text)
+				if err != nil {
+					return nil, child.errorf("%s", err)
+				}
+			} else if child.name == "except" {
+				except, err = c.fold(rngChoice, child.children, g)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				return nil, child.errorf("Unexpected '%s' in data", child.name)
+			}
+		}
+		return &rngPattern{kind: rngData, datatype: datatype, a: except}, nil
+	case "value":
+		library := node.library
+		typ, ok := node.attrs["type"]
+		if !ok {
+			library = ""
+			typ = "token"
+		}
+		datatype, err := newRNGDatatype(library, typ)
+		if err != nil {
+			return nil, node.errorf("%s", err)
+		}
+		return &rngPattern{kind: rngValue, datatype: datatype, value: node.text}, nil
+	}
+	return nil, node.errorf("Unexpected '%s' in pattern", node.name)
+}
+
+// nameClassFor returns the name class of an element or attribute
+// pattern, along with the remaining child patterns
+func (c *rngCompiler) nameClassFor(node *rngNode, ns string) (*rngNameClass, []*rngNode, error) {
+	if name, ok := node.attrs["name"]; ok {
+		names, err := c.qname(node, name, ns)
+		return names, node.children, err
+	}
+	if len(node.children) == 0 {
+		return nil, nil, node.errorf("Missing name for '%s'", node.name)
+	}
+	names, err := c.nameClass(node.children[0])
+	return names, node.children[1:], err
+}
+
+func (c *rngCompiler) qname(node *rngNode, name string, ns string) (*rngNameClass, error) {
+	name = strings.TrimSpace(name)
+	if idx := strings.Index(name, ":"); idx != -1 {
+		uri, ok := node.prefixes[name[0:idx]]
+		if !ok {
+			return nil, node.errorf("Undeclared namespace prefix in '%s'", name)
+		}
+		return &rngNameClass{kind: rngName, ns: uri, local: name[idx+1:]}, nil
+	}
+	return &rngNameClass{kind: rngName, ns: ns, local: name}, nil
+}
+
+func (c *rngCompiler) nameClass(node *rngNode) (*rngNameClass, error) {
+	switch node.name {
+	case "name":
+		return c.qname(node, node.text, node.ns)
+	case "anyName", "nsName":
+		names := &rngNameClass{kind: rngAnyName}
+		if node.name == "nsName" {
+			names.kind = rngNsName
+			names.ns = node.ns
+		}
+		for _, child := range node.children {
+			if child.name != "except" {
+				return nil, child.errorf("Unexpected '%s' in %s", child.name, node.name)
+			}
+			except, err := c.nameChoice(child.children)
+			if err != nil {
+				return nil, err
+			}
+			names.except = except
+		}
+		return names, nil
+	case "choice":
+		return c.nameChoice(node.children)
+	}
+	return nil, node.errorf("Unexpected '%s' in name class", node.name)
+}
+
+func (c *rngCompiler) nameChoice(nodes []*rngNode) (*rngNameClass, error) {
+	var res *rngNameClass
+	for _, node := range nodes {
+		names, err := c.nameClass(node)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = names
+		} else {
+			res = &rngNameClass{kind: rngNameChoice, a: res, b: names}
+		}
+	}
+	if res == nil {
+		return &rngNameClass{kind: rngName, local: ""}, nil
+	}
+	return res, nil
+}
+
+// resolve replaces all references with the pattern they refer to
+func (c *rngCompiler) resolve(start *rngPattern) (*rngPattern, error) {
+	deref := func(p *rngPattern) (*rngPattern, error) {
+		seen := make(map[*rngDefine]bool)
+		for p != nil && p.kind == rngRef {
+			if seen[p.define] {
+				return nil, fmt.Errorf("Recursive reference to '%s' outside an element", p.define.name)
+			}
+			seen[p.define] = true
+			p = p.define.pattern
+		}
+		return p, nil
+	}
+
+	start, err := deref(start)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := make(map[*rngPattern]bool)
+	stack := []*rngPattern{start}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[p] {
+			continue
+		}
+		visited[p] = true
+
+		for _, child := range []**rngPattern{&p.a, &p.b} {
+			if *child == nil {
+				continue
+			}
+			*child, err = deref(*child)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, *child)
+		}
+	}
+	return start, nil
+}
+
+type rngDatatype struct {
+	library  string
+	name     string
+	patterns []*regexp.Regexp
+	params   map[string]string
+}
+
+var xsdIntegerBounds = map[string][2]string{
+	"integer":            {"", ""},
+	"long":               {"-9223372036854775808", "9223372036854775807"},
+	"int":                {"-2147483648", "2147483647"},
+	"short":              {"-32768", "32767"},
+	"byte":               {"-128", "127"},
+	"nonNegativeInteger": {"0", ""},
+	"positiveInteger":    {"1", ""},
+	"nonPositiveInteger": {"", "0"},
+	"negativeInteger":    {"", "-1"},
+	"unsignedLong":       {"0", "18446744073709551615"},
+	"unsignedInt":        {"0", "4294967295"},
+	"unsignedShort":      {"0", "65535"},
+	"unsignedByte":       {"0", "255"},
+}
+
+var xsdStringTypes = map[string]bool{
+	"string":           true,
+	"normalizedString": true,
+	"token":            true,
+	"language":         true,
+	"anyURI":           true,
+	"NMTOKEN":          true,
+	"NMTOKENS":         true,
+	"Name":             true,
+	"NCName":           true,
+	"ID":               true,
+	"IDREF":            true,
+	"IDREFS":           true,
+	"QName":            true,
+	"base64Binary":     true,
+	"hexBinary":        true,
+	"dateTime":         true,
+	"date":             true,
+	"time":             true,
+	"duration":         true,
+}
+
+var xsdOtherTypes = map[string]bool{
+	"decimal": true,
+	"double":  true,
+	"float":   true,
+	"boolean": true,
+}
+
+func newRNGDatatype(library string, name string) (*rngDatatype, error) {
+	name = strings.TrimSpace(name)
+	if library == "" {
+		if name != "string" && name != "token" {
+			return nil, fmt.Errorf("Unknown builtin datatype '%s'", name)
+		}
+	} else if library == xsdDatatypes {
+		_, isInt := xsdIntegerBounds[name]
+		if !isInt && !xsdStringTypes[name] && !xsdOtherTypes[name] {
+			return nil, fmt.Errorf("Unsupported datatype '%s'", name)
+		}
+	} else {
+		return nil, fmt.Errorf("Unsupported datatype library '%s'", library)
+	}
+	return &rngDatatype{
+		library: library,
+		name:    name,
+		params:  make(map[string]string),
+	}, nil
+}
+
+func (t *rngDatatype) addParam(name string, value string) error {
+	if t.library == "" {
+		return fmt.Errorf("Builtin datatype '%s' takes no parameters", t.name)
+	}
+	switch name {
+	case "pattern":
+		re, err := compileXSDPattern(value)
+		if err != nil {
+			return fmt.Errorf("Invalid pattern '%s': %s", value, err)
+		}
+		t.patterns = append(t.patterns, re)
+	case "length", "minLength", "maxLength":
+		_, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+		if err != nil {
+			return fmt.Errorf("Invalid %s '%s'", name, value)
+		}
+		t.params[name] = strings.TrimSpace(value)
+	case "minInclusive", "maxInclusive", "minExclusive", "maxExclusive":
+		_, ok := new(big.Float).SetString(strings.TrimSpace(value))
+		if !ok {
+			return fmt.Errorf("Invalid %s '%s'", name, value)
+		}
+		t.params[name] = strings.TrimSpace(value)
+	default:
+		return fmt.Errorf("Unsupported parameter '%s' for datatype '%s'", name, t.name)
+	}
+	return nil
+}
+
+// The XML Schema escapes which differ from Go's, as they appear
+// outside and inside character classes. Negated escapes without an
+// entry in xsdClassEscapes cannot be expressed inside a class.
+var xsdEscapes = map[byte]string{
+	'i': `[_:\p{L}]`,
+	'I': `[^_:\p{L}]`,
+	'c': `[-._:\p{L}\p{Nd}\p{Mn}\p{Mc}]`,
+	'C': `[^-._:\p{L}\p{Nd}\p{Mn}\p{Mc}]`,
+	'd': `\p{Nd}`,
+	'D': `\P{Nd}`,
+	's': `[ \t\n\r]`,
+	'S': `[^ \t\n\r]`,
+	'w': `[\p{L}\p{M}\p{N}\p{S}]`,
+	'W': `[\p{P}\p{Z}\p{C}]`,
+}
+
+var xsdClassEscapes = map[byte]string{
+	'i': `_:\p{L}`,
+	'c': `\-._:\p{L}\p{Nd}\p{Mn}\p{Mc}`,
+	'd': `\p{Nd}`,
+	'D': `\P{Nd}`,
+	's': ` \t\n\r`,
+	'w': `\p{L}\p{M}\p{N}\p{S}`,
+	'W': `\p{P}\p{Z}\p{C}`,
+}
+
+// compileXSDPattern translates an XML Schema regular expression into
+// Go syntax. XML Schema patterns are implicitly anchored, treat ^ and
+// $ as ordinary characters and have escapes for XML name characters.
+// Character class subtraction has no Go equivalent and is rejected.
+func compileXSDPattern(pattern string) (*regexp.Regexp, error) {
+	var buf bytes.Buffer
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(pattern) {
+				return nil, fmt.Errorf("Trailing backslash")
+			}
+			i++
+			e := pattern[i]
+			if e == 'p' || e == 'P' {
+				end := strings.IndexByte(pattern[i:], '}')
+				if end == -1 || pattern[i+1] != '{' {
+					return nil, fmt.Errorf("Malformed category escape")
+				}
+				if strings.HasPrefix(pattern[i+2:], "Is") {
+					return nil, fmt.Errorf("Unicode block escapes are not supported")
+				}
+				buf.WriteString(pattern[i-1 : i+end+1])
+				i += end
+				continue
+			}
+			if strings.IndexByte(`nrt\\|.?*+(){}-[]^$`, e) != -1 {
+				buf.WriteByte('\\')
+				buf.WriteByte(e)
+				continue
+			}
+			repl, ok := xsdEscapes[e]
+			if inClass {
+				repl, ok = xsdClassEscapes[e]
+			}
+			if !ok {
+				return nil, fmt.Errorf("Unsupported escape '\\%c'", e)
+			}
+			buf.WriteString(repl)
+		case inClass && c == '-' && i+1 < len(pattern) && pattern[i+1] == '[':
+			return nil, fmt.Errorf("Character class subtraction is not supported")
+		case inClass && c == '[':
+			buf.WriteString(`\[`)
+		case inClass && c == ']':
+			inClass = false
+			buf.WriteByte(c)
+		case inClass:
+			buf.WriteByte(c)
+		case c == '[':
+			inClass = true
+			buf.WriteByte(c)
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				buf.WriteByte('^')
+				i++
+			}
+		case c == '(' && i+1 < len(pattern) && pattern[i+1] == '?':
+			return nil, fmt.Errorf("Unsupported group syntax")
+		case c == '^' || c == '$':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c == '.':
+			buf.WriteString(`[^\n\r]`)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return regexp.Compile("^(?:" + buf.String() + ")$")
+}
+
+func (t *rngDatatype) normalize(s string) string {
+	if t.name == "string" {
+		return s
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+var xsdDecimal = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+var xsdHexBinary = regexp.MustCompile(`^([0-9a-fA-F]{2})*$`)
+
+func (t *rngDatatype) allows(s string) bool {
+	if t.library == "" {
+		return true
+	}
+	s = t.normalize(s)
+
+	var num *big.Float
+	if bounds, ok := xsdIntegerBounds[t.name]; ok {
+		val, ok := new(big.Int).SetString(strings.TrimPrefix(s, "+"), 10)
+		if !ok {
+			return false
+		}
+		if bounds[0] != "" {
+			min, _ := new(big.Int).SetString(bounds[0], 10)
+			if val.Cmp(min) < 0 {
+				return false
+			}
+		}
+		if bounds[1] != "" {
+			max, _ := new(big.Int).SetString(bounds[1], 10)
+			if val.Cmp(max) > 0 {
+				return false
+			}
+		}
+		num = new(big.Float).SetInt(val)
+	} else {
+		switch t.name {
+		case "decimal":
+			if !xsdDecimal.MatchString(s) {
+				return false
+			}
+			num, _ = new(big.Float).SetString(s)
+		case "double", "float":
+			if s != "INF" && s != "-INF" && s != "NaN" {
+				val, err := strconv.ParseFloat(s, 64)
+				if err != nil {
+					return false
+				}
+				num = big.NewFloat(val)
+			}
+		case "boolean":
+			if s != "true" && s != "false" && s != "1" && s != "0" {
+				return false
+			}
+		case "hexBinary":
+			if !xsdHexBinary.MatchString(s) {
+				return false
+			}
+		case "Name", "NCName", "ID", "IDREF", "NMTOKEN", "QName":
+			if s == "" || strings.ContainsAny(s, " \t\r\n") {
+				return false
+			}
+			if t.name != "Name" && t.name != "QName" && t.name != "NMTOKEN" && strings.Contains(s, ":") {
+				return false
+			}
+		}
+	}
+
+	for _, re := range t.patterns {
+		if !re.MatchString(s) {
+			return false
+		}
+	}
+
+	length := uint64(utf8.RuneCountInString(s))
+	for name, val := range t.params {
+		switch name {
+		case "length", "minLength", "maxLength":
+			limit, _ := strconv.ParseUint(val, 10, 32)
+			if (name == "length" && length != limit) ||
+				(name == "minLength" && length < limit) ||
+				(name == "maxLength" && length > limit) {
+				return false
+			}
+		default:
+			if num == nil {
+				return false
+			}
+			limit, _ := new(big.Float).SetString(val)
+			cmp := num.Cmp(limit)
+			if (name == "minInclusive" && cmp < 0) ||
+				(name == "maxInclusive" && cmp > 0) ||
+				(name == "minExclusive" && cmp <= 0) ||
+				(name == "maxExclusive" && cmp >= 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (t *rngDatatype) equal(s1, s2 string) bool {
+	if _, ok := xsdIntegerBounds[t.name]; ok {
+		v1, ok1 := new(big.Int).SetString(strings.TrimPrefix(t.normalize(s1), "+"), 10)
+		v2, ok2 := new(big.Int).SetString(strings.TrimPrefix(t.normalize(s2), "+"), 10)
+		if ok1 && ok2 {
+			return v1.Cmp(v2) == 0
+		}
+	}
+	return t.normalize(s1) == t.normalize(s2)
+}
+
+func isXMLSpace(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+type rngKey struct {
+	kind int
+	a    *rngPattern
+	b    *rngPattern
+}
+
+// rngValidator holds the patterns derived while validating a
+// document. Derived patterns are interned so that identical
+// alternatives collapse in choices.
+type rngValidator struct {
+	interned map[rngKey]*rngPattern
+}
+
+func (v *rngValidator) intern(kind int, a, b *rngPattern) *rngPattern {
+	key := rngKey{kind, a, b}
+	p, ok := v.interned[key]
+	if !ok {
+		p = &rngPattern{kind: kind, a: a, b: b}
+		v.interned[key] = p
+	}
+	return p
+}
+
+func (v *rngValidator) choice(a, b *rngPattern) *rngPattern {
+	if a.kind == rngNotAllowed || a == b {
+		return b
+	}
+	if b.kind == rngNotAllowed {
+		return a
+	}
+	return v.intern(rngChoice, a, b)
+}
+
+func (v *rngValidator) group(a, b *rngPattern) *rngPattern {
+	if a.kind == rngNotAllowed || b.kind == rngNotAllowed {
+		return rngNotAllowedPattern
+	}
+	if a.kind == rngEmpty {
+		return b
+	}
+	if b.kind == rngEmpty {
+		return a
+	}
+	return v.intern(rngGroup, a, b)
+}
+
+func (v *rngValidator) interleave(a, b *rngPattern) *rngPattern {
+	if a.kind == rngNotAllowed || b.kind == rngNotAllowed {
+		return rngNotAllowedPattern
+	}
+	if a.kind == rngEmpty {
+		return b
+	}
+	if b.kind == rngEmpty {
+		return a
+	}
+	return v.intern(rngInterleave, a, b)
+}
+
+func (v *rngValidator) after(a, b *rngPattern) *rngPattern {
+	if a.kind == rngNotAllowed || b.kind == rngNotAllowed {
+		return rngNotAllowedPattern
+	}
+	return v.intern(rngAfter, a, b)
+}
+
+func (v *rngValidator) oneOrMore(a *rngPattern) *rngPattern {
+	if a.kind == rngNotAllowed {
+		return rngNotAllowedPattern
+	}
+	return v.intern(rngOneOrMore, a, nil)
+}
+
+func nullable(p *rngPattern) bool {
+	switch p.kind {
+	case rngEmpty, rngText:
+		return true
+	case rngChoice:
+		return nullable(p.a) || nullable(p.b)
+	case rngGroup, rngInterleave:
+		return nullable(p.a) && nullable(p.b)
+	case rngOneOrMore:
+		return nullable(p.a)
+	}
+	return false
+}
+
+func (v *rngValidator) applyAfter(p *rngPattern, f func(*rngPattern) *rngPattern) *rngPattern {
+	switch p.kind {
+	case rngAfter:
+		return v.after(p.a, f(p.b))
+	case rngChoice:
+		return v.choice(v.applyAfter(p.a, f), v.applyAfter(p.b, f))
+	}
+	return rngNotAllowedPattern
+}
+
+func (v *rngValidator) textDeriv(p *rngPattern, s string) *rngPattern {
+	switch p.kind {
+	case rngChoice:
+		return v.choice(v.textDeriv(p.a, s), v.textDeriv(p.b, s))
+	case rngInterleave:
+		return v.choice(v.interleave(v.textDeriv(p.a, s), p.b),
+			v.interleave(p.a, v.textDeriv(p.b, s)))
+	case rngGroup:
+		res := v.group(v.textDeriv(p.a, s), p.b)
+		if nullable(p.a) {
+			res = v.choice(res, v.textDeriv(p.b, s))
+		}
+		return res
+	case rngAfter:
+		return v.after(v.textDeriv(p.a, s), p.b)
+	case rngOneOrMore:
+		return v.group(v.textDeriv(p.a, s), v.choice(p, rngEmptyPattern))
+	case rngText:
+		return p
+	case rngValue:
+		if p.datatype.equal(p.value, s) {
+			return rngEmptyPattern
+		}
+	case rngData:
+		if p.datatype.allows(s) && (p.a == nil || !nullable(v.textDeriv(p.a, s))) {
+			return rngEmptyPattern
+		}
+	case rngList:
+		res := p.a
+		for _, word := range strings.Fields(s) {
+			res = v.textDeriv(res, word)
+		}
+		if nullable(res) {
+			return rngEmptyPattern
+		}
+	}
+	return rngNotAllowedPattern
+}
+
+func (v *rngValidator) startTagOpenDeriv(p *rngPattern, name xml.Name) *rngPattern {
+	switch p.kind {
+	case rngChoice:
+		return v.choice(v.startTagOpenDeriv(p.a, name), v.startTagOpenDeriv(p.b, name))
+	case rngElement:
+		if p.names.contains(name) {
+			return v.after(p.a, rngEmptyPattern)
+		}
+	case rngInterleave:
+		return v.choice(
+			v.applyAfter(v.startTagOpenDeriv(p.a, name), func(x *rngPattern) *rngPattern {
+				return v.interleave(x, p.b)
+			}),
+			v.applyAfter(v.startTagOpenDeriv(p.b, name), func(x *rngPattern) *rngPattern {
+				return v.interleave(p.a, x)
+			}))
+	case rngOneOrMore:
+		return v.applyAfter(v.startTagOpenDeriv(p.a, name), func(x *rngPattern) *rngPattern {
+			return v.group(x, v.choice(p, rngEmptyPattern))
+		})
+	case rngGroup:
+		res := v.applyAfter(v.startTagOpenDeriv(p.a, name), func(x *rngPattern) *rngPattern {
+			return v.group(x, p.b)
+		})
+		if nullable(p.a) {
+			res = v.choice(res, v.startTagOpenDeriv(p.b, name))
+		}
+		return res
+	case rngAfter:
+		return v.applyAfter(v.startTagOpenDeriv(p.a, name), func(x *rngPattern) *rngPattern {
+			return v.after(x, p.b)
+		})
+	}
+	return rngNotAllowedPattern
+}
+
+func (v *rngValidator) attDeriv(p *rngPattern, attr xml.Attr) *rngPattern {
+	switch p.kind {
+	case rngAfter:
+		return v.after(v.attDeriv(p.a, attr), p.b)
+	case rngChoice:
+		return v.choice(v.attDeriv(p.a, attr), v.attDeriv(p.b, attr))
+	case rngGroup:
+		return v.choice(v.group(v.attDeriv(p.a, attr), p.b),
+			v.group(p.a, v.attDeriv(p.b, attr)))
+	case rngInterleave:
+		return v.choice(v.interleave(v.attDeriv(p.a, attr), p.b),
+			v.interleave(p.a, v.attDeriv(p.b, attr)))
+	case rngOneOrMore:
+		return v.group(v.attDeriv(p.a, attr), v.choice(p, rngEmptyPattern))
+	case rngAttribute:
+		if p.names.contains(attr.Name) && v.valueMatch(p.a, attr.Value) {
+			return rngEmptyPattern
+		}
+	}
+	return rngNotAllowedPattern
+}
+
+// attNamed reports whether any attribute pattern which may apply
+// next accepts the given attribute name, regardless of its value
+func attNamed(p *rngPattern, name xml.Name) bool {
+	switch p.kind {
+	case rngAfter, rngOneOrMore:
+		return attNamed(p.a, name)
+	case rngChoice, rngGroup, rngInterleave:
+		return attNamed(p.a, name) || attNamed(p.b, name)
+	case rngAttribute:
+		return p.names.contains(name)
+	}
+	return false
+}
+
+func (v *rngValidator) valueMatch(p *rngPattern, s string) bool {
+	return (nullable(p) && isXMLSpace(s)) || nullable(v.textDeriv(p, s))
+}
+
+func (v *rngValidator) startTagCloseDeriv(p *rngPattern) *rngPattern {
+	switch p.kind {
+	case rngAfter:
+		return v.after(v.startTagCloseDeriv(p.a), p.b)
+	case rngChoice:
+		return v.choice(v.startTagCloseDeriv(p.a), v.startTagCloseDeriv(p.b))
+	case rngGroup:
+		return v.group(v.startTagCloseDeriv(p.a), v.startTagCloseDeriv(p.b))
+	case rngInterleave:
+		return v.interleave(v.startTagCloseDeriv(p.a), v.startTagCloseDeriv(p.b))
+	case rngOneOrMore:
+		return v.oneOrMore(v.startTagCloseDeriv(p.a))
+	case rngAttribute:
+		return rngNotAllowedPattern
+	}
+	return p
+}
+
+func (v *rngValidator) endTagDeriv(p *rngPattern) *rngPattern {
+	switch p.kind {
+	case rngChoice:
+		return v.choice(v.endTagDeriv(p.a), v.endTagDeriv(p.b))
+	case rngAfter:
+		if nullable(p.a) {
+			return p.b
+		}
+	}
+	return rngNotAllowedPattern
+}
+
+// continuation returns the pattern matching whatever may follow an
+// element, given the derivative of its start tag. It is used to carry
+// on validating the siblings of an invalid element.
+func (v *rngValidator) continuation(p *rngPattern) *rngPattern {
+	switch p.kind {
+	case rngChoice:
+		return v.choice(v.continuation(p.a), v.continuation(p.b))
+	case rngAfter:
+		return p.b
+	}
+	return rngNotAllowedPattern
+}
+
+type rngInstance struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	line     int
+	text     string
+	isText   bool
+	children []*rngInstance
+}
+
+func (n *rngInstance) displayName() string {
+	if n.name.Space == "" {
+		return n.name.Local
+	}
+	return "{" + n.name.Space + "}" + n.name.Local
+}
+
+func parseRNGInstance(doc string) (*rngInstance, error) {
+	d := xml.NewDecoder(strings.NewReader(doc))
+	lines := &rngLines{data: doc}
+	var root *rngInstance
+	var stack []*rngInstance
+	for {
+		line := lines.at(d.InputOffset())
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			node := &rngInstance{
+				name: tok.Name,
+				line: line,
+			}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				node.attrs = append(node.attrs, attr)
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) == 0 {
+				continue
+			}
+			parent := stack[len(stack)-1]
+			n := len(parent.children)
+			if n > 0 && parent.children[n-1].isText {
+				parent.children[n-1].text += string(tok)
+			} else {
+				parent.children = append(parent.children, &rngInstance{
+					text:   string(tok),
+					isText: true,
+					line:   line,
+				})
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("Document has no root element")
+	}
+	return root, nil
+}
+
+func (v *rngValidator) element(p *rngPattern, node *rngInstance, parent *rngInstance, errs *SchemaErrors) *rngPattern {
+	p1 := v.startTagOpenDeriv(p, node.name)
+	if p1.kind == rngNotAllowed {
+		if parent == nil {
+			errs.add(node.line, "Unexpected root element '%s'", node.displayName())
+		} else {
+			errs.add(node.line, "Element '%s' is not allowed in '%s'", node.displayName(), parent.displayName())
+		}
+		return p
+	}
+	cont := v.continuation(p1)
+
+	for _, attr := range node.attrs {
+		p2 := v.attDeriv(p1, attr)
+		if p2.kind == rngNotAllowed {
+			if attNamed(p1, attr.Name) {
+				errs.add(node.line, "Invalid value '%s' for attribute '%s' of '%s'",
+					attr.Value, attr.Name.Local, node.displayName())
+			} else {
+				errs.add(node.line, "Attribute '%s' is not allowed on '%s'",
+					attr.Name.Local, node.displayName())
+			}
+			continue
+		}
+		p1 = p2
+	}
+
+	p2 := v.startTagCloseDeriv(p1)
+	if p2.kind == rngNotAllowed {
+		errs.add(node.line, "Element '%s' is missing required attributes", node.displayName())
+		return cont
+	}
+
+	var texts []*rngInstance
+	elements := 0
+	for _, child := range node.children {
+		if child.isText {
+			texts = append(texts, child)
+		} else {
+			elements++
+		}
+	}
+
+	if elements == 0 {
+		text := ""
+		line := node.line
+		if len(texts) > 0 {
+			text = texts[0].text
+			line = texts[0].line
+		}
+		p3 := v.textDeriv(p2, text)
+		if isXMLSpace(text) {
+			p3 = v.choice(p2, p3)
+		}
+		if p3.kind == rngNotAllowed {
+			errs.add(line, "Invalid content '%s' in '%s'", strings.TrimSpace(text), node.displayName())
+			return cont
+		}
+		p2 = p3
+	} else {
+		for _, child := range node.children {
+			if child.isText {
+				if isXMLSpace(child.text) {
+					continue
+				}
+				p3 := v.textDeriv(p2, child.text)
+				if p3.kind == rngNotAllowed {
+					errs.add(child.line, "Text is not allowed in '%s'", node.displayName())
+					continue
+				}
+				p2 = p3
+			} else {
+				p2 = v.element(p2, child, node, errs)
+				if p2.kind == rngNotAllowed {
+					return cont
+				}
+			}
+		}
+	}
+
+	p4 := v.endTagDeriv(p2)
+	if p4.kind == rngNotAllowed {
+		errs.add(node.line, "Element '%s' is missing required content", node.displayName())
+		return cont
+	}
+	return p4
+}
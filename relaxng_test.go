@@ -0,0 +1,373 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func compileTestRNG(files map[string]string, define string) (*Schema, error) {
+	load := func(name string) ([]byte, error) {
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("No such file %s", name)
+		}
+		return []byte(data), nil
+	}
+	start, err := compileRNG(load, "main.rng", define)
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{start: start}, nil
+}
+
+var relaxngTestFiles = map[string]string{
+	"main.rng": strings.Join([]string{
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"`,
+		`    xmlns:a="http://relaxng.org/ns/compatibility/annotations/1.0"`,
+		`    xmlns:demo="http://example.org/demo"`,
+		`    datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">`,
+		`  <a:documentation>Test schema</a:documentation>`,
+		`  <include href="types.rng">`,
+		`    <define name="name">`,
+		`      <data type="string">`,
+		`        <param name="pattern">[a-z]+</param>`,
+		`      </data>`,
+		`    </define>`,
+		`  </include>`,
+		`  <start>`,
+		`    <element name="machine">`,
+		`      <attribute name="type">`,
+		`        <choice>`,
+		`          <value>kvm</value>`,
+		`          <value>qemu</value>`,
+		`        </choice>`,
+		`      </attribute>`,
+		`      <optional>`,
+		`        <attribute name="id">`,
+		`          <ref name="count"/>`,
+		`        </attribute>`,
+		`      </optional>`,
+		`      <interleave>`,
+		`        <element name="name">`,
+		`          <ref name="name"/>`,
+		`        </element>`,
+		`        <optional>`,
+		`          <element name="cpus">`,
+		`            <list>`,
+		`              <oneOrMore>`,
+		`                <ref name="count"/>`,
+		`              </oneOrMore>`,
+		`            </list>`,
+		`          </element>`,
+		`        </optional>`,
+		`        <zeroOrMore>`,
+		`          <ref name="disk"/>`,
+		`        </zeroOrMore>`,
+		`        <optional>`,
+		`          <element name="description">`,
+		`            <mixed>`,
+		`              <zeroOrMore>`,
+		`                <element name="b"><text/></element>`,
+		`              </zeroOrMore>`,
+		`            </mixed>`,
+		`          </element>`,
+		`        </optional>`,
+		`        <optional>`,
+		`          <element name="metadata">`,
+		`            <zeroOrMore>`,
+		`              <element>`,
+		`                <anyName>`,
+		`                  <except><nsName ns=""/></except>`,
+		`                </anyName>`,
+		`                <zeroOrMore>`,
+		`                  <attribute><anyName/></attribute>`,
+		`                </zeroOrMore>`,
+		`                <text/>`,
+		`              </element>`,
+		`            </zeroOrMore>`,
+		`          </element>`,
+		`        </optional>`,
+		`        <optional>`,
+		`          <element name="demo:extra"><empty/></element>`,
+		`        </optional>`,
+		`      </interleave>`,
+		`    </element>`,
+		`  </start>`,
+		`  <define name="disk" combine="choice">`,
+		`    <element name="disk">`,
+		`      <attribute name="type"><value>file</value></attribute>`,
+		`      <element name="source">`,
+		`        <attribute name="file"><text/></attribute>`,
+		`      </element>`,
+		`    </element>`,
+		`  </define>`,
+		`</grammar>`,
+	}, "\n"),
+	"types.rng": strings.Join([]string{
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"`,
+		`    datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">`,
+		`  <define name="name">`,
+		`    <notAllowed/>`,
+		`  </define>`,
+		`  <define name="count">`,
+		`    <data type="unsignedInt">`,
+		`      <param name="maxInclusive">64</param>`,
+		`    </data>`,
+		`  </define>`,
+		`  <define name="disk" combine="choice">`,
+		`    <element name="disk">`,
+		`      <attribute name="type"><value>block</value></attribute>`,
+		`      <element name="source">`,
+		`        <attribute name="dev"><text/></attribute>`,
+		`      </element>`,
+		`    </element>`,
+		`  </define>`,
+		`</grammar>`,
+	}, "\n"),
+}
+
+var relaxngValidTestData = [][]string{
+	[]string{
+		`<machine type="kvm">`,
+		`  <name>demo</name>`,
+		`</machine>`,
+	},
+	[]string{
+		`<machine type="qemu" id=" 7 ">`,
+		`  <disk type="block">`,
+		`    <source dev="/dev/sda"/>`,
+		`  </disk>`,
+		`  <cpus>1 2`,
+		`    3</cpus>`,
+		`  <description>A <b>small</b> machine</description>`,
+		`  <name>demo</name>`,
+		`  <disk type="file"><source file="/demo.img"/></disk>`,
+		`  <metadata>`,
+		`    <app:info xmlns:app="http://example.org/app" app:version="1">text</app:info>`,
+		`  </metadata>`,
+		`  <extra xmlns="http://example.org/demo"/>`,
+		`</machine>`,
+	},
+}
+
+var relaxngInvalidTestData = []struct {
+	Document []string
+	Errors   []string
+}{
+	{
+		Document: []string{
+			`<domain type="kvm">`,
+			`  <name>demo</name>`,
+			`</domain>`,
+		},
+		Errors: []string{
+			"Line 1: Unexpected root element 'domain'",
+		},
+	},
+	{
+		Document: []string{
+			`<machine type="xen" id="65">`,
+			`  <name>demo</name>`,
+			`</machine>`,
+		},
+		Errors: []string{
+			"Line 1: Invalid value 'xen' for attribute 'type' of 'machine'",
+			"Line 1: Invalid value '65' for attribute 'id' of 'machine'",
+			"Line 1: Element 'machine' is missing required attributes",
+		},
+	},
+	{
+		Document: []string{
+			`<machine type="kvm" arch="x86_64">`,
+			`  <name>Demo</name>`,
+			`  <disk type="file">`,
+			`    <source dev="/dev/sda"/>`,
+			`  </disk>`,
+			`  <cpus>1 two</cpus>`,
+			`  <clock/>`,
+			`  <metadata>`,
+			`    <info/>`,
+			`  </metadata>`,
+			`</machine>`,
+		},
+		Errors: []string{
+			"Line 1: Attribute 'arch' is not allowed on 'machine'",
+			"Line 2: Invalid content 'Demo' in 'name'",
+			"Line 4: Attribute 'dev' is not allowed on 'source'",
+			"Line 4: Element 'source' is missing required attributes",
+			"Line 6: Invalid content '1 two' in 'cpus'",
+			"Line 7: Element 'clock' is not allowed in 'machine'",
+			"Line 9: Element 'info' is not allowed in 'metadata'",
+		},
+	},
+	{
+		Document: []string{
+			`<machine type="kvm">`,
+			`  <disk type="block"></disk>`,
+			`</machine>`,
+		},
+		Errors: []string{
+			"Line 2: Element 'disk' is missing required content",
+			"Line 1: Element 'machine' is missing required content",
+		},
+	},
+	{
+		Document: []string{
+			`<machine type="kvm">`,
+			`  <name>demo</name>`,
+			`  <extra>text</extra>`,
+			`  <description>A <i>small</i> machine</description>`,
+			`</machine>`,
+		},
+		Errors: []string{
+			"Line 3: Element 'extra' is not allowed in 'machine'",
+			"Line 4: Element 'i' is not allowed in 'description'",
+		},
+	},
+}
+
+func TestRelaxNGValidate(t *testing.T) {
+	schema, err := compileTestRNG(relaxngTestFiles, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, doc := range relaxngValidTestData {
+		err = schema.Validate(strings.Join(doc, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range relaxngInvalidTestData {
+		err = schema.Validate(strings.Join(test.Document, "\n"))
+		if err == nil {
+			t.Fatalf("Expected errors for document %s", test.Document[0])
+		}
+		expect := strings.Join(test.Errors, "\n")
+		if err.Error() != expect {
+			t.Fatal("Bad errors:\n", err, "\n do not match\n", expect, "\n")
+		}
+	}
+
+	err = schema.Validate(`<machine type="kvm"><name>demo</machine>`)
+	if err == nil {
+		t.Fatal("Expected error for malformed document")
+	}
+	if _, ok := err.(SchemaErrors); ok {
+		t.Fatal("Expected XML syntax error for malformed document")
+	}
+}
+
+func TestRelaxNGDefine(t *testing.T) {
+	schema, err := compileTestRNG(relaxngTestFiles, "disk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, doc := range []string{
+		`<disk type="file"><source file="/srv/demo.img"/></disk>`,
+		`<disk type="block"><source dev="/dev/sda"/></disk>`,
+	} {
+		err = schema.Validate(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = schema.Validate(`<machine type="kvm"><name>demo</name></machine>`)
+	if err == nil {
+		t.Fatal("Expected error validating a whole document against a define")
+	}
+
+	_, err = compileTestRNG(relaxngTestFiles, "missing")
+	if err == nil {
+		t.Fatal("Expected error for unknown define")
+	}
+}
+
+func TestRelaxNGCompileErrors(t *testing.T) {
+	tests := []string{
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"><define name="a"><empty/></define></grammar>`,
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"><start><ref name="missing"/></start></grammar>`,
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"><start><ref name="a"/></start><define name="a"><ref name="a"/></define></grammar>`,
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"><start><data type="unsignedInt"/></start></grammar>`,
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0" datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes"><start><data type="string"><param name="pattern">[a-</param></data></start></grammar>`,
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"><start><element name="x:a"><empty/></element></start></grammar>`,
+		`<grammar xmlns="http://relaxng.org/ns/structure/1.0"><start><include href="missing.rng"/></start></grammar>`,
+	}
+
+	for _, test := range tests {
+		_, err := compileTestRNG(map[string]string{"main.rng": test}, "")
+		if err == nil {
+			t.Fatalf("Expected error compiling %s", test)
+		}
+	}
+}
+
+func TestXSDPattern(t *testing.T) {
+	tests := []struct {
+		Pattern string
+		Match   []string
+		NoMatch []string
+	}{
+		{`\i\c*`, []string{"a", "_b-1.c", "vé"}, []string{"1a", "a b", ""}},
+		{`\$[0-9]+\^?`, []string{"$10", "$1^"}, []string{"10"}},
+		{`$[0-9]+^`, []string{"$10^"}, []string{"10"}},
+		{`[$^a]+`, []string{"$^a"}, []string{"b"}},
+		{`[^\s]+`, []string{"abc"}, []string{"a b"}},
+		{`\d+`, []string{"42", "٤٢"}, []string{"4a"}},
+		{`a.c`, []string{"abc"}, []string{"a\rc", "a\nc"}},
+		{`[\d\-]+`, []string{"1-2"}, []string{"a"}},
+		{`/[a-zA-Z0-9_\.\+\-\\&"'<>/%]+`, []string{"/srv/demo.img"}, []string{"srv"}},
+	}
+
+	for _, test := range tests {
+		re, err := compileXSDPattern(test.Pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range test.Match {
+			if !re.MatchString(s) {
+				t.Fatalf("Expected %s to match %q", test.Pattern, s)
+			}
+		}
+		for _, s := range test.NoMatch {
+			if re.MatchString(s) {
+				t.Fatalf("Expected %s not to match %q", test.Pattern, s)
+			}
+		}
+	}
+
+	for _, pattern := range []string{`[a-z-[aeiou]]`, `[\i-[:]][\c-[:]]*`, `\p{IsBasicLatin}`, `[\S]`, `(?i)a`, `\b`, `a\`} {
+		_, err := compileXSDPattern(pattern)
+		if err == nil {
+			t.Fatalf("Expected error compiling %s", pattern)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+//go:generate go run schema_data_gen.go
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+var schemaCache = struct {
+	sync.Mutex
+	schemas map[string]*Schema
+}{
+	schemas: make(map[string]*Schema),
+}
+
+// A Schema is a compiled RelaxNG schema, such as one of the
+// schemas libvirt uses to validate its XML documents
+type Schema struct {
+	start *rngPattern
+}
+
+// A SchemaError describes a part of a document which does not
+// conform to a schema
+type SchemaError struct {
+	Line    int
+	Message string
+}
+
+func (e SchemaError) Error() string {
+	return fmt.Sprintf("Line %d: %s", e.Line, e.Message)
+}
+
+// SchemaErrors is the list of problems found when validating a
+// document, in document order
+type SchemaErrors []SchemaError
+
+func (e SchemaErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *SchemaErrors) add(line int, format string, args ...interface{}) {
+	*e = append(*e, SchemaError{
+		Line:    line,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// LoadSchema compiles the RelaxNG schema in the given file, for
+// example /usr/share/libvirt/schemas/domain.rng. Included schemas
+// are loaded relative to the file including them.
+func LoadSchema(filename string) (*Schema, error) {
+	load := func(name string) ([]byte, error) {
+		return ioutil.ReadFile(filepath.FromSlash(name))
+	}
+	start, err := compileRNG(load, filepath.ToSlash(filename), "")
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{start: start}, nil
+}
+
+// loadVendoredSchema compiles one of the vendored schemas, starting
+// from the given define unless it is empty
+func loadVendoredSchema(file string, define string) (*Schema, error) {
+	schemaCache.Lock()
+	defer schemaCache.Unlock()
+
+	key := file + "#" + define
+	schema, ok := schemaCache.schemas[key]
+	if ok {
+		return schema, nil
+	}
+
+	load := func(name string) ([]byte, error) {
+		data, ok := schemaFiles[name]
+		if !ok {
+			return nil, fmt.Errorf("No vendored schema '%s'", name)
+		}
+		return []byte(data), nil
+	}
+	start, err := compileRNG(load, file, define)
+	if err != nil {
+		return nil, err
+	}
+	schema = &Schema{start: start}
+	schemaCache.schemas[key] = schema
+	return schema, nil
+}
+
+// Validate checks the XML document against the schema. Problems with
+// the document content are reported as SchemaErrors, carrying the line
+// numbers of the offending elements.
+func (s *Schema) Validate(doc string) error {
+	root, err := parseRNGInstance(doc)
+	if err != nil {
+		return err
+	}
+
+	v := &rngValidator{
+		interned: make(map[rngKey]*rngPattern),
+	}
+	var errs SchemaErrors
+	p := v.element(s.start, root, nil, &errs)
+	if len(errs) == 0 && !nullable(p) {
+		errs.add(root.line, "Document is incomplete")
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateDocument(doc Document, file string, define string) error {
+	s, err := loadVendoredSchema(file, define)
+	if err != nil {
+		return err
+	}
+	xml, err := doc.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.Validate(xml)
+}
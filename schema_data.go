@@ -0,0 +1,130 @@
+// Code generated by schema_data_gen.go. DO NOT EDIT.
+
+package libvirtxml
+
+// Schemas vendored from libvirt, keyed by file name
+var schemaFiles = map[string]string{
+	"basictypes.rng": `<?xml version="1.0"?>
+<!-- network-related definitions used in multiple grammars -->
+<!-- Only the definitions used by the schemas vendored alongside are
+     kept here; the remainder live in libvirt's docs/schemas -->
+<grammar xmlns="http://relaxng.org/ns/structure/1.0" datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">
+
+  <define name="UUID">
+    <choice>
+      <data type="string">
+        <param name="pattern">[a-fA-F0-9]{32}</param>
+      </data>
+      <data type="string">
+        <param name="pattern">[a-fA-F0-9]{8}\-([a-fA-F0-9]{4}\-){3}[a-fA-F0-9]{12}</param>
+      </data>
+    </choice>
+  </define>
+
+  <define name="absFilePath">
+    <data type="string">
+      <param name="pattern">/[a-zA-Z0-9_\.\+\-\\&amp;&quot;&apos;&lt;&gt;/%,:]+</param>
+    </data>
+  </define>
+
+  <define name="genericName">
+    <data type="string">
+      <param name="pattern">[a-zA-Z0-9_\+\-]+</param>
+    </data>
+  </define>
+
+</grammar>
+`,
+	"secret.rng": `<?xml version="1.0"?>
+<!-- A Relax NG schema for the libvirt secret properties XML format -->
+<grammar xmlns="http://relaxng.org/ns/structure/1.0"
+    datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">
+  <start>
+    <ref name='secret'/>
+  </start>
+
+  <include href='basictypes.rng'/>
+
+  <define name='secret'>
+    <element name='secret'>
+      <optional>
+        <attribute name='ephemeral'>
+          <choice>
+            <value>yes</value>
+            <value>no</value>
+          </choice>
+        </attribute>
+      </optional>
+      <optional>
+        <attribute name='private'>
+          <choice>
+            <value>yes</value>
+            <value>no</value>
+          </choice>
+        </attribute>
+      </optional>
+      <interleave>
+        <optional>
+          <element name='uuid'>
+            <ref name='UUID'/>
+          </element>
+        </optional>
+        <optional>
+          <element name='description'>
+            <text/>
+          </element>
+        </optional>
+        <optional>
+          <element name='usage'>
+            <choice>
+              <ref name='usagevolume'/>
+              <ref name='usageceph'/>
+              <ref name='usageiscsi'/>
+              <ref name='usagetls'/>
+              <!-- More choices later -->
+            </choice>
+          </element>
+        </optional>
+      </interleave>
+    </element>
+  </define>
+
+  <define name='usagevolume'>
+    <attribute name='type'>
+      <value>volume</value>
+    </attribute>
+    <element name='volume'>
+      <ref name='absFilePath'/>
+    </element>
+  </define>
+
+  <define name='usageceph'>
+    <attribute name='type'>
+      <value>ceph</value>
+    </attribute>
+    <element name='name'>
+      <text/>
+    </element>
+  </define>
+
+  <define name='usageiscsi'>
+    <attribute name='type'>
+      <value>iscsi</value>
+    </attribute>
+    <element name='target'>
+      <ref name='genericName'/>
+    </element>
+  </define>
+
+  <define name='usagetls'>
+    <attribute name='type'>
+      <value>tls</value>
+    </attribute>
+    <element name='name'>
+      <text/>
+    </element>
+  </define>
+
+</grammar>
+`,
+}
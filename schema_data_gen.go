@@ -0,0 +1,139 @@
+// +build ignore
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+// This program turns the schemas vendored in the schemas directory
+// into string constants in schema_data.go, so that they are built into
+// the package. Run it with "go generate" after changing any of them.
+// To vendor the schemas of a libvirt checkout, first replacing those
+// in the schemas directory, give the directory holding them:
+//
+//   go run schema_data_gen.go -libvirt testdata/libvirt/src/conf/schemas
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// vendor replaces the schemas in the schemas directory by those in
+// dir
+func vendor(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	old, err := ioutil.ReadDir("schemas")
+	if err != nil {
+		return err
+	}
+	for _, file := range old {
+		if strings.HasSuffix(file.Name(), ".rng") {
+			err = os.Remove("schemas/" + file.Name())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".rng") {
+			continue
+		}
+		data, err := ioutil.ReadFile(dir + "/" + file.Name())
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile("schemas/"+file.Name(), data, 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	libvirt := flag.String("libvirt", "", "directory of the libvirt schemas to vendor")
+	flag.Parse()
+
+	if *libvirt != "" {
+		err := vendor(*libvirt)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	files, err := ioutil.ReadDir("schemas")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var names []string
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), ".rng") {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by schema_data_gen.go. DO NOT EDIT.\n\npackage libvirtxml\n\n")
+	fmt.Fprintf(&out, "// Schemas vendored from libvirt, keyed by file name\nvar schemaFiles = map[string]string{\n")
+	for _, name := range names {
+		data, err := ioutil.ReadFile("schemas/" + name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		text := string(data)
+		if strings.Contains(text, "`") || strings.Contains(text, "\r") {
+			fmt.Fprintf(&out, "%q: %s,\n", name, strconv.Quote(text))
+		} else {
+			fmt.Fprintf(&out, "%q: `%s`,\n", name, text)
+		}
+	}
+	fmt.Fprintf(&out, "}\n")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = ioutil.WriteFile("schema_data.go", src, 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
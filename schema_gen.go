@@ -0,0 +1,1022 @@
+// +build ignore
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+// This program reads libvirt's RelaxNG schemas and generates struct
+// definitions, with union types discriminated by their "type"
+// attribute, for the document they describe. Types which are already
+// declared in the package are left alone, so the output only holds
+// what is missing. With -report it instead lists the schema elements
+// and attributes which have no field in the existing structs.
+//
+//   go run schema_gen.go -prefix Domain -o domain_schema.go \
+//       testdata/libvirt/docs/schemas/domain.rng
+//   go run schema_gen.go -report -prefix Domain \
+//       testdata/libvirt/docs/schemas/domain.rng
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const rngNamespace = "http://relaxng.org/ns/structure/1.0"
+
+// Words which are spelt in upper case in Go names
+var acronyms = map[string]bool{
+	"acpi": true, "apic": true, "ats": true, "bios": true, "ccw": true,
+	"cpu": true, "dhcp": true, "dmi": true, "dns": true, "eoi": true,
+	"fd": true, "gic": true, "hpt": true, "htm": true, "id": true,
+	"io": true, "iommu": true, "ip": true, "iscsi": true, "isa": true,
+	"lun": true, "mac": true, "mtu": true, "numa": true, "nvram": true, "oem": true,
+	"pci": true, "pf": true, "pit": true, "pty": true, "qos": true,
+	"rng": true, "rtc": true, "scsi": true, "sev": true, "smbios": true,
+	"smm": true, "tcp": true, "tls": true, "tpm": true, "tsc": true,
+	"tty": true, "udp": true, "uri": true, "url": true, "usb": true,
+	"uuid": true, "vcpu": true, "vf": true, "vga": true, "vnc": true,
+	"vram": true, "wwn": true, "xml": true,
+}
+
+var unsignedTypes = map[string]bool{
+	"unsignedLong":       true,
+	"unsignedInt":        true,
+	"unsignedShort":      true,
+	"unsignedByte":       true,
+	"positiveInteger":    true,
+	"nonNegativeInteger": true,
+}
+
+var signedTypes = map[string]bool{
+	"integer": true,
+	"long":    true,
+	"int":     true,
+	"short":   true,
+	"byte":    true,
+}
+
+type node struct {
+	name     string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+type schema struct {
+	defines map[string][]*node
+	start   []*node
+}
+
+func parseFile(filename string) (*node, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var root *node
+	var stack []*node
+	skip := 0
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", filename, err)
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 || tok.Name.Space != rngNamespace {
+				skip++
+				continue
+			}
+			n := &node{
+				name:  tok.Name.Local,
+				attrs: make(map[string]string),
+			}
+			for _, attr := range tok.Attr {
+				if attr.Name.Space == "" {
+					n.attrs[attr.Name.Local] = strings.TrimSpace(attr.Value)
+				}
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if skip == 0 && len(stack) > 0 {
+				stack[len(stack)-1].text += string(tok)
+			}
+		}
+	}
+	if root == nil || root.name != "grammar" {
+		return nil, fmt.Errorf("%s: Not a RelaxNG grammar", filename)
+	}
+	return root, nil
+}
+
+func loadSchema(filename string) (*schema, error) {
+	s := &schema{
+		defines: make(map[string][]*node),
+	}
+	err := s.load(filename, nil)
+	return s, err
+}
+
+func (s *schema) load(filename string, overrides map[string]bool) error {
+	root, err := parseFile(filename)
+	if err != nil {
+		return err
+	}
+	return s.grammar(filename, root.children, overrides)
+}
+
+func (s *schema) grammar(filename string, nodes []*node, overrides map[string]bool) error {
+	for _, n := range nodes {
+		switch n.name {
+		case "start":
+			if !overrides["start"] {
+				s.start = append(s.start, n.children...)
+			}
+		case "define":
+			name := n.attrs["name"]
+			if overrides[name] {
+				continue
+			}
+			body := &node{name: "group", children: n.children}
+			if n.attrs["combine"] == "interleave" {
+				body.name = "interleave"
+			}
+			s.defines[name] = append(s.defines[name], body)
+		case "div":
+			err := s.grammar(filename, n.children, overrides)
+			if err != nil {
+				return err
+			}
+		case "include":
+			replaced := make(map[string]bool)
+			for name := range overrides {
+				replaced[name] = true
+			}
+			for _, child := range n.children {
+				if child.name == "start" {
+					replaced["start"] = true
+				} else if child.name == "define" {
+					replaced[child.attrs["name"]] = true
+				}
+			}
+			err := s.load(filepath.Join(filepath.Dir(filename), n.attrs["href"]), replaced)
+			if err != nil {
+				return err
+			}
+			err = s.grammar(filename, n.children, overrides)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type item struct {
+	attr     bool
+	name     string
+	optional bool
+	multiple bool
+	nodes    []*node
+}
+
+type variant struct {
+	value string
+	// The variant used when the "type" attribute is left out
+	fallback bool
+	model    *model
+}
+
+// model is the content allowed by one or more element patterns
+type model struct {
+	items    []*item
+	text     bool
+	textType string
+	variants []*variant
+}
+
+func (m *model) add(it *item) {
+	for _, other := range m.items {
+		if other.attr == it.attr && other.name == it.name {
+			other.optional = other.optional || it.optional
+			other.multiple = other.multiple || it.multiple
+			other.nodes = append(other.nodes, it.nodes...)
+			return
+		}
+	}
+	m.items = append(m.items, it)
+}
+
+func (m *model) setText(typ string) {
+	if m.text && m.textType != typ {
+		typ = "string"
+	}
+	m.text = true
+	m.textType = typ
+}
+
+func (s *schema) dataType(nodes []*node, seen map[string]bool) string {
+	typ := ""
+	for _, n := range nodes {
+		t := "string"
+		switch n.name {
+		case "data":
+			if unsignedTypes[n.attrs["type"]] {
+				t = "uint"
+			} else if signedTypes[n.attrs["type"]] {
+				t = "int"
+			}
+		case "ref":
+			if seen[n.attrs["name"]] {
+				continue
+			}
+			seen[n.attrs["name"]] = true
+			t = s.dataType(s.defines[n.attrs["name"]], seen)
+		case "choice", "group":
+			t = s.dataType(n.children, seen)
+		}
+		if typ != "" && typ != t {
+			return "string"
+		}
+		typ = t
+	}
+	if typ == "" {
+		return "string"
+	}
+	return typ
+}
+
+// typeValue returns the value of the "type" attribute in a choice
+// branch, and whether the attribute may be left out
+func (s *schema) typeValue(nodes []*node, seen map[string]bool) (string, bool, bool) {
+	for _, n := range nodes {
+		switch n.name {
+		case "attribute":
+			if n.attrs["name"] != "type" || len(n.children) != 1 || n.children[0].name != "value" {
+				continue
+			}
+			return strings.TrimSpace(n.children[0].text), false, true
+		case "group", "interleave":
+			if val, optional, ok := s.typeValue(n.children, seen); ok {
+				return val, optional, true
+			}
+		case "optional":
+			if val, _, ok := s.typeValue(n.children, seen); ok {
+				return val, true, true
+			}
+		case "ref":
+			name := n.attrs["name"]
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if val, optional, ok := s.typeValue(s.defines[name], seen); ok {
+				return val, optional, true
+			}
+		}
+	}
+	return "", false, false
+}
+
+func (s *schema) collect(m *model, nodes []*node, optional, multiple bool, seen map[string]bool) {
+	for _, n := range nodes {
+		switch n.name {
+		case "element", "attribute":
+			name, ok := n.attrs["name"]
+			if !ok {
+				continue
+			}
+			if idx := strings.Index(name, ":"); idx != -1 {
+				name = name[idx+1:]
+			}
+			m.add(&item{
+				attr:     n.name == "attribute",
+				name:     name,
+				optional: optional,
+				multiple: multiple,
+				nodes:    []*node{n},
+			})
+		case "optional":
+			s.collect(m, n.children, true, multiple, seen)
+		case "zeroOrMore":
+			s.collect(m, n.children, true, true, seen)
+		case "oneOrMore":
+			s.collect(m, n.children, optional, true, seen)
+		case "group", "interleave", "div":
+			s.collect(m, n.children, optional, multiple, seen)
+		case "mixed":
+			m.setText("string")
+			s.collect(m, n.children, optional, multiple, seen)
+		case "choice":
+			if m.variants == nil && s.union(m, n, seen) {
+				continue
+			}
+			s.collect(m, n.children, true, multiple, seen)
+		case "ref":
+			name := n.attrs["name"]
+			if seen[name] {
+				continue
+			}
+			inner := make(map[string]bool)
+			for k := range seen {
+				inner[k] = true
+			}
+			inner[name] = true
+			s.collect(m, s.defines[name], optional, multiple, inner)
+		case "text", "list", "value":
+			m.setText("string")
+		case "data":
+			m.setText(s.dataType([]*node{n}, map[string]bool{}))
+		}
+	}
+}
+
+// union checks whether every branch of a choice fixes the value of the
+// "type" attribute, which makes the content a union type
+func (s *schema) union(m *model, n *node, seen map[string]bool) bool {
+	if len(n.children) < 2 {
+		return false
+	}
+	var variants []*variant
+	fallback := false
+	for _, branch := range n.children {
+		val, optional, ok := s.typeValue([]*node{branch}, map[string]bool{})
+		if !ok {
+			return false
+		}
+		vm := &model{}
+		s.collect(vm, []*node{branch}, false, false, seen)
+		var items []*item
+		for _, it := range vm.items {
+			if !it.attr || it.name != "type" {
+				items = append(items, it)
+			}
+		}
+		vm.items = items
+		variants = append(variants, &variant{val, optional && !fallback, vm})
+		fallback = fallback || optional
+	}
+	m.variants = variants
+	return true
+}
+
+func (s *schema) elementModel(nodes []*node) *model {
+	m := &model{}
+	for _, n := range nodes {
+		children := n.children
+		if _, ok := n.attrs["name"]; !ok && len(children) > 0 {
+			children = children[1:]
+		}
+		s.collect(m, children, false, false, map[string]bool{})
+	}
+	return m
+}
+
+func goName(name string) string {
+	var words []string
+	word := ""
+	prev := ' '
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if word != "" {
+				words = append(words, word)
+			}
+			word = ""
+		} else if unicode.IsUpper(r) && unicode.IsLower(prev) {
+			words = append(words, word)
+			word = string(r)
+		} else {
+			word += string(r)
+		}
+		prev = r
+	}
+	if word != "" {
+		words = append(words, word)
+	}
+
+	res := ""
+	for _, word := range words {
+		lower := strings.ToLower(word)
+		if acronyms[lower] {
+			res += strings.ToUpper(word)
+		} else if strings.HasSuffix(lower, "s") && acronyms[lower[:len(lower)-1]] {
+			// Plural acronyms keep a lower case "s", as in VCPUs
+			res += strings.ToUpper(word[:len(word)-1]) + "s"
+		} else {
+			res += strings.ToUpper(word[0:1]) + word[1:]
+		}
+	}
+	return res
+}
+
+// localName turns a Go name into the name of an unexported type or
+// variable, lowering a leading acronym as a whole
+func localName(name string) string {
+	n := 0
+	for n < len(name) && unicode.IsUpper(rune(name[n])) {
+		n++
+	}
+	if n > 1 && n < len(name) {
+		n--
+	}
+	local := strings.ToLower(name[0:n]) + name[n:]
+	// Avoid the names used by the generated union methods
+	switch local {
+	case "a", "d", "e", "err", "start", "typ":
+		return "v" + name
+	}
+	if token.Lookup(local).IsKeyword() {
+		local = "v" + name
+	}
+	return local
+}
+
+type generator struct {
+	schema   *schema
+	existing map[string]bool
+	names    map[string]string
+	used     map[string]bool
+	buf      bytes.Buffer
+	pending  []func()
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// nodesKey identifies the content of element patterns, so that
+// elements sharing a definition, including recursive ones, share
+// a struct
+func nodesKey(nodes []*node) string {
+	var keys []string
+	for _, n := range nodes {
+		children := n.children
+		if _, ok := n.attrs["name"]; !ok && len(children) > 0 {
+			children = children[1:]
+		}
+		if len(children) == 1 && children[0].name == "ref" {
+			keys = append(keys, "ref:"+children[0].attrs["name"])
+		} else {
+			keys = append(keys, fmt.Sprintf("%p", n))
+		}
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// typeFor returns the name of the struct for the element patterns,
+// queueing the generation of its declaration on first use
+func (g *generator) typeFor(name string, nodes []*node, root string) string {
+	key := nodesKey(nodes)
+	if typ, ok := g.names[key]; ok {
+		return typ
+	}
+	typ := name
+	for i := 2; g.used[typ]; i++ {
+		typ = name + strconv.Itoa(i)
+	}
+	g.used[typ] = true
+	g.names[key] = typ
+
+	m := g.schema.elementModel(nodes)
+	g.pending = append(g.pending, func() {
+		if !g.existing[typ] {
+			g.generateStruct(typ, m, root)
+		}
+	})
+	return typ
+}
+
+func (g *generator) generateStruct(typ string, m *model, root string) {
+	g.printf("type %s struct {\n", typ)
+	if root != "" {
+		g.printf("XMLName xml.Name `xml:\"%s\"`\n", root)
+	}
+	for _, v := range m.variants {
+		vtyp := typ + goName(v.value)
+		g.printf("%s *%s `xml:\"-\"`\n", goName(v.value), vtyp)
+		vm := v.model
+		g.pending = append(g.pending, func() {
+			if !g.existing[vtyp] {
+				g.generateStruct(vtyp, vm, "")
+			}
+		})
+	}
+	g.generateFields(typ, m)
+	g.printf("}\n\n")
+
+	if len(m.variants) > 0 {
+		g.generateUnion(typ, m, root)
+	}
+}
+
+func (g *generator) generateFields(typ string, m *model) {
+	fields := make(map[string]bool)
+	field := func(name string) string {
+		res := name
+		for i := 2; fields[res]; i++ {
+			res = name + strconv.Itoa(i)
+		}
+		fields[res] = true
+		return res
+	}
+
+	for _, it := range m.items {
+		if !it.attr {
+			continue
+		}
+		name := field(goName(it.name))
+		var content []*node
+		for _, n := range it.nodes {
+			content = append(content, n.children...)
+		}
+		dtype := g.schema.dataType(content, map[string]bool{})
+		if dtype == "string" {
+			if it.optional {
+				g.printf("%s string `xml:\"%s,attr,omitempty\"`\n", name, it.name)
+			} else {
+				g.printf("%s string `xml:\"%s,attr\"`\n", name, it.name)
+			}
+		} else if it.optional {
+			g.printf("%s *%s `xml:\"%s,attr\"`\n", name, dtype, it.name)
+		} else {
+			g.printf("%s %s `xml:\"%s,attr\"`\n", name, dtype, it.name)
+		}
+	}
+
+	for _, it := range m.items {
+		if it.attr {
+			continue
+		}
+		name := goName(it.name)
+		if it.multiple && !strings.HasSuffix(name, "s") {
+			name += "s"
+		}
+		name = field(name)
+		child := g.schema.elementModel(it.nodes)
+		if len(child.items) == 0 && len(child.variants) == 0 {
+			if !child.text {
+				if it.multiple {
+					g.printf("%s []struct{} `xml:\"%s\"`\n", name, it.name)
+				} else {
+					g.printf("%s *struct{} `xml:\"%s\"`\n", name, it.name)
+				}
+			} else if it.multiple {
+				g.printf("%s []%s `xml:\"%s\"`\n", name, child.textType, it.name)
+			} else if child.textType == "string" {
+				g.printf("%s string `xml:\"%s,omitempty\"`\n", name, it.name)
+			} else {
+				g.printf("%s *%s `xml:\"%s\"`\n", name, child.textType, it.name)
+			}
+			continue
+		}
+
+		ctyp := g.typeFor(typ+goName(it.name), it.nodes, "")
+		if it.multiple {
+			g.printf("%s []%s `xml:\"%s\"`\n", name, ctyp, it.name)
+		} else {
+			g.printf("%s *%s `xml:\"%s\"`\n", name, ctyp, it.name)
+		}
+	}
+
+	if m.text && (len(m.items) > 0 || len(m.variants) > 0) {
+		g.printf("%s %s `xml:\",chardata\"`\n", field("Value"), m.textType)
+	}
+}
+
+// generateUnion writes the methods marshalling a union type, which
+// carry the variant in the "type" attribute. The variant structs hide
+// the XMLName of a root element, so the name is set explicitly.
+func (g *generator) generateUnion(typ string, m *model, root string) {
+	common := len(m.items) > 0 || m.text
+	alias := localName(typ)
+
+	if common {
+		g.printf("type %s %s\n\n", alias, typ)
+		for _, v := range m.variants {
+			g.printf("type %s%s struct {\n%s%s\n%s\n}\n\n",
+				alias, goName(v.value), typ, goName(v.value), alias)
+		}
+	}
+
+	g.printf("func (a *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", typ)
+	if root != "" {
+		g.printf("start.Name = xml.Name{Local: %q}\n", root)
+	}
+	for i, v := range m.variants {
+		field := goName(v.value)
+		local := localName(field)
+		if i > 0 {
+			g.printf("} else ")
+		}
+		g.printf("if a.%s != nil {\n", field)
+		g.printf("start.Attr = append(start.Attr, xml.Attr{\nxml.Name{Local: \"type\"}, %q,\n})\n", v.value)
+		if common {
+			g.printf("%s := %s%s{\n*a.%s, %s(*a),\n}\n", local, alias, field, field, alias)
+			g.printf("return e.EncodeElement(&%s, start)\n", local)
+		} else {
+			g.printf("return e.EncodeElement(a.%s, start)\n", field)
+		}
+	}
+	g.printf("}\n")
+	if common {
+		g.printf("return e.EncodeElement((*%s)(a), start)\n}\n\n", alias)
+	} else {
+		g.printf("return nil\n}\n\n")
+	}
+
+	g.printf("func (a *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {\n", typ)
+	g.printf("typ, _ := getAttr(start.Attr, \"type\")\n")
+	for i, v := range m.variants {
+		field := goName(v.value)
+		local := localName(field)
+		if i > 0 {
+			g.printf("} else ")
+		}
+		if v.fallback {
+			g.printf("if typ == %q || typ == \"\" {\n", v.value)
+		} else {
+			g.printf("if typ == %q {\n", v.value)
+		}
+		if common {
+			g.printf("var %s %s%s\n", local, alias, field)
+			g.printf("err := d.DecodeElement(&%s, &start)\nif err != nil {\nreturn err\n}\n", local)
+			g.printf("*a = %s(%s.%s)\n", typ, local, alias)
+			g.printf("a.%s = &%s.%s%s\nreturn nil\n", field, local, typ, field)
+		} else {
+			g.printf("a.%s = &%s%s{}\n", field, typ, field)
+			g.printf("return d.DecodeElement(a.%s, &start)\n", field)
+		}
+	}
+	g.printf("}\n")
+	if common {
+		g.printf("return d.DecodeElement((*%s)(a), &start)\n}\n\n", alias)
+	} else {
+		g.printf("d.Skip()\nreturn nil\n}\n\n")
+	}
+}
+
+// rootElement returns the element pattern the schema starts with
+func (s *schema) rootElement(name string) (*item, error) {
+	m := &model{}
+	s.collect(m, s.start, false, false, map[string]bool{})
+	for _, it := range m.items {
+		if !it.attr && (name == "" || it.name == name) {
+			return it, nil
+		}
+	}
+	if name == "" {
+		return nil, fmt.Errorf("Schema has no root element")
+	}
+	return nil, fmt.Errorf("Schema has no root element '%s'", name)
+}
+
+type goField struct {
+	name     string
+	typ      string
+	tag      string
+	attr     bool
+	chardata bool
+	any      bool
+	embedded bool
+}
+
+type goStruct struct {
+	fields  []goField
+	marshal bool
+}
+
+// loadPackage parses the hand written structs of the package
+func loadPackage(dir string) (map[string]*goStruct, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") &&
+			!strings.HasSuffix(name, "_gen.go") &&
+			!strings.HasSuffix(name, "_generated.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := make(map[string]*goStruct)
+	named := make(map[string]string)
+	var marshal []string
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok {
+					if fn.Recv != nil && fn.Name.Name == "MarshalXML" {
+						marshal = append(marshal, baseType(fn.Recv.List[0].Type))
+					}
+					continue
+				}
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					switch t := ts.Type.(type) {
+					case *ast.StructType:
+						structs[ts.Name.Name] = parseStruct(t)
+					case *ast.Ident:
+						named[ts.Name.Name] = t.Name
+					}
+				}
+			}
+		}
+	}
+
+	for name, base := range named {
+		if st, ok := structs[base]; ok {
+			structs[name] = &goStruct{fields: st.fields}
+		}
+	}
+	for _, name := range marshal {
+		if st, ok := structs[name]; ok {
+			st.marshal = true
+		}
+	}
+	return structs, nil
+}
+
+func baseType(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return baseType(t.X)
+	case *ast.ArrayType:
+		return baseType(t.Elt)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+func parseStruct(st *ast.StructType) *goStruct {
+	res := &goStruct{}
+	for _, f := range st.Fields.List {
+		field := goField{typ: baseType(f.Type)}
+		if f.Tag != nil {
+			tag, _ := strconv.Unquote(f.Tag.Value)
+			opts := strings.Split(reflect.StructTag(tag).Get("xml"), ",")
+			field.tag = opts[0]
+			for _, opt := range opts[1:] {
+				switch opt {
+				case "attr":
+					field.attr = true
+				case "chardata":
+					field.chardata = true
+				case "any", "innerxml":
+					field.any = true
+				}
+			}
+		}
+		if len(f.Names) == 0 {
+			field.embedded = true
+			res.fields = append(res.fields, field)
+			continue
+		}
+		for _, name := range f.Names {
+			field.name = name.Name
+			res.fields = append(res.fields, field)
+		}
+	}
+	return res
+}
+
+// cover lists the attributes and elements handled by a struct
+type cover struct {
+	attrs    map[string]bool
+	elems    map[string]string
+	chardata bool
+	any      bool
+}
+
+func (c *cover) addStruct(structs map[string]*goStruct, name string, seen map[string]bool) {
+	st, ok := structs[name]
+	if !ok || seen[name] {
+		return
+	}
+	seen[name] = true
+	for _, f := range st.fields {
+		if f.embedded || ((f.tag == "" || f.tag == "-") && st.marshal && structs[f.typ] != nil) {
+			// Embedded structs, and the variants of a union
+			// type, share the element of the parent
+			c.addStruct(structs, f.typ, seen)
+			if !f.embedded {
+				c.attrs["type"] = true
+			}
+			continue
+		}
+		if f.any {
+			c.any = true
+		} else if f.chardata {
+			c.chardata = true
+		} else if f.tag == "" || f.tag == "-" {
+			continue
+		} else if f.attr {
+			c.attrs[f.tag] = true
+		} else {
+			c.elems[f.tag] = f.typ
+		}
+	}
+}
+
+type reporter struct {
+	schema  *schema
+	structs map[string]*goStruct
+	visited map[string]bool
+	missing map[string]bool
+}
+
+func (r *reporter) check(path string, typ string, nodes []*node) {
+	key := typ + "|" + nodesKey(nodes)
+	if r.visited[key] {
+		return
+	}
+	r.visited[key] = true
+
+	c := &cover{
+		attrs: make(map[string]bool),
+		elems: make(map[string]string),
+	}
+	c.addStruct(r.structs, typ, map[string]bool{})
+	if c.any {
+		return
+	}
+
+	m := r.schema.elementModel(nodes)
+	items := m.items
+	for _, v := range m.variants {
+		items = append(items, v.model.items...)
+	}
+	if len(m.variants) > 0 && !c.attrs["type"] {
+		r.missing[fmt.Sprintf("%s/@type: no field in %s", path, typ)] = true
+	}
+	for _, it := range items {
+		if it.attr {
+			if !c.attrs[it.name] {
+				r.missing[fmt.Sprintf("%s/@%s: no field in %s", path, it.name, typ)] = true
+			}
+			continue
+		}
+		ctyp, ok := c.elems[it.name]
+		if !ok {
+			r.missing[fmt.Sprintf("%s/%s: no field in %s", path, it.name, typ)] = true
+			continue
+		}
+		if _, ok := r.structs[ctyp]; ok {
+			r.check(path+"/"+it.name, ctyp, it.nodes)
+		}
+	}
+	if m.text && len(m.items) > 0 && !c.chardata {
+		r.missing[fmt.Sprintf("%s/text(): no field in %s", path, typ)] = true
+	}
+}
+
+func main() {
+	prefix := flag.String("prefix", "", "name of the struct for the root element")
+	element := flag.String("element", "", "root element, if the schema allows several")
+	output := flag.String("o", "", "file to write the generated structs to")
+	report := flag.Bool("report", false, "list schema nodes missing from the existing structs")
+	dir := flag.String("dir", ".", "directory of the package")
+	flag.Parse()
+
+	if flag.NArg() != 1 || *prefix == "" {
+		fmt.Fprintln(os.Stderr, "usage: schema_gen [-report] [-o file] [-element name] -prefix Name schema.rng")
+		os.Exit(2)
+	}
+
+	s, err := loadSchema(flag.Arg(0))
+	if err == nil {
+		var structs map[string]*goStruct
+		var root *item
+		structs, err = loadPackage(*dir)
+		if err == nil {
+			root, err = s.rootElement(*element)
+		}
+		if err == nil && *report {
+			err = runReport(s, structs, root, *prefix)
+		} else if err == nil {
+			err = runGenerate(s, structs, root, *prefix, *output)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func runReport(s *schema, structs map[string]*goStruct, root *item, prefix string) error {
+	if _, ok := structs[prefix]; !ok {
+		return fmt.Errorf("No struct %s in the package", prefix)
+	}
+	r := &reporter{
+		schema:  s,
+		structs: structs,
+		visited: make(map[string]bool),
+		missing: make(map[string]bool),
+	}
+	r.check(root.name, prefix, root.nodes)
+
+	var lines []string
+	for line := range r.missing {
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func runGenerate(s *schema, structs map[string]*goStruct, root *item, prefix string, output string) error {
+	g := &generator{
+		schema:   s,
+		existing: make(map[string]bool),
+		names:    make(map[string]string),
+		used:     make(map[string]bool),
+	}
+	for name := range structs {
+		g.existing[name] = true
+	}
+
+	g.typeFor(prefix, root.nodes, root.name)
+	for len(g.pending) > 0 {
+		next := g.pending[0]
+		g.pending = g.pending[1:]
+		next()
+	}
+
+	body := g.buf.String()
+	var header bytes.Buffer
+	fmt.Fprintf(&header, "// Code generated by schema_gen.go. DO NOT EDIT.\n\npackage libvirtxml\n\n")
+	if strings.Contains(body, "xml.") {
+		fmt.Fprintf(&header, "import \"encoding/xml\"\n\n")
+	}
+	src, err := format.Source(append(header.Bytes(), body...))
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return ioutil.WriteFile(output, src, 0644)
+}
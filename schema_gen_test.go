@@ -0,0 +1,80 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os/exec"
+	"testing"
+)
+
+// runSchemaGen runs schema_gen.go, which is a program of its own
+func runSchemaGen(t *testing.T, args ...string) string {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("No go tool to run schema_gen.go with")
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(gobin, append([]string{"run", "schema_gen.go"}, args...)...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("schema_gen.go failed: %s: %s", err, stderr.String())
+	}
+	return string(out)
+}
+
+func TestSchemaGenReport(t *testing.T) {
+	out := runSchemaGen(t, "-report", "-prefix", "Secret", "schemas/secret.rng")
+	if out != "" {
+		t.Fatalf("Unexpected schema nodes missing from Secret:\n%s", out)
+	}
+}
+
+func TestSchemaGenUnion(t *testing.T) {
+	out := runSchemaGen(t, "-prefix", "Gadget", "-dir", "testdata/schemagen",
+		"testdata/schemagen/union.rng")
+	expect, err := ioutil.ReadFile("testdata/schemagen/union.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != string(expect) {
+		t.Fatal("Bad generated code:\n", out, "\n does not match\n", string(expect), "\n")
+	}
+}
+
+func TestSchemaGenDisk(t *testing.T) {
+	out := runSchemaGen(t, "-prefix", "DomainDisk", "-dir", "testdata/schemagen",
+		"testdata/schemagen/disk.rng")
+	expect, err := ioutil.ReadFile("testdata/schemagen/disk.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != string(expect) {
+		t.Fatal("Bad generated code:\n", out, "\n does not match\n", string(expect), "\n")
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchemaSecret(t *testing.T) {
+	for _, test := range secretTestData {
+		err := test.Object.Validate()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	secret := &Secret{
+		Ephemeral: "true",
+		UUID:      "55806c7d-8e93-456f-829b",
+		Usage: &SecretUsage{
+			Type:   "volume",
+			Volume: "/var/lib/libvirt/images/puppyname.img",
+		},
+	}
+	err := secret.Validate()
+	if err == nil {
+		t.Fatal("Expected validation failure")
+	}
+	errs, ok := err.(SchemaErrors)
+	if !ok {
+		t.Fatalf("Expected schema errors, got %s", err)
+	}
+	expect := []SchemaError{
+		SchemaError{Line: 1, Message: "Invalid value 'true' for attribute 'ephemeral' of 'secret'"},
+		SchemaError{Line: 2, Message: "Invalid content '55806c7d-8e93-456f-829b' in 'uuid'"},
+	}
+	if len(errs) != len(expect) {
+		t.Fatalf("Expected %d errors, got %s", len(expect), err)
+	}
+	for i, e := range expect {
+		if errs[i] != e {
+			t.Fatalf("Expected error '%s', got '%s'", e, errs[i])
+		}
+	}
+}
+
+func TestSchemaLoad(t *testing.T) {
+	schema, err := LoadSchema("schemas/secret.rng")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := strings.Join([]string{
+		`<secret ephemeral="no" private="yes">`,
+		`  <usage type="ceph">`,
+		`    <name>client.admin secret</name>`,
+		`  </usage>`,
+		`</secret>`,
+	}, "\n")
+	err = schema.Validate(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc = strings.Join([]string{
+		`<secret>`,
+		`  <usage type="ceph">`,
+		`    <target>demo</target>`,
+		`  </usage>`,
+		`</secret>`,
+	}, "\n")
+	err = schema.Validate(doc)
+	expect := "Line 3: Element 'target' is not allowed in 'usage'\n" +
+		"Line 2: Element 'usage' is missing required content"
+	if err == nil || err.Error() != expect {
+		t.Fatalf("Unexpected validation result %v", err)
+	}
+
+	_, err = LoadSchema("schemas/missing.rng")
+	if err == nil {
+		t.Fatal("Expected error loading missing schema")
+	}
+}
+
+func TestSchemaVendored(t *testing.T) {
+	docs := []Document{&Secret{}, &Domain{}, &DomainDisk{}, &Network{}, &Caps{}}
+	for _, doc := range docs {
+		ref, ok := doc.(interface {
+			schemaRef() (string, string)
+		})
+		if !ok {
+			t.Fatalf("No schema for %T", doc)
+		}
+		file, _ := ref.schemaRef()
+		_, vendored := schemaFiles[file]
+		_, validates := doc.(interface {
+			Validate() error
+		})
+		if vendored != validates {
+			t.Fatalf("%T has Validate %v but schema %s vendored %v", doc, validates, file, vendored)
+		}
+	}
+}
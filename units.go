@@ -0,0 +1,92 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2017 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// unitScale returns the number of bytes in one unit, following the
+// rules libvirt applies to the "unit" attribute of sizes. An empty
+// unit means defunit.
+func unitScale(unit string, defunit string) (uint64, error) {
+	if unit == "" {
+		unit = defunit
+	}
+	lower := strings.ToLower(unit)
+	if lower == "b" || lower == "byte" || lower == "bytes" {
+		return 1, nil
+	}
+
+	var power uint
+	switch lower[0] {
+	case 'k':
+		power = 1
+	case 'm':
+		power = 2
+	case 'g':
+		power = 3
+	case 't':
+		power = 4
+	case 'p':
+		power = 5
+	case 'e':
+		power = 6
+	default:
+		return 0, fmt.Errorf("Unknown unit '%s'", unit)
+	}
+
+	var base uint64
+	switch lower[1:] {
+	case "", "ib":
+		base = 1024
+	case "b":
+		base = 1000
+	default:
+		return 0, fmt.Errorf("Unknown unit '%s'", unit)
+	}
+
+	scale := uint64(1)
+	for i := uint(0); i < power; i++ {
+		scale *= base
+	}
+	return scale, nil
+}
+
+// scaleToKiB converts value in unit to KiB, with an empty unit
+// meaning KiB as it does for most memory sizes in libvirt.
+func scaleToKiB(value uint64, unit string) (uint64, error) {
+	scale, err := unitScale(unit, "KiB")
+	if err != nil {
+		return 0, err
+	}
+	if value > math.MaxUint64/scale {
+		return 0, fmt.Errorf("Value %d %s is too large", value, unit)
+	}
+	return value * scale / 1024, nil
+}
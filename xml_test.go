@@ -387,6 +387,77 @@ func testRoundTrip(t *testing.T, xml string, filename string) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	err = testValidateSchema(doc)
+	if err != nil {
+		t.Fatal(fmt.Errorf("Schema validation failed for %s:\n%s\n", filename, err))
+	}
+}
+
+// Where the libvirt checkout keeps its schemas, which moved between
+// releases
+var schemadirs = []string{
+	"testdata/libvirt/src/conf/schemas",
+	"testdata/libvirt/docs/schemas",
+}
+
+var checkoutSchemas = make(map[string]*Schema)
+
+// loadCheckoutSchema compiles a schema of the libvirt checkout,
+// starting from the given define unless it is empty
+func loadCheckoutSchema(file string, define string) (*Schema, error) {
+	key := file + "#" + define
+	if schema, ok := checkoutSchemas[key]; ok {
+		return schema, nil
+	}
+	for _, dir := range schemadirs {
+		if _, err := os.Stat(dir + "/" + file); err != nil {
+			continue
+		}
+		load := func(name string) ([]byte, error) {
+			return ioutil.ReadFile(name)
+		}
+		start, err := compileRNG(load, dir+"/"+file, define)
+		if err != nil {
+			return nil, err
+		}
+		schema := &Schema{start: start}
+		checkoutSchemas[key] = schema
+		return schema, nil
+	}
+	return nil, fmt.Errorf("No schema %s in the libvirt checkout", file)
+}
+
+// testValidateSchema checks a marshalled document against its schema,
+// the vendored one if there is one and otherwise the one in the
+// libvirt checkout. Only network parts have no schema.
+func testValidateSchema(doc Document) error {
+	ref, ok := doc.(interface {
+		schemaRef() (string, string)
+	})
+	if !ok {
+		switch doc.(type) {
+		case *NetworkDHCPHost, *NetworkDHCPRange, *NetworkDNSHost, *NetworkDNSSRV,
+			*NetworkDNSTXT, *NetworkForwardInterface, *NetworkPortGroup:
+			return nil
+		}
+		return fmt.Errorf("No schema to validate %T with", doc)
+	}
+	if v, ok := doc.(interface {
+		Validate() error
+	}); ok {
+		return v.Validate()
+	}
+
+	schema, err := loadCheckoutSchema(ref.schemaRef())
+	if err != nil {
+		return err
+	}
+	xml, err := doc.Marshal()
+	if err != nil {
+		return err
+	}
+	return schema.Validate(xml)
 }
 
 func syncGit(t *testing.T) {
@@ -443,3 +514,93 @@ func TestRoundTrip(t *testing.T) {
 		}
 	}
 }
+
+// Guest CPUs compared with host CPUs by libvirt's tests/cputest.c,
+// with the results it expects
+var cpuCompareLibvirtTests = []struct {
+	Host   string
+	Guest  string
+	Result string
+}{
+	{"host", "min", CPUCompareSuperset},
+	{"host", "pentium3", CPUCompareSuperset},
+	{"host", "exact", CPUCompareSuperset},
+	{"host", "exact-forbid", CPUCompareIncompatible},
+	{"host", "exact-forbid-extra", CPUCompareSuperset},
+	{"host", "exact-disable", CPUCompareSuperset},
+	{"host", "exact-disable2", CPUCompareSuperset},
+	{"host", "exact-disable-extra", CPUCompareSuperset},
+	{"host", "exact-require", CPUCompareSuperset},
+	{"host", "exact-require-extra", CPUCompareIncompatible},
+	{"host", "exact-force", CPUCompareSuperset},
+	{"host", "strict", CPUCompareIncompatible},
+	{"host", "strict-full", CPUCompareIdentical},
+	{"host", "strict-disable", CPUCompareIdentical},
+	{"host", "strict-force-extra", CPUCompareIdentical},
+	{"host", "guest", CPUCompareSuperset},
+	{"host", "pentium3-amd", CPUCompareIncompatible},
+	{"host-amd", "pentium3-amd", CPUCompareSuperset},
+	{"host-worse", "nehalem-force", CPUCompareIdentical},
+	{"host", "bogus-model", ""},
+}
+
+func loadCPUTestData(t *testing.T, name string, doc Document) {
+	filename := "testdata/libvirt/tests/cputestdata/x86_64-" + name + ".xml"
+	xml, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = doc.Unmarshal(trimXML(string(xml)))
+	if err != nil {
+		t.Fatal(fmt.Errorf("Cannot parse file %s: %s\n", filename, err))
+	}
+}
+
+func TestCompareCPULibvirt(t *testing.T) {
+	syncGit(t)
+	for _, test := range cpuCompareLibvirtTests {
+		host := &CapsHostCPU{}
+		loadCPUTestData(t, test.Host, host)
+		guest := &DomainCPU{}
+		loadCPUTestData(t, test.Guest, guest)
+
+		result, err := CompareCPU(guest, host)
+		if test.Result == "" {
+			if err == nil {
+				t.Fatalf("Expected error comparing %s with %s", test.Guest, test.Host)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Result != test.Result {
+			t.Fatalf("Expected %s comparing %s with %s, got %+v",
+				test.Result, test.Guest, test.Host, result)
+		}
+	}
+}
+
+// TestValidateDomainLibvirt checks that the validator copes with the
+// complete domain schema, including its XML Schema patterns
+func TestValidateDomainLibvirt(t *testing.T) {
+	syncGit(t)
+	schema, err := loadCheckoutSchema("domain.rng", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := ioutil.ReadFile("testdata/libvirt/tests/qemuxml2argvdata/minimal.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = schema.Validate(string(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = schema.Validate(strings.Replace(string(doc), "<uuid>", "<uuid>x", 1))
+	if _, ok := err.(SchemaErrors); !ok {
+		t.Fatalf("Expected schema errors for a malformed UUID, got %v", err)
+	}
+}